@@ -24,8 +24,16 @@ const (
 	GroupName            = "template.kubevirt.io"
 	SubresourceGroupName = "subresources.template.kubevirt.io"
 
-	SingularResourceName        = "virtualmachinetemplate"
-	PluralResourceName          = SingularResourceName + "s"
-	SingularRequestResourceName = "virtualmachinetemplaterequest"
-	PluralRequestResourceName   = SingularRequestResourceName + "s"
+	SingularResourceName         = "virtualmachinetemplate"
+	PluralResourceName           = SingularResourceName + "s"
+	SingularRequestResourceName  = "virtualmachinetemplaterequest"
+	PluralRequestResourceName    = SingularRequestResourceName + "s"
+	SingularClusterResourceName  = "clustervirtualmachinetemplate"
+	PluralClusterResourceName    = SingularClusterResourceName + "s"
+	SingularInstanceResourceName = "virtualmachinetemplateinstance"
+	PluralInstanceResourceName   = SingularInstanceResourceName + "s"
+	SingularGrantResourceName    = "virtualmachinetemplategrant"
+	PluralGrantResourceName      = SingularGrantResourceName + "s"
+	SingularPreviewResourceName  = "virtualmachinetemplatepreview"
+	PluralPreviewResourceName    = SingularPreviewResourceName + "s"
 )
@@ -22,8 +22,12 @@ package subresourcesv1beta1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	poolv1beta1 "kubevirt.io/api/pool/v1beta1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
 )
 
 // +kubebuilder:object:root=true
@@ -37,6 +41,15 @@ type VirtualMachineTemplate struct {
 
 // +kubebuilder:object:root=true
 
+// ClusterVirtualMachineTemplate is a dummy object to satisfy the k8s.io/apiserver conventions.
+// A subresource cannot be served without a storage for its parent resource.
+type ClusterVirtualMachineTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+}
+
+// +kubebuilder:object:root=true
+
 // ProcessedVirtualMachineTemplate is the object served by the /process and /create subresources.
 // It's not a standalone resource but represents a process or create action on the parent VirtualMachineTemplate resource.
 type ProcessedVirtualMachineTemplate struct {
@@ -46,14 +59,40 @@ type ProcessedVirtualMachineTemplate struct {
 	// TemplateRef contains a reference to the template that was processed. Optional.
 	TemplateRef *corev1.ObjectReference `json:"templateRef,omitempty,omitzero" protobuf:"bytes,2,opt,name=templateRef"`
 
-	// VirtualMachine is a VirtualMachine that was created from processing a template. Required.
-	VirtualMachine *virtv1.VirtualMachine `json:"virtualMachine" protobuf:"bytes,3,name=virtualMachine"`
+	// VirtualMachine is a VirtualMachine that was created from processing a template. It is
+	// only set if the OutputKind VirtualMachine was requested. Optional.
+	VirtualMachine *virtv1.VirtualMachine `json:"virtualMachine,omitempty,omitzero" protobuf:"bytes,3,opt,name=virtualMachine"`
 
 	// Message is an optional instructional message that should inform the user how to
 	// utilize the newly created VirtualMachine. Optional.
 	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+
+	// VirtualMachinePool is the VirtualMachinePool wrapping the processed VirtualMachine.
+	// It is only set if the OutputKind VirtualMachinePool was requested. Optional.
+	VirtualMachinePool *poolv1beta1.VirtualMachinePool `json:"virtualMachinePool,omitempty,omitzero" protobuf:"bytes,5,opt,name=virtualMachinePool"` //nolint:lll
+
+	// VirtualMachineInstanceReplicaSet is the VirtualMachineInstanceReplicaSet wrapping the
+	// VirtualMachineInstance template of the processed VirtualMachine. It is only set if the
+	// OutputKind VirtualMachineInstanceReplicaSet was requested. Optional.
+	VirtualMachineInstanceReplicaSet *virtv1.VirtualMachineInstanceReplicaSet `json:"virtualMachineInstanceReplicaSet,omitempty,omitzero" protobuf:"bytes,6,opt,name=virtualMachineInstanceReplicaSet"` //nolint:lll
 }
 
+// OutputKind is the kind of object a VirtualMachineTemplate is processed into.
+// +enum
+type OutputKind string
+
+const (
+	// OutputKindVirtualMachine processes a template into a VirtualMachine.
+	OutputKindVirtualMachine OutputKind = "VirtualMachine"
+	// OutputKindVirtualMachinePool processes a template into a VirtualMachinePool
+	// whose VirtualMachines are rendered from the template.
+	OutputKindVirtualMachinePool OutputKind = "VirtualMachinePool"
+	// OutputKindVirtualMachineInstanceReplicaSet processes a template into a
+	// VirtualMachineInstanceReplicaSet of the VirtualMachineInstance template of
+	// the rendered VirtualMachine.
+	OutputKindVirtualMachineInstanceReplicaSet OutputKind = "VirtualMachineInstanceReplicaSet"
+)
+
 // +kubebuilder:object:root=true
 
 // ProcessOptions are the options used when processing a VirtualMachineTemplate.
@@ -63,8 +102,327 @@ type ProcessOptions struct {
 
 	// Parameters is an optional map of key value pairs used during processing of the template. Optional.
 	Parameters map[string]string `json:"parameters,omitempty" protobuf:"bytes,2,opt,name=parameters"`
+
+	// Revision selects the revision of the template to process. If unset, the
+	// current state of the template is processed. Optional.
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,3,opt,name=revision"`
+
+	// TargetNamespace is the namespace the template is processed into. If it differs
+	// from the namespace of the template, a VirtualMachineTemplateGrant in the namespace
+	// of the template must allow it. Defaults to the namespace of the template. Optional.
+	TargetNamespace string `json:"targetNamespace,omitempty" protobuf:"bytes,4,opt,name=targetNamespace"`
+
+	// DryRun, when present, indicates that the objects created by the create
+	// subresource should not be persisted. They are still defaulted, admitted and
+	// validated by the API server and returned. The only valid value is All. Optional.
+	//
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,5,rep,name=dryRun"`
+
+	// Count is the number of VirtualMachines created from the template by the create
+	// subresource. If set, the template is processed once per VirtualMachine, its generated
+	// parameters are generated anew for each of them, and the create subresource responds
+	// with a ProcessedVirtualMachineTemplateBatch. If the template declares the parameter
+	// INDEX, it is set to the index of each VirtualMachine, starting at zero. At most 100
+	// VirtualMachines can be created at once. Optional.
+	Count int32 `json:"count,omitempty" protobuf:"varint,6,opt,name=count"`
+
+	// NamePattern is the name of the VirtualMachines created if Count is set. Every occurrence
+	// of ${INDEX} is replaced with the index of the VirtualMachine. If unset, the name of the
+	// processed VirtualMachines is used, which must then be unique for each of them. Optional.
+	NamePattern string `json:"namePattern,omitempty" protobuf:"bytes,7,opt,name=namePattern"`
+
+	// OutputKind is the kind of object the template is processed into and created as, one of
+	// VirtualMachine, VirtualMachinePool or VirtualMachineInstanceReplicaSet. Defaults to
+	// VirtualMachine. Other kinds cannot be combined with a Count. Optional.
+	OutputKind OutputKind `json:"outputKind,omitempty" protobuf:"bytes,8,opt,name=outputKind,casttype=OutputKind"`
+
+	// Replicas is the number of replicas of the VirtualMachinePool or VirtualMachineInstanceReplicaSet
+	// selected by the OutputKind. Defaults to the default of the selected kind. Optional.
+	Replicas *int32 `json:"replicas,omitempty" protobuf:"varint,9,opt,name=replicas"`
+
+	// IdempotencyKey identifies a request to the create subresource across retries. It is
+	// recorded on the created VirtualMachine, and a repeated request with the same key for the
+	// same template returns the existing VirtualMachine instead of creating another one. With a
+	// Count, the index of each VirtualMachine is appended to the key. It is only supported for
+	// the OutputKind VirtualMachine and must not be longer than 128 characters. Optional.
+	IdempotencyKey string `json:"idempotencyKey,omitempty" protobuf:"bytes,10,opt,name=idempotencyKey"`
+
+	// Apply makes the create subresource server-side apply the processed VirtualMachine
+	// instead of creating it, with a field manager named after the template. An existing
+	// VirtualMachine with the same name is updated to the processed state, for example to
+	// reconfigure it with new parameters. It is only supported for the OutputKind
+	// VirtualMachine and cannot be combined with an IdempotencyKey. Optional.
+	Apply bool `json:"apply,omitempty" protobuf:"varint,11,opt,name=apply"`
+
+	// Overrides are applied to the processed VirtualMachine after parameter substitution.
+	// The template can restrict them with its PatchablePaths. Optional.
+	Overrides *VirtualMachineOverrides `json:"overrides,omitempty" protobuf:"bytes,12,opt,name=overrides"`
+}
+
+// VirtualMachineOverrides are changes made to a processed VirtualMachine on request.
+// The labels and annotations recording the provenance of the VirtualMachine cannot be changed.
+type VirtualMachineOverrides struct {
+	// Labels are added to the labels of the VirtualMachine. Optional.
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,1,rep,name=labels"`
+
+	// Annotations are added to the annotations of the VirtualMachine. Optional.
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,rep,name=annotations"`
+
+	// RunStrategy overrides the runStrategy of the VirtualMachine. Optional.
+	RunStrategy *virtv1.VirtualMachineRunStrategy `json:"runStrategy,omitempty" protobuf:"bytes,3,opt,name=runStrategy"`
+
+	// Patch is applied to the VirtualMachine after the labels, annotations and runStrategy. Optional.
+	Patch *VirtualMachinePatch `json:"patch,omitempty" protobuf:"bytes,4,opt,name=patch"`
+}
+
+// VirtualMachinePatch is a patch of a processed VirtualMachine.
+type VirtualMachinePatch struct {
+	// Type is the type of the patch. Required.
+	Type PatchType `json:"type" protobuf:"bytes,1,name=type,casttype=PatchType"`
+
+	// Data is the patch document in JSON. Required.
+	Data string `json:"data" protobuf:"bytes,2,name=data"`
+}
+
+// PatchType is the type of a VirtualMachinePatch.
+// +enum
+type PatchType string
+
+const (
+	// PatchTypeJSON is a JSON patch as defined by RFC 6902.
+	PatchTypeJSON PatchType = "JSON"
+	// PatchTypeStrategicMerge is a strategic merge patch as used by kubectl.
+	PatchTypeStrategicMerge PatchType = "StrategicMerge"
+)
+
+const (
+	// BatchIndexParameter is the name of the parameter that is set to the index of each
+	// VirtualMachine created from a template when a Count is requested.
+	BatchIndexParameter = "INDEX"
+	// BatchIndexReference is replaced with the index of each VirtualMachine in a NamePattern.
+	BatchIndexReference = "${" + BatchIndexParameter + "}"
+)
+
+// +kubebuilder:object:root=true
+
+// ProcessedVirtualMachineTemplateBatch is the object served by the /create subresource if a Count
+// is requested. It lists the result of creating each VirtualMachine, which succeeds or fails individually.
+type ProcessedVirtualMachineTemplateBatch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+
+	// TemplateRef contains a reference to the template that was processed. Optional.
+	TemplateRef *corev1.ObjectReference `json:"templateRef,omitempty,omitzero" protobuf:"bytes,2,opt,name=templateRef"`
+
+	// Items lists the result of creating each VirtualMachine, ordered by index. Optional.
+	Items []ProcessedVirtualMachineTemplateBatchItem `json:"items,omitempty" protobuf:"bytes,3,rep,name=items"`
+}
+
+// ProcessedVirtualMachineTemplateBatchItem is the result of creating a single VirtualMachine of a batch.
+type ProcessedVirtualMachineTemplateBatchItem struct {
+	// Index is the index of the VirtualMachine in the batch. Required.
+	Index int32 `json:"index" protobuf:"varint,1,name=index"`
+
+	// VirtualMachine is the VirtualMachine that was created. It is unset if creating
+	// the VirtualMachine failed. Optional.
+	VirtualMachine *virtv1.VirtualMachine `json:"virtualMachine,omitempty,omitzero" protobuf:"bytes,2,opt,name=virtualMachine"`
+
+	// Message is an optional instructional message that should inform the user how to
+	// utilize the newly created VirtualMachine. Optional.
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+
+	// Error is the reason creating the VirtualMachine failed. Optional.
+	Error *ProcessedVirtualMachineTemplateBatchError `json:"error,omitempty,omitzero" protobuf:"bytes,4,opt,name=error"`
+}
+
+// ProcessedVirtualMachineTemplateBatchError describes why creating a single VirtualMachine of a batch failed.
+type ProcessedVirtualMachineTemplateBatchError struct {
+	// Code is the HTTP status code of the error. Required.
+	Code int32 `json:"code" protobuf:"varint,1,name=code"`
+
+	// Reason is a machine-readable description of the error. Optional.
+	Reason metav1.StatusReason `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+
+	// Message is a human-readable description of the error. Optional.
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+}
+
+// +kubebuilder:object:root=true
+
+// RollbackOptions are the options used when rolling back a VirtualMachineTemplate.
+type RollbackOptions struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Revision is the revision of the template to roll back to. Required.
+	Revision int64 `json:"revision" protobuf:"varint,2,name=revision"`
+}
+
+// +kubebuilder:object:root=true
+
+// RolledBackVirtualMachineTemplate is the object served by the /rollback subresource.
+// It represents a rollback action on the parent VirtualMachineTemplate resource.
+type RolledBackVirtualMachineTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+
+	// TemplateRef contains a reference to the template that was rolled back. Optional.
+	TemplateRef *corev1.ObjectReference `json:"templateRef,omitempty,omitzero" protobuf:"bytes,2,opt,name=templateRef"`
+
+	// Revision is the revision of the template that was restored. Required.
+	Revision int64 `json:"revision" protobuf:"varint,3,name=revision"`
+
+	// TemplateGeneration is the generation of the template after the rollback. Optional.
+	TemplateGeneration int64 `json:"templateGeneration,omitempty" protobuf:"varint,4,opt,name=templateGeneration"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineTemplateInstances is the object served by the /instances subresource.
+// It lists the VirtualMachines that were created from the parent VirtualMachineTemplate.
+type VirtualMachineTemplateInstances struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+
+	// TemplateRef contains a reference to the template the instances were created from. Optional.
+	TemplateRef *corev1.ObjectReference `json:"templateRef,omitempty,omitzero" protobuf:"bytes,2,opt,name=templateRef"`
+
+	// TemplateGeneration is the current generation of the template. Optional.
+	TemplateGeneration int64 `json:"templateGeneration,omitempty" protobuf:"varint,3,opt,name=templateGeneration"`
+
+	// Instances is the list of VirtualMachines created from the template. Optional.
+	Instances []TemplateInstance `json:"instances,omitempty" protobuf:"bytes,4,rep,name=instances"`
+}
+
+// TemplateInstance describes a single VirtualMachine created from a VirtualMachineTemplate.
+type TemplateInstance struct {
+	// Namespace is the namespace of the VirtualMachine. Required.
+	Namespace string `json:"namespace" protobuf:"bytes,1,name=namespace"`
+
+	// Name is the name of the VirtualMachine. Required.
+	Name string `json:"name" protobuf:"bytes,2,name=name"`
+
+	// CreationTimestamp is the creation timestamp of the VirtualMachine. Optional.
+	CreationTimestamp metav1.Time `json:"creationTimestamp,omitempty,omitzero" protobuf:"bytes,3,opt,name=creationTimestamp"`
+
+	// TemplateGeneration is the generation of the template the VirtualMachine was created from.
+	// It is zero if the generation is unknown. Optional.
+	TemplateGeneration int64 `json:"templateGeneration,omitempty" protobuf:"varint,4,opt,name=templateGeneration"`
+
+	// Outdated indicates that the VirtualMachine was created from an older generation of the template. Optional.
+	Outdated bool `json:"outdated,omitempty" protobuf:"varint,5,opt,name=outdated"`
+}
+
+// +kubebuilder:object:root=true
+
+// UpgradeOptions are the options used when diffing or upgrading a VirtualMachine
+// that was created from a VirtualMachineTemplate.
+type UpgradeOptions struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+
+	// VirtualMachineName is the name of the VirtualMachine to diff or upgrade. The VirtualMachine
+	// must have been created from the template and must reside in the namespace of the template. Required.
+	VirtualMachineName string `json:"virtualMachineName" protobuf:"bytes,2,name=virtualMachineName"`
+
+	// Revision selects the revision of the template to render. If unset, the
+	// current state of the template is rendered. Optional.
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,3,opt,name=revision"`
+
+	// ResourceVersion is the expected resourceVersion of the VirtualMachine. If set, an upgrade
+	// fails with a conflict if the VirtualMachine was modified in the meantime. Optional.
+	ResourceVersion string `json:"resourceVersion,omitempty" protobuf:"bytes,4,opt,name=resourceVersion"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineUpgrade is the object served by the /diff and /upgrade subresources.
+// It represents the changes between a VirtualMachine and the parent VirtualMachineTemplate
+// rendered with the parameter values recorded on the VirtualMachine.
+type VirtualMachineUpgrade struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+
+	// TemplateRef contains a reference to the template that was rendered. Optional.
+	TemplateRef *corev1.ObjectReference `json:"templateRef,omitempty,omitzero" protobuf:"bytes,2,opt,name=templateRef"`
+
+	// Revision is the revision of the template that was rendered. Optional.
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,3,opt,name=revision"`
+
+	// UpToDate indicates that the spec of the VirtualMachine matches the rendered template. Optional.
+	UpToDate bool `json:"upToDate,omitempty" protobuf:"varint,4,opt,name=upToDate"`
+
+	// Changes lists the differences between the spec of the VirtualMachine and the spec
+	// rendered from the template as JSON patch operations, sorted by path. Optional.
+	Changes []VirtualMachineSpecChange `json:"changes,omitempty" protobuf:"bytes,5,rep,name=changes"`
+
+	// VirtualMachine is the VirtualMachine rendered from the template when diffing,
+	// or the updated VirtualMachine when upgrading. Required.
+	VirtualMachine *virtv1.VirtualMachine `json:"virtualMachine" protobuf:"bytes,6,name=virtualMachine"`
+}
+
+// VirtualMachineSpecChange is a single JSON patch operation on the spec of a VirtualMachine.
+type VirtualMachineSpecChange struct {
+	// Operation is the JSON patch operation, one of add, remove or replace. Required.
+	Operation string `json:"op" protobuf:"bytes,1,name=op"`
+
+	// Path is the JSON pointer to the changed field, relative to the spec of the VirtualMachine. Required.
+	Path string `json:"path" protobuf:"bytes,2,name=path"`
+
+	// Value is the new value of the changed field. Optional.
+	//
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Value *runtime.RawExtension `json:"value,omitempty" protobuf:"bytes,3,opt,name=value"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineTemplatePreview processes an inline VirtualMachineTemplate without persisting
+// anything. It can only be created and reports the result of processing in its status.
+type VirtualMachineTemplatePreview struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec contains the template to preview and the options used to process it. Required.
+	Spec VirtualMachineTemplatePreviewSpec `json:"spec" protobuf:"bytes,2,name=spec"`
+
+	// Status contains the result of processing the template. It is set by the server. Optional.
+	Status VirtualMachineTemplatePreviewStatus `json:"status,omitempty,omitzero" protobuf:"bytes,3,opt,name=status"`
+}
+
+// VirtualMachineTemplatePreviewSpec contains the template to preview and the options used to process it.
+type VirtualMachineTemplatePreviewSpec struct {
+	// Template is the VirtualMachineTemplate to process. It does not have to exist in the cluster. Required.
+	Template *v1beta1.VirtualMachineTemplate `json:"template" protobuf:"bytes,1,name=template"`
+
+	// Options are the options used when processing the template. Revisions cannot be selected
+	// and the template is always processed into the namespace of the request. Optional.
+	Options *ProcessOptions `json:"options,omitempty,omitzero" protobuf:"bytes,2,opt,name=options"`
+}
+
+// VirtualMachineTemplatePreviewStatus contains the result of processing a previewed template.
+type VirtualMachineTemplatePreviewStatus struct {
+	// Processed is the result of processing the template. It is unset if the template
+	// could not be processed. Optional.
+	Processed *ProcessedVirtualMachineTemplate `json:"processed,omitempty,omitzero" protobuf:"bytes,1,opt,name=processed"`
+
+	// Warnings lists the warnings found while validating the template. Optional.
+	//
+	// +listType=atomic
+	Warnings []string `json:"warnings,omitempty" protobuf:"bytes,2,rep,name=warnings"`
+
+	// Errors lists all errors found while validating and processing the template. Optional.
+	//
+	// +listType=atomic
+	Errors []metav1.StatusCause `json:"errors,omitempty" protobuf:"bytes,3,rep,name=errors"`
 }
 
 func init() {
-	SchemeBuilder.Register(&VirtualMachineTemplate{}, &ProcessOptions{}, &ProcessedVirtualMachineTemplate{})
+	SchemeBuilder.Register(
+		&VirtualMachineTemplate{}, &ClusterVirtualMachineTemplate{}, &ProcessOptions{}, &ProcessedVirtualMachineTemplate{},
+		&VirtualMachineTemplateInstances{}, &RollbackOptions{}, &RolledBackVirtualMachineTemplate{},
+		&UpgradeOptions{}, &VirtualMachineUpgrade{}, &VirtualMachineTemplatePreview{},
+		&ProcessedVirtualMachineTemplateBatch{},
+	)
 }
@@ -25,10 +25,38 @@ package subresourcesv1beta1
 
 import (
 	"k8s.io/api/core/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	corev1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/pool/v1beta1"
+	corev1beta1 "kubevirt.io/virt-template-api/core/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVirtualMachineTemplate) DeepCopyInto(out *ClusterVirtualMachineTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVirtualMachineTemplate.
+func (in *ClusterVirtualMachineTemplate) DeepCopy() *ClusterVirtualMachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVirtualMachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterVirtualMachineTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProcessOptions) DeepCopyInto(out *ProcessOptions) {
 	*out = *in
@@ -41,6 +69,21 @@ func (in *ProcessOptions) DeepCopyInto(out *ProcessOptions) {
 			(*out)[key] = val
 		}
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(VirtualMachineOverrides)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessOptions.
@@ -76,6 +119,16 @@ func (in *ProcessedVirtualMachineTemplate) DeepCopyInto(out *ProcessedVirtualMac
 		*out = new(corev1.VirtualMachine)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VirtualMachinePool != nil {
+		in, out := &in.VirtualMachinePool, &out.VirtualMachinePool
+		*out = new(v1beta1.VirtualMachinePool)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VirtualMachineInstanceReplicaSet != nil {
+		in, out := &in.VirtualMachineInstanceReplicaSet, &out.VirtualMachineInstanceReplicaSet
+		*out = new(corev1.VirtualMachineInstanceReplicaSet)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessedVirtualMachineTemplate.
@@ -96,6 +149,253 @@ func (in *ProcessedVirtualMachineTemplate) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessedVirtualMachineTemplateBatch) DeepCopyInto(out *ProcessedVirtualMachineTemplateBatch) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProcessedVirtualMachineTemplateBatchItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessedVirtualMachineTemplateBatch.
+func (in *ProcessedVirtualMachineTemplateBatch) DeepCopy() *ProcessedVirtualMachineTemplateBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessedVirtualMachineTemplateBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProcessedVirtualMachineTemplateBatch) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessedVirtualMachineTemplateBatchError) DeepCopyInto(out *ProcessedVirtualMachineTemplateBatchError) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessedVirtualMachineTemplateBatchError.
+func (in *ProcessedVirtualMachineTemplateBatchError) DeepCopy() *ProcessedVirtualMachineTemplateBatchError {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessedVirtualMachineTemplateBatchError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProcessedVirtualMachineTemplateBatchItem) DeepCopyInto(out *ProcessedVirtualMachineTemplateBatchItem) {
+	*out = *in
+	if in.VirtualMachine != nil {
+		in, out := &in.VirtualMachine, &out.VirtualMachine
+		*out = new(corev1.VirtualMachine)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(ProcessedVirtualMachineTemplateBatchError)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessedVirtualMachineTemplateBatchItem.
+func (in *ProcessedVirtualMachineTemplateBatchItem) DeepCopy() *ProcessedVirtualMachineTemplateBatchItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ProcessedVirtualMachineTemplateBatchItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackOptions) DeepCopyInto(out *RollbackOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackOptions.
+func (in *RollbackOptions) DeepCopy() *RollbackOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RollbackOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolledBackVirtualMachineTemplate) DeepCopyInto(out *RolledBackVirtualMachineTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolledBackVirtualMachineTemplate.
+func (in *RolledBackVirtualMachineTemplate) DeepCopy() *RolledBackVirtualMachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(RolledBackVirtualMachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolledBackVirtualMachineTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateInstance) DeepCopyInto(out *TemplateInstance) {
+	*out = *in
+	in.CreationTimestamp.DeepCopyInto(&out.CreationTimestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateInstance.
+func (in *TemplateInstance) DeepCopy() *TemplateInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeOptions) DeepCopyInto(out *UpgradeOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeOptions.
+func (in *UpgradeOptions) DeepCopy() *UpgradeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpgradeOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineOverrides) DeepCopyInto(out *VirtualMachineOverrides) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RunStrategy != nil {
+		in, out := &in.RunStrategy, &out.RunStrategy
+		*out = new(corev1.VirtualMachineRunStrategy)
+		**out = **in
+	}
+	if in.Patch != nil {
+		in, out := &in.Patch, &out.Patch
+		*out = new(VirtualMachinePatch)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineOverrides.
+func (in *VirtualMachineOverrides) DeepCopy() *VirtualMachineOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePatch) DeepCopyInto(out *VirtualMachinePatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePatch.
+func (in *VirtualMachinePatch) DeepCopy() *VirtualMachinePatch {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSpecChange) DeepCopyInto(out *VirtualMachineSpecChange) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSpecChange.
+func (in *VirtualMachineSpecChange) DeepCopy() *VirtualMachineSpecChange {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSpecChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineTemplate) DeepCopyInto(out *VirtualMachineTemplate) {
 	*out = *in
@@ -120,3 +420,164 @@ func (in *VirtualMachineTemplate) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateInstances) DeepCopyInto(out *VirtualMachineTemplateInstances) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]TemplateInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateInstances.
+func (in *VirtualMachineTemplateInstances) DeepCopy() *VirtualMachineTemplateInstances {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateInstances)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineTemplateInstances) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplatePreview) DeepCopyInto(out *VirtualMachineTemplatePreview) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplatePreview.
+func (in *VirtualMachineTemplatePreview) DeepCopy() *VirtualMachineTemplatePreview {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplatePreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineTemplatePreview) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplatePreviewSpec) DeepCopyInto(out *VirtualMachineTemplatePreviewSpec) {
+	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(corev1beta1.VirtualMachineTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(ProcessOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplatePreviewSpec.
+func (in *VirtualMachineTemplatePreviewSpec) DeepCopy() *VirtualMachineTemplatePreviewSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplatePreviewSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplatePreviewStatus) DeepCopyInto(out *VirtualMachineTemplatePreviewStatus) {
+	*out = *in
+	if in.Processed != nil {
+		in, out := &in.Processed, &out.Processed
+		*out = new(ProcessedVirtualMachineTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]metav1.StatusCause, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplatePreviewStatus.
+func (in *VirtualMachineTemplatePreviewStatus) DeepCopy() *VirtualMachineTemplatePreviewStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplatePreviewStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineUpgrade) DeepCopyInto(out *VirtualMachineUpgrade) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]VirtualMachineSpecChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VirtualMachine != nil {
+		in, out := &in.VirtualMachine, &out.VirtualMachine
+		*out = new(corev1.VirtualMachine)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineUpgrade.
+func (in *VirtualMachineUpgrade) DeepCopy() *VirtualMachineUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineUpgrade) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -25,6 +25,20 @@ const (
 	FinalizerSnapshotCleanup = templateapi.GroupName + "/SnapshotCleanup"
 	LabelRequestUID          = templateapi.GroupName + "/RequestUID"
 
+	// LabelTemplateUID is set on VirtualMachines processed from a VirtualMachineTemplate
+	// and holds the UID of the source template.
+	LabelTemplateUID = templateapi.GroupName + "/TemplateUID"
+	// AnnotationTemplateName, AnnotationTemplateNamespace and AnnotationTemplateGeneration
+	// record the name, namespace and generation of the source VirtualMachineTemplate.
+	AnnotationTemplateName       = templateapi.GroupName + "/TemplateName"
+	AnnotationTemplateNamespace  = templateapi.GroupName + "/TemplateNamespace"
+	AnnotationTemplateGeneration = templateapi.GroupName + "/TemplateGeneration"
+	// AnnotationTemplateRevision records the revision of the source VirtualMachineTemplate.
+	AnnotationTemplateRevision = templateapi.GroupName + "/TemplateRevision"
+	// AnnotationTemplateParameters records the parameter values the source VirtualMachineTemplate
+	// was processed with as a JSON object.
+	AnnotationTemplateParameters = templateapi.GroupName + "/TemplateParameters"
+
 	ConditionReady       = "Ready"
 	ConditionProgressing = "Progressing"
 
@@ -29,6 +29,51 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Generalization) DeepCopyInto(out *Generalization) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Generalization.
+func (in *Generalization) DeepCopy() *Generalization {
+	if in == nil {
+		return nil
+	}
+	out := new(Generalization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancetypeCapture) DeepCopyInto(out *InstancetypeCapture) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancetypeCapture.
+func (in *InstancetypeCapture) DeepCopy() *InstancetypeCapture {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancetypeCapture)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Parameter) DeepCopyInto(out *Parameter) {
 	*out = *in
@@ -44,6 +89,104 @@ func (in *Parameter) DeepCopy() *Parameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Sanitization) DeepCopyInto(out *Sanitization) {
+	*out = *in
+	if in.Strip != nil {
+		in, out := &in.Strip, &out.Strip
+		*out = make([]SanitizationRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Keep != nil {
+		in, out := &in.Keep, &out.Keep
+		*out = make([]SanitizationRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sanitization.
+func (in *Sanitization) DeepCopy() *Sanitization {
+	if in == nil {
+		return nil
+	}
+	out := new(Sanitization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageOverride) DeepCopyInto(out *StorageOverride) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]corev1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeMode != nil {
+		in, out := &in.VolumeMode, &out.VolumeMode
+		*out = new(corev1.PersistentVolumeMode)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageOverride.
+func (in *StorageOverride) DeepCopy() *StorageOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageOverrides) DeepCopyInto(out *StorageOverrides) {
+	*out = *in
+	in.StorageOverride.DeepCopyInto(&out.StorageOverride)
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VolumeStorageOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageOverrides.
+func (in *StorageOverrides) DeepCopy() *StorageOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateParameterization) DeepCopyInto(out *TemplateParameterization) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateParameterization.
+func (in *TemplateParameterization) DeepCopy() *TemplateParameterization {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateParameterization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineReference) DeepCopyInto(out *VirtualMachineReference) {
 	*out = *in
@@ -118,6 +261,21 @@ func (in *VirtualMachineTemplateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateReference) DeepCopyInto(out *VirtualMachineTemplateReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateReference.
+func (in *VirtualMachineTemplateReference) DeepCopy() *VirtualMachineTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineTemplateRequest) DeepCopyInto(out *VirtualMachineTemplateRequest) {
 	*out = *in
@@ -181,6 +339,11 @@ func (in *VirtualMachineTemplateRequestList) DeepCopyObject() runtime.Object {
 func (in *VirtualMachineTemplateRequestSpec) DeepCopyInto(out *VirtualMachineTemplateRequestSpec) {
 	*out = *in
 	out.VirtualMachineRef = in.VirtualMachineRef
+	if in.VirtualMachineTemplateRef != nil {
+		in, out := &in.VirtualMachineTemplateRef, &out.VirtualMachineTemplateRef
+		*out = new(VirtualMachineTemplateReference)
+		**out = **in
+	}
 	if in.TemplateLabels != nil {
 		in, out := &in.TemplateLabels, &out.TemplateLabels
 		*out = make(map[string]string, len(*in))
@@ -193,6 +356,31 @@ func (in *VirtualMachineTemplateRequestSpec) DeepCopyInto(out *VirtualMachineTem
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Instancetypes != nil {
+		in, out := &in.Instancetypes, &out.Instancetypes
+		*out = new(InstancetypeCapture)
+		**out = **in
+	}
+	if in.Parameterize != nil {
+		in, out := &in.Parameterize, &out.Parameterize
+		*out = new(TemplateParameterization)
+		**out = **in
+	}
+	if in.Sanitization != nil {
+		in, out := &in.Sanitization, &out.Sanitization
+		*out = new(Sanitization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Generalization != nil {
+		in, out := &in.Generalization, &out.Generalization
+		*out = new(Generalization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(StorageOverrides)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateRequestSpec.
@@ -220,6 +408,11 @@ func (in *VirtualMachineTemplateRequestStatus) DeepCopyInto(out *VirtualMachineT
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.SanitizedFields != nil {
+		in, out := &in.SanitizedFields, &out.SanitizedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateRequestStatus.
@@ -245,6 +438,16 @@ func (in *VirtualMachineTemplateSpec) DeepCopyInto(out *VirtualMachineTemplateSp
 		*out = make([]Parameter, len(*in))
 		copy(*out, *in)
 	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PatchablePaths != nil {
+		in, out := &in.PatchablePaths, &out.PatchablePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateSpec.
@@ -267,6 +470,16 @@ func (in *VirtualMachineTemplateStatus) DeepCopyInto(out *VirtualMachineTemplate
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LatestInstance != nil {
+		in, out := &in.LatestInstance, &out.LatestInstance
+		*out = new(VirtualMachineReference)
+		**out = **in
+	}
+	if in.OutdatedInstances != nil {
+		in, out := &in.OutdatedInstances, &out.OutdatedInstances
+		*out = make([]VirtualMachineReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateStatus.
@@ -278,3 +491,19 @@ func (in *VirtualMachineTemplateStatus) DeepCopy() *VirtualMachineTemplateStatus
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeStorageOverride) DeepCopyInto(out *VolumeStorageOverride) {
+	*out = *in
+	in.StorageOverride.DeepCopyInto(&out.StorageOverride)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeStorageOverride.
+func (in *VolumeStorageOverride) DeepCopy() *VolumeStorageOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeStorageOverride)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,69 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Instances",type=integer,JSONPath=`.status.instances`
+// +kubebuilder:resource:scope=Cluster,shortName=cvmt;cvmts
+// +kubebuilder:subresource:status
+// +genclient
+// +genclient:nonNamespaced
+
+// ClusterVirtualMachineTemplate is the Schema for the clustervirtualmachinetemplates API.
+// It has the same spec as a VirtualMachineTemplate, but is cluster-scoped and can be
+// processed into any namespace. Revisions are not recorded for cluster-scoped templates.
+type ClusterVirtualMachineTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +kubebuilder:validation:Optional
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired state of the template
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Spec VirtualMachineTemplateSpec `json:"spec" protobuf:"bytes,2,name=spec"`
+
+	// Status defines the observed state of the template
+	//
+	// +kubebuilder:validation:Optional
+	// +optional
+	Status VirtualMachineTemplateStatus `json:"status,omitempty,omitzero" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterVirtualMachineTemplateList contains a list of ClusterVirtualMachineTemplate
+type ClusterVirtualMachineTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []ClusterVirtualMachineTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterVirtualMachineTemplate{}, &ClusterVirtualMachineTemplateList{})
+}
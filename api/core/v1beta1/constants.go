@@ -25,12 +25,48 @@ const (
 	FinalizerSnapshotCleanup = templateapi.GroupName + "/SnapshotCleanup"
 	LabelRequestUID          = templateapi.GroupName + "/RequestUID"
 
+	// LabelSnapshotSource is set on the VirtualMachineSnapshots and VirtualMachineSnapshotContents
+	// VirtualMachineTemplateRequests create templates from, including existing snapshots
+	// referenced by requests, so that the controller caches and watches them.
+	LabelSnapshotSource = templateapi.GroupName + "/SnapshotSource"
+
+	// FinalizerVirtualMachineCleanup is set on VirtualMachineTemplateInstances to apply
+	// their deletion policy to the managed VirtualMachine.
+	FinalizerVirtualMachineCleanup = templateapi.GroupName + "/VirtualMachineCleanup"
+
+	// LabelTemplateUID is set on VirtualMachines processed from a VirtualMachineTemplate
+	// and holds the UID of the source template.
+	LabelTemplateUID = templateapi.GroupName + "/TemplateUID"
+	// AnnotationTemplateName, AnnotationTemplateNamespace and AnnotationTemplateGeneration
+	// record the name, namespace and generation of the source VirtualMachineTemplate.
+	AnnotationTemplateName       = templateapi.GroupName + "/TemplateName"
+	AnnotationTemplateNamespace  = templateapi.GroupName + "/TemplateNamespace"
+	AnnotationTemplateGeneration = templateapi.GroupName + "/TemplateGeneration"
+	// AnnotationTemplateRevision records the revision of the source VirtualMachineTemplate.
+	AnnotationTemplateRevision = templateapi.GroupName + "/TemplateRevision"
+	// AnnotationTemplateParameters records the parameter values the source VirtualMachineTemplate
+	// was processed with as a JSON object.
+	AnnotationTemplateParameters = templateapi.GroupName + "/TemplateParameters"
+	// AnnotationTemplateOverrides records the overrides the source VirtualMachineTemplate
+	// was processed with as a JSON object.
+	AnnotationTemplateOverrides = templateapi.GroupName + "/TemplateOverrides"
+	// AnnotationIdempotencyKey records the idempotency key of the request that created a
+	// VirtualMachine from a template.
+	AnnotationIdempotencyKey = templateapi.GroupName + "/IdempotencyKey"
+
+	// LabelOutputName is set on the VirtualMachines of a VirtualMachinePool and the
+	// VirtualMachineInstances of a VirtualMachineInstanceReplicaSet processed from a
+	// template, and holds the name of the pool or replica set selecting them.
+	LabelOutputName = templateapi.GroupName + "/OutputName"
+
 	ConditionReady       = "Ready"
 	ConditionProgressing = "Progressing"
+	ConditionUpToDate    = "UpToDate"
 
 	ReasonReconciled           = "Reconciled"
 	ReasonReconciling          = "Reconciling"
 	ReasonInvalidConfiguration = "InvalidConfiguration"
 	ReasonFailed               = "Failed"
 	ReasonWaiting              = "Waiting"
+	ReasonOutdated             = "Outdated"
 )
@@ -49,6 +49,26 @@ type VirtualMachineTemplateSpec struct {
 	// +kubebuilder:validation:Optional
 	// +optional
 	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+
+	// RevisionHistoryLimit is the number of ControllerRevisions to retain for this
+	// template. Each change to the template is recorded in a new revision, which
+	// allows to process a previous revision or to roll back to it. The current
+	// revision is always retained. Defaults to 10.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty" protobuf:"varint,4,opt,name=revisionHistoryLimit"`
+
+	// PatchablePaths restricts the overrides a request processing the template can apply
+	// to the processed VirtualMachine. Each path is a JSON pointer like /spec/runStrategy
+	// that allows changes at or below it. If unset, all overrides are allowed.
+	//
+	// +kubebuilder:validation:items:Pattern=`^/`
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	// +optional
+	PatchablePaths []string `json:"patchablePaths,omitempty" protobuf:"bytes,5,rep,name=patchablePaths"`
 }
 
 // Parameter defines a name/value combination that is to be substituted during
@@ -143,11 +163,43 @@ type VirtualMachineTemplateStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" protobuf:"bytes,1,rep,name=conditions"`
+
+	// Instances is the number of VirtualMachines that were created from this template.
+	//
+	// +kubebuilder:validation:Optional
+	// +optional
+	Instances int32 `json:"instances,omitempty" protobuf:"varint,2,opt,name=instances"`
+
+	// LatestInstance is a reference to the most recently created VirtualMachine
+	// in the namespace of this template that was created from this template.
+	//
+	// +kubebuilder:validation:Optional
+	// +optional
+	LatestInstance *VirtualMachineReference `json:"latestInstance,omitempty" protobuf:"bytes,3,opt,name=latestInstance"`
+
+	// OutdatedInstances holds references to VirtualMachines in the namespace of this
+	// template that were created from an older generation of this template. At most
+	// 100 references are listed, the instances subresource can be used to retrieve all of them.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=100
+	// +optional
+	// +listType=atomic
+	OutdatedInstances []VirtualMachineReference `json:"outdatedInstances,omitempty" protobuf:"bytes,4,rep,name=outdatedInstances"`
+
+	// Revision is the number of the ControllerRevision that holds the current
+	// state of the template.
+	//
+	// +kubebuilder:validation:Optional
+	// +optional
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,5,opt,name=revision"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Instances",type=integer,JSONPath=`.status.instances`
+// +kubebuilder:printcolumn:name="Revision",type=integer,JSONPath=`.status.revision`
 // +kubebuilder:resource:shortName=vmt;vmts
 // +kubebuilder:subresource:status
 // +genclient
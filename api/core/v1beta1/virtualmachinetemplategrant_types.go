@@ -0,0 +1,149 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineTemplateGrantSpec defines which namespaces and subjects may process
+// the VirtualMachineTemplates in the namespace of the grant into their namespace.
+type VirtualMachineTemplateGrantSpec struct {
+	// From lists the namespaces and subjects that are granted to process templates
+	// into the listed namespaces.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	// +required
+	From []VirtualMachineTemplateGrantFrom `json:"from" protobuf:"bytes,1,rep,name=from"`
+
+	// To lists the templates in the namespace of the grant that may be processed.
+	// If empty, all templates in the namespace of the grant may be processed.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	// +optional
+	To []VirtualMachineTemplateGrantTo `json:"to,omitempty" protobuf:"bytes,2,rep,name=to"`
+}
+
+// VirtualMachineTemplateGrantFrom describes a target namespace of a VirtualMachineTemplateGrant.
+type VirtualMachineTemplateGrantFrom struct {
+	// Namespace is the namespace templates may be processed into.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +required
+	Namespace string `json:"namespace" protobuf:"bytes,1,name=namespace"`
+
+	// Subjects lists the subjects that may process templates into the namespace.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	// +required
+	Subjects []VirtualMachineTemplateGrantSubject `json:"subjects" protobuf:"bytes,2,rep,name=subjects"`
+}
+
+// VirtualMachineTemplateGrantSubjectKind is the kind of a VirtualMachineTemplateGrantSubject.
+//
+// +kubebuilder:validation:Enum=User;Group;ServiceAccount
+type VirtualMachineTemplateGrantSubjectKind string
+
+const (
+	// GrantSubjectUser matches a user by name.
+	GrantSubjectUser VirtualMachineTemplateGrantSubjectKind = "User"
+	// GrantSubjectGroup matches all members of a group.
+	GrantSubjectGroup VirtualMachineTemplateGrantSubjectKind = "Group"
+	// GrantSubjectServiceAccount matches a service account by namespace and name.
+	GrantSubjectServiceAccount VirtualMachineTemplateGrantSubjectKind = "ServiceAccount"
+)
+
+// VirtualMachineTemplateGrantSubject references a user, group or service account.
+//
+// +kubebuilder:validation:XValidation:rule="(self.kind == 'ServiceAccount') == has(self.__namespace__)",message="namespace is required for and only allowed for ServiceAccount subjects"
+type VirtualMachineTemplateGrantSubject struct {
+	// Kind is the kind of the subject.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Kind VirtualMachineTemplateGrantSubjectKind `json:"kind" protobuf:"bytes,1,name=kind,casttype=VirtualMachineTemplateGrantSubjectKind"`
+
+	// Name is the name of the subject.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name" protobuf:"bytes,2,name=name"`
+
+	// Namespace is the namespace of a ServiceAccount subject.
+	//
+	// +kubebuilder:validation:Optional
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+}
+
+// VirtualMachineTemplateGrantTo references a template in the namespace of a VirtualMachineTemplateGrant.
+type VirtualMachineTemplateGrantTo struct {
+	// Name is the name of the template.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:resource:shortName=vmtg;vmtgs
+// +genclient
+
+// VirtualMachineTemplateGrant is the Schema for the virtualmachinetemplategrants API.
+// Like a Gateway API ReferenceGrant it lives in the namespace of the templates and
+// allows processing them into other namespaces.
+type VirtualMachineTemplateGrant struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +kubebuilder:validation:Optional
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the granted namespaces, subjects and templates
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Spec VirtualMachineTemplateGrantSpec `json:"spec" protobuf:"bytes,2,name=spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineTemplateGrantList contains a list of VirtualMachineTemplateGrant
+type VirtualMachineTemplateGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []VirtualMachineTemplateGrant `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VirtualMachineTemplateGrant{}, &VirtualMachineTemplateGrantList{})
+}
@@ -0,0 +1,175 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InstanceUpdatePolicy defines how a VirtualMachineTemplateInstance handles changes
+// to its template or parameters after its VirtualMachine was created.
+// +kubebuilder:validation:Enum=Automatic;Manual
+type InstanceUpdatePolicy string
+
+const (
+	// InstanceUpdatePolicyAutomatic updates the VirtualMachine whenever the rendered spec changes.
+	InstanceUpdatePolicyAutomatic InstanceUpdatePolicy = "Automatic"
+	// InstanceUpdatePolicyManual only reports that the VirtualMachine is out of date.
+	InstanceUpdatePolicyManual InstanceUpdatePolicy = "Manual"
+)
+
+// InstanceDeletionPolicy defines what happens to the VirtualMachine of a
+// VirtualMachineTemplateInstance when the instance is deleted.
+// +kubebuilder:validation:Enum=Delete;Orphan
+type InstanceDeletionPolicy string
+
+const (
+	// InstanceDeletionPolicyDelete deletes the VirtualMachine together with the instance.
+	InstanceDeletionPolicyDelete InstanceDeletionPolicy = "Delete"
+	// InstanceDeletionPolicyOrphan keeps the VirtualMachine and releases it from the instance.
+	InstanceDeletionPolicyOrphan InstanceDeletionPolicy = "Orphan"
+)
+
+// VirtualMachineTemplateInstanceSpec defines the desired state of VirtualMachineTemplateInstance
+type VirtualMachineTemplateInstanceSpec struct {
+	// TemplateRef references the VirtualMachineTemplate the VirtualMachine is rendered from.
+	// The template must exist in the namespace of the instance.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	TemplateRef corev1.LocalObjectReference `json:"templateRef" protobuf:"bytes,1,name=templateRef"`
+
+	// Parameters holds the values of the template parameters the VirtualMachine is rendered with.
+	// Values of parameters not listed here are taken from the template. Generated values
+	// are generated once and kept for the lifetime of the VirtualMachine.
+	//
+	// +kubebuilder:validation:Optional
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty" protobuf:"bytes,2,rep,name=parameters"`
+
+	// Revision pins the instance to a revision of the template. If not specified,
+	// the instance follows the current revision of the template.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	// +optional
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,3,opt,name=revision"`
+
+	// UpdatePolicy defines whether changes to the template or parameters are applied to
+	// an existing VirtualMachine. The run strategy of the VirtualMachine is never updated.
+	// Defaults to Automatic.
+	//
+	// +kubebuilder:default=Automatic
+	// +kubebuilder:validation:Optional
+	// +optional
+	UpdatePolicy InstanceUpdatePolicy `json:"updatePolicy,omitempty" protobuf:"bytes,4,opt,name=updatePolicy,casttype=InstanceUpdatePolicy"`
+
+	// DeletionPolicy defines whether the VirtualMachine is deleted together with the instance.
+	// Defaults to Delete.
+	//
+	// +kubebuilder:default=Delete
+	// +kubebuilder:validation:Optional
+	// +optional
+	DeletionPolicy InstanceDeletionPolicy `json:"deletionPolicy,omitempty" protobuf:"bytes,5,opt,name=deletionPolicy,casttype=InstanceDeletionPolicy"`
+}
+
+// VirtualMachineTemplateInstanceStatus defines the observed state of VirtualMachineTemplateInstance.
+type VirtualMachineTemplateInstanceStatus struct {
+	// Conditions represent the current state of the template instance.
+	// Each condition has a unique type and reflects the status of a specific aspect of the resource.
+	//
+	// Condition types include:
+	// - "Ready": the VirtualMachine was rendered and exists
+	// - "UpToDate": the VirtualMachine matches the rendered template
+	//
+	// The status of each condition is one of True, False, or Unknown.
+	//
+	// +kubebuilder:validation:Optional
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" protobuf:"bytes,1,rep,name=conditions"`
+
+	// VirtualMachineRef is a reference to the VirtualMachine managed by the instance.
+	// +kubebuilder:validation:Optional
+	// +optional
+	VirtualMachineRef *VirtualMachineReference `json:"virtualMachineRef,omitempty" protobuf:"bytes,2,opt,name=virtualMachineRef"`
+
+	// TemplateGeneration is the generation of the template the VirtualMachine was last rendered from.
+	// +kubebuilder:validation:Optional
+	// +optional
+	TemplateGeneration int64 `json:"templateGeneration,omitempty" protobuf:"varint,3,opt,name=templateGeneration"`
+
+	// Revision is the revision of the template the VirtualMachine was last rendered from.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Revision int64 `json:"revision,omitempty" protobuf:"varint,4,opt,name=revision"`
+
+	// Message is the processed message of the template.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Template",type=string,JSONPath=`.spec.templateRef.name`
+// +kubebuilder:printcolumn:name="VirtualMachine",type=string,JSONPath=`.status.virtualMachineRef.name`
+// +kubebuilder:printcolumn:name="Revision",type=integer,JSONPath=`.status.revision`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="UpToDate",type=string,JSONPath=`.status.conditions[?(@.type=="UpToDate")].status`
+// +kubebuilder:resource:shortName=vmti;vmtis
+// +kubebuilder:subresource:status
+// +genclient
+
+// VirtualMachineTemplateInstance is the Schema for the virtualmachinetemplateinstances API
+type VirtualMachineTemplateInstance struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +kubebuilder:validation:Optional
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired state of the template instance
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	Spec VirtualMachineTemplateInstanceSpec `json:"spec" protobuf:"bytes,2,name=spec"`
+
+	// Status defines the observed state of the template instance
+	//
+	// +kubebuilder:validation:Optional
+	// +optional
+	Status VirtualMachineTemplateInstanceStatus `json:"status,omitempty,omitzero" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineTemplateInstanceList contains a list of VirtualMachineTemplateInstance
+type VirtualMachineTemplateInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineTemplateInstance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VirtualMachineTemplateInstance{}, &VirtualMachineTemplateInstanceList{})
+}
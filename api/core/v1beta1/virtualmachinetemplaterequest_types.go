@@ -21,16 +21,37 @@ package v1beta1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // VirtualMachineTemplateRequestSpec defines the desired state of VirtualMachineTemplateRequest
 // +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(self.generalization) || !has(self.diskStrategy) || self.diskStrategy == 'Clone'",message="generalization requires the disk strategy Clone"
+// +kubebuilder:validation:XValidation:rule="!has(self.virtualMachineSnapshotName) || !has(self.diskStrategy) || self.diskStrategy == 'Clone'",message="virtualMachineSnapshotName requires the disk strategy Clone"
+// +kubebuilder:validation:XValidation:rule="has(self.virtualMachineRef) != has(self.virtualMachineTemplateRef)",message="exactly one of virtualMachineRef and virtualMachineTemplateRef must be specified"
+// +kubebuilder:validation:XValidation:rule="!has(self.virtualMachineTemplateRef) || (!has(self.virtualMachineSnapshotName) && !has(self.instancetypes) && !has(self.captureBackendStorage) && !has(self.parameterize) && !has(self.sanitization) && !has(self.generalization) && !has(self.diskStrategy))",message="virtualMachineTemplateRef only supports templateName, templateLabels, storage and dataVolumeTemplateSource"
 type VirtualMachineTemplateRequestSpec struct {
-	// VirtualMachineReference holds a reference to a VirtualMachine.kubevirt.io
-	// +kubebuilder:validation:Required
-	// +required
-	VirtualMachineRef VirtualMachineReference `json:"virtualMachineRef" protobuf:"bytes,1,name=virtualMachineRef"`
+	// VirtualMachineReference holds a reference to a VirtualMachine.kubevirt.io.
+	// Exactly one of virtualMachineRef and virtualMachineTemplateRef must be specified.
+	// +kubebuilder:validation:Optional
+	// +optional
+	VirtualMachineRef VirtualMachineReference `json:"virtualMachineRef,omitempty,omitzero" protobuf:"bytes,1,opt,name=virtualMachineRef"`
+
+	// VirtualMachineTemplateRef holds a reference to a VirtualMachineTemplate to copy into the namespace
+	// of the request, including its disks. The PVCs and VolumeSnapshots referenced by the DataVolumeTemplates
+	// of the template are cloned and the references are rewritten to the clones.
+	// Exactly one of virtualMachineRef and virtualMachineTemplateRef must be specified.
+	// +kubebuilder:validation:Optional
+	// +optional
+	VirtualMachineTemplateRef *VirtualMachineTemplateReference `json:"virtualMachineTemplateRef,omitempty" protobuf:"bytes,14,opt,name=virtualMachineTemplateRef"` //nolint:lll
+
+	// VirtualMachineSnapshotName holds the optional name of an existing VirtualMachineSnapshot of the
+	// VirtualMachine in its namespace. If specified, the template is created from this snapshot instead
+	// of a new snapshot of the VirtualMachine, and the snapshot is kept after the template was created.
+	// +kubebuilder:validation:Optional
+	// +optional
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName,omitempty" protobuf:"bytes,13,opt,name=virtualMachineSnapshotName"`
 
 	// TemplateName holds the optional name for the new VirtualMachineTemplate.
 	// If not specified the template will have the same name as the VirtualMachineTemplateRequest.
@@ -56,6 +77,317 @@ type VirtualMachineTemplateRequestSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty" protobuf:"varint,4,opt,name=ttlSecondsAfterFinished"`
+
+	// Instancetypes controls how the instancetype and preference referenced by the
+	// VirtualMachine are captured in the template. If unset, they are expanded into
+	// the template and the references are removed.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Instancetypes *InstancetypeCapture `json:"instancetypes,omitempty" protobuf:"bytes,5,opt,name=instancetypes"`
+
+	// CaptureBackendStorage clones the persistent backend storage of the VirtualMachine, which
+	// holds its persistent EFI variables and vTPM state, into the template. VirtualMachines
+	// created from the template adopt a clone of it instead of starting with empty state.
+	// This shares the vTPM state of the source VirtualMachine with all of them: secrets sealed
+	// by its vTPM, like BitLocker keys or credentials, can be unsealed by every VirtualMachine
+	// created from the template. Only capture the backend storage of generalized VirtualMachines
+	// whose vTPM holds no secrets. Defaults to false.
+	// +kubebuilder:validation:Optional
+	// +optional
+	CaptureBackendStorage bool `json:"captureBackendStorage,omitempty" protobuf:"varint,6,opt,name=captureBackendStorage"`
+
+	// Parameterize selects settings of the VirtualMachine whose captured values are replaced
+	// with parameters in the template. The parameters default to the captured values. The names
+	// of the instancetype and preference are parameterized with instancetypes.parameterize.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Parameterize *TemplateParameterization `json:"parameterize,omitempty" protobuf:"bytes,7,opt,name=parameterize"`
+
+	// Sanitization controls which settings specific to the source VirtualMachine are removed
+	// from the template. If unset, the rules of the default profile of the cluster are applied.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Sanitization *Sanitization `json:"sanitization,omitempty" protobuf:"bytes,8,opt,name=sanitization"`
+
+	// Generalization runs a Job against the cloned disks before the template is created, e.g. to
+	// remove SSH host keys, the machine ID, cloud-init state or Windows SIDs with virt-sysprep.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Generalization *Generalization `json:"generalization,omitempty" protobuf:"bytes,9,opt,name=generalization"`
+
+	// Storage overrides the storage of the DataVolumes cloned from the volumes of the VirtualMachine
+	// and of the DataVolumeTemplates in the template. If unset, the storage is determined by CDI.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Storage *StorageOverrides `json:"storage,omitempty" protobuf:"bytes,10,opt,name=storage"`
+
+	// DataVolumeTemplateSource selects how the DataVolumeTemplates of the template refer to the cloned
+	// volumes. PVC refers to the cloned PVCs directly. DataSource creates a CDI DataSource per cloned
+	// volume and refers to it, so that the volumes can be replaced without changing the template.
+	// Defaults to PVC.
+	// +kubebuilder:validation:Optional
+	// +optional
+	DataVolumeTemplateSource DataVolumeTemplateSource `json:"dataVolumeTemplateSource,omitempty" protobuf:"bytes,11,opt,name=dataVolumeTemplateSource,casttype=DataVolumeTemplateSource"` //nolint:lll
+
+	// DiskStrategy selects how the volumes of the VirtualMachine are captured. Clone clones the
+	// VolumeSnapshots of the volumes into DataVolumes owned by the template. Snapshot keeps the
+	// VolumeSnapshots instead and lets the DataVolumeTemplates of the template clone them, which
+	// is cheaper and faster with CSI drivers that clone snapshots efficiently. Snapshot requires
+	// the VirtualMachine to be in the namespace of the request. Defaults to Clone.
+	// +kubebuilder:validation:Optional
+	// +optional
+	DiskStrategy DiskStrategy `json:"diskStrategy,omitempty" protobuf:"bytes,12,opt,name=diskStrategy,casttype=DiskStrategy"`
+}
+
+// StorageOverrides overrides the storage of the cloned volumes of a VirtualMachine. The overrides
+// apply to all volumes, the overrides of a single volume take precedence over them.
+type StorageOverrides struct {
+	StorageOverride `json:",inline" protobuf:"bytes,1,opt,name=storageOverride"`
+
+	// Volumes overrides the storage of single volumes of the VirtualMachine.
+	// +kubebuilder:validation:Optional
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Volumes []VolumeStorageOverride `json:"volumes,omitempty" protobuf:"bytes,2,rep,name=volumes"`
+}
+
+// VolumeStorageOverride overrides the storage of a single volume of a VirtualMachine.
+type VolumeStorageOverride struct {
+	// Name is the name of the volume in the VirtualMachine.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
+
+	StorageOverride `json:",inline" protobuf:"bytes,2,opt,name=storageOverride"`
+}
+
+// StorageOverride overrides the storage of a cloned volume. Unset fields are determined by CDI.
+type StorageOverride struct {
+	// StorageClassName is the name of the StorageClass of the volume.
+	// +kubebuilder:validation:Optional
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty" protobuf:"bytes,1,opt,name=storageClassName"`
+
+	// AccessModes are the access modes of the volume.
+	// +kubebuilder:validation:Optional
+	// +optional
+	// +listType=atomic
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty" protobuf:"bytes,2,rep,name=accessModes,casttype=k8s.io/api/core/v1.PersistentVolumeAccessMode"` //nolint:lll
+
+	// VolumeMode is the volume mode of the volume.
+	// +kubebuilder:validation:Optional
+	// +optional
+	VolumeMode *corev1.PersistentVolumeMode `json:"volumeMode,omitempty" protobuf:"bytes,3,opt,name=volumeMode,casttype=k8s.io/api/core/v1.PersistentVolumeMode"` //nolint:lll
+
+	// Size is the requested size of the volume. It must not be smaller than the size of the source volume.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty" protobuf:"bytes,4,opt,name=size"`
+}
+
+// Generalization configures the Job generalizing the cloned disks of a VirtualMachine. The Job runs
+// in the namespace of the request with the default service account of the namespace. Disks on
+// filesystem volumes are mounted at /disks/<volume name>, with the disk image at
+// /disks/<volume name>/disk.img. Disks on block volumes are attached at /dev/disks/<volume name>.
+// The environment variable DISKS holds the space separated paths of the disk images and devices.
+type Generalization struct {
+	// Image is the container image generalizing the disks, e.g. an image providing virt-sysprep.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Image string `json:"image" protobuf:"bytes,1,name=image"`
+
+	// Command overrides the entrypoint of the image.
+	// +kubebuilder:validation:Optional
+	// +optional
+	// +listType=atomic
+	Command []string `json:"command,omitempty" protobuf:"bytes,2,rep,name=command"`
+
+	// Args are the arguments passed to the entrypoint of the image.
+	// +kubebuilder:validation:Optional
+	// +optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty" protobuf:"bytes,3,rep,name=args"`
+
+	// ActiveDeadlineSeconds limits the duration of the Job. The request fails if the Job
+	// does not complete in time.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty" protobuf:"varint,4,opt,name=activeDeadlineSeconds"`
+}
+
+// DiskStrategy is the strategy for capturing the volumes of a VirtualMachine.
+// +kubebuilder:validation:Enum=Clone;Snapshot
+// +enum
+type DiskStrategy string
+
+const (
+	// DiskStrategyClone clones the VolumeSnapshots of the volumes into DataVolumes.
+	DiskStrategyClone DiskStrategy = "Clone"
+	// DiskStrategySnapshot keeps the VolumeSnapshots of the volumes.
+	DiskStrategySnapshot DiskStrategy = "Snapshot"
+)
+
+// DataVolumeTemplateSource is the kind of source the DataVolumeTemplates of a template refer to.
+// +kubebuilder:validation:Enum=PVC;DataSource
+// +enum
+type DataVolumeTemplateSource string
+
+const (
+	// DataVolumeTemplateSourcePVC refers to the cloned PVCs.
+	DataVolumeTemplateSourcePVC DataVolumeTemplateSource = "PVC"
+	// DataVolumeTemplateSourceDataSource refers to CDI DataSources pointing at the cloned PVCs.
+	DataVolumeTemplateSourceDataSource DataVolumeTemplateSource = "DataSource"
+)
+
+// SanitizationProfile is a built-in set of sanitization rules.
+// +kubebuilder:validation:Enum=Minimal;Default;Aggressive
+// +enum
+type SanitizationProfile string
+
+const (
+	// SanitizationProfileMinimal removes the MAC addresses and the firmware identifiers.
+	SanitizationProfileMinimal SanitizationProfile = "Minimal"
+	// SanitizationProfileDefault additionally removes the hostname, the labels and annotations of the
+	// VirtualMachineInstance template and the node affinity.
+	SanitizationProfileDefault SanitizationProfile = "Default"
+	// SanitizationProfileAggressive additionally removes the access credentials and the cloud-init volumes.
+	SanitizationProfileAggressive SanitizationProfile = "Aggressive"
+)
+
+// SanitizationRule selects settings specific to the source VirtualMachine that are removed from the template.
+// +kubebuilder:validation:Enum=MACAddresses;FirmwareIdentifiers;Hostname;Labels;Annotations;NodeAffinity;AccessCredentials;CloudInit
+// +enum
+type SanitizationRule string
+
+const (
+	// SanitizationRuleMACAddresses removes the MAC addresses of the interfaces.
+	SanitizationRuleMACAddresses SanitizationRule = "MACAddresses"
+	// SanitizationRuleFirmwareIdentifiers removes the serial and the UUID of the firmware.
+	SanitizationRuleFirmwareIdentifiers SanitizationRule = "FirmwareIdentifiers"
+	// SanitizationRuleHostname removes the hostname and the subdomain.
+	SanitizationRuleHostname SanitizationRule = "Hostname"
+	// SanitizationRuleLabels removes the labels of the VirtualMachineInstance template.
+	SanitizationRuleLabels SanitizationRule = "Labels"
+	// SanitizationRuleAnnotations removes the annotations of the VirtualMachineInstance template.
+	SanitizationRuleAnnotations SanitizationRule = "Annotations"
+	// SanitizationRuleNodeAffinity removes the node selector and the node affinity.
+	SanitizationRuleNodeAffinity SanitizationRule = "NodeAffinity"
+	// SanitizationRuleAccessCredentials removes the access credentials, like SSH public keys.
+	SanitizationRuleAccessCredentials SanitizationRule = "AccessCredentials"
+	// SanitizationRuleCloudInit removes the cloud-init volumes and their disks, including
+	// references to secrets holding cloud-init user or network data.
+	SanitizationRuleCloudInit SanitizationRule = "CloudInit"
+)
+
+// Sanitization controls which settings specific to the source VirtualMachine are removed from the template.
+type Sanitization struct {
+	// Profile is the built-in set of rules to apply. If unset, the default profile of the cluster is used.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Profile SanitizationProfile `json:"profile,omitempty" protobuf:"bytes,1,opt,name=profile,casttype=SanitizationProfile"`
+
+	// Strip lists rules to apply in addition to the rules of the profile.
+	// +kubebuilder:validation:Optional
+	// +optional
+	// +listType=set
+	Strip []SanitizationRule `json:"strip,omitempty" protobuf:"bytes,2,rep,name=strip,casttype=SanitizationRule"`
+
+	// Keep lists rules of the profile not to apply. Keep takes precedence over Strip.
+	// +kubebuilder:validation:Optional
+	// +optional
+	// +listType=set
+	Keep []SanitizationRule `json:"keep,omitempty" protobuf:"bytes,3,rep,name=keep,casttype=SanitizationRule"`
+}
+
+// TemplateParameterization selects settings of a VirtualMachine that are replaced with parameters.
+// Settings that are not set on the VirtualMachine are not parameterized.
+type TemplateParameterization struct {
+	// CPU replaces the number of CPU sockets, cores and threads with the parameters
+	// CPU_SOCKETS, CPU_CORES and CPU_THREADS.
+	// +kubebuilder:validation:Optional
+	// +optional
+	CPU bool `json:"cpu,omitempty" protobuf:"varint,1,opt,name=cpu"`
+
+	// Memory replaces the guest memory, or the requested memory if the guest memory
+	// is not set, with the parameter MEMORY.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Memory bool `json:"memory,omitempty" protobuf:"varint,2,opt,name=memory"`
+
+	// RunStrategy replaces the run strategy with the parameter RUN_STRATEGY.
+	// +kubebuilder:validation:Optional
+	// +optional
+	RunStrategy bool `json:"runStrategy,omitempty" protobuf:"varint,3,opt,name=runStrategy"`
+
+	// CloudInitUserData replaces the inline user data of cloud-init volumes with the
+	// parameter CLOUD_INIT_USER_DATA. Further volumes use the suffixes _2, _3 and so on.
+	// +kubebuilder:validation:Optional
+	// +optional
+	CloudInitUserData bool `json:"cloudInitUserData,omitempty" protobuf:"varint,4,opt,name=cloudInitUserData"`
+
+	// SSHKeys replaces the names of the secrets holding the SSH public keys of the access
+	// credentials with the parameter SSH_KEYS_SECRET. Further secrets use the suffixes _2, _3 and so on.
+	// +kubebuilder:validation:Optional
+	// +optional
+	SSHKeys bool `json:"sshKeys,omitempty" protobuf:"varint,5,opt,name=sshKeys"`
+
+	// NetworkNames replaces the names of the Multus networks with the parameters NETWORK_<NAME>,
+	// where <NAME> is the name of the network in the VirtualMachine in upper case.
+	// +kubebuilder:validation:Optional
+	// +optional
+	NetworkNames bool `json:"networkNames,omitempty" protobuf:"varint,6,opt,name=networkNames"`
+}
+
+// InstancetypeCapturePolicy is the policy for capturing the instancetype and preference of a VirtualMachine.
+// +enum
+type InstancetypeCapturePolicy string
+
+const (
+	// InstancetypeCapturePolicyExpand expands the instancetype and preference into the template.
+	InstancetypeCapturePolicyExpand InstancetypeCapturePolicy = "Expand"
+	// InstancetypeCapturePolicyKeep keeps the references to the instancetype and preference in the template.
+	InstancetypeCapturePolicyKeep InstancetypeCapturePolicy = "Keep"
+)
+
+// InstancetypeCapture controls how the instancetype and preference of a VirtualMachine are captured.
+// +kubebuilder:validation:XValidation:rule="self.policy == 'Keep' || (!(has(self.copy) && self.copy) && !(has(self.parameterize) && self.parameterize))",message="copy and parameterize require the policy Keep"
+type InstancetypeCapture struct {
+	// Policy is Expand to expand the instancetype and preference into the template, or Keep
+	// to keep the references to them. The revisions of the source VirtualMachine are never kept.
+	// +kubebuilder:validation:Enum=Expand;Keep
+	// +kubebuilder:validation:Required
+	// +required
+	Policy InstancetypeCapturePolicy `json:"policy" protobuf:"bytes,1,name=policy,casttype=InstancetypeCapturePolicy"`
+
+	// Copy copies a referenced namespaced VirtualMachineInstancetype or VirtualMachinePreference
+	// into the namespace of the template if it is not in the same namespace as the VirtualMachine.
+	// Existing objects with the same name are not overwritten.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Copy bool `json:"copy,omitempty" protobuf:"varint,2,opt,name=copy"`
+
+	// Parameterize replaces the names of the referenced instancetype and preference with the
+	// parameters INSTANCETYPE and PREFERENCE, whose values default to the captured names.
+	// +kubebuilder:validation:Optional
+	// +optional
+	Parameterize bool `json:"parameterize,omitempty" protobuf:"varint,3,opt,name=parameterize"`
+}
+
+// VirtualMachineTemplateReference holds a reference to a VirtualMachineTemplate.template.kubevirt.io
+type VirtualMachineTemplateReference struct {
+	// Namespace is the namespace of the VirtualMachineTemplate.
+	// +kubebuilder:validation:Required
+	// +required
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+
+	// Name is the name of the VirtualMachineTemplate.
+	// +kubebuilder:validation:Required
+	// +required
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
 }
 
 // VirtualMachineReference holds a reference to a VirtualMachine.kubevirt.io
@@ -91,6 +423,12 @@ type VirtualMachineTemplateRequestStatus struct {
 	// +kubebuilder:validation:Optional
 	// +optional
 	TemplateRef *corev1.LocalObjectReference `json:"templateRef,omitempty" protobuf:"bytes,2,opt,name=templateRef"`
+
+	// SanitizedFields lists the fields of the source VirtualMachine that were removed from the template.
+	// +kubebuilder:validation:Optional
+	// +optional
+	// +listType=atomic
+	SanitizedFields []string `json:"sanitizedFields,omitempty" protobuf:"bytes,3,rep,name=sanitizedFields"`
 }
 
 // +kubebuilder:object:root=true
@@ -29,6 +29,110 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVirtualMachineTemplate) DeepCopyInto(out *ClusterVirtualMachineTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVirtualMachineTemplate.
+func (in *ClusterVirtualMachineTemplate) DeepCopy() *ClusterVirtualMachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVirtualMachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterVirtualMachineTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVirtualMachineTemplateList) DeepCopyInto(out *ClusterVirtualMachineTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterVirtualMachineTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVirtualMachineTemplateList.
+func (in *ClusterVirtualMachineTemplateList) DeepCopy() *ClusterVirtualMachineTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVirtualMachineTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterVirtualMachineTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Generalization) DeepCopyInto(out *Generalization) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Generalization.
+func (in *Generalization) DeepCopy() *Generalization {
+	if in == nil {
+		return nil
+	}
+	out := new(Generalization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancetypeCapture) DeepCopyInto(out *InstancetypeCapture) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancetypeCapture.
+func (in *InstancetypeCapture) DeepCopy() *InstancetypeCapture {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancetypeCapture)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Parameter) DeepCopyInto(out *Parameter) {
 	*out = *in
@@ -44,6 +148,104 @@ func (in *Parameter) DeepCopy() *Parameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Sanitization) DeepCopyInto(out *Sanitization) {
+	*out = *in
+	if in.Strip != nil {
+		in, out := &in.Strip, &out.Strip
+		*out = make([]SanitizationRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Keep != nil {
+		in, out := &in.Keep, &out.Keep
+		*out = make([]SanitizationRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sanitization.
+func (in *Sanitization) DeepCopy() *Sanitization {
+	if in == nil {
+		return nil
+	}
+	out := new(Sanitization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageOverride) DeepCopyInto(out *StorageOverride) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]corev1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeMode != nil {
+		in, out := &in.VolumeMode, &out.VolumeMode
+		*out = new(corev1.PersistentVolumeMode)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageOverride.
+func (in *StorageOverride) DeepCopy() *StorageOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageOverrides) DeepCopyInto(out *StorageOverrides) {
+	*out = *in
+	in.StorageOverride.DeepCopyInto(&out.StorageOverride)
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VolumeStorageOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageOverrides.
+func (in *StorageOverrides) DeepCopy() *StorageOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateParameterization) DeepCopyInto(out *TemplateParameterization) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateParameterization.
+func (in *TemplateParameterization) DeepCopy() *TemplateParameterization {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateParameterization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineReference) DeepCopyInto(out *VirtualMachineReference) {
 	*out = *in
@@ -86,6 +288,250 @@ func (in *VirtualMachineTemplate) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateGrant) DeepCopyInto(out *VirtualMachineTemplateGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateGrant.
+func (in *VirtualMachineTemplateGrant) DeepCopy() *VirtualMachineTemplateGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineTemplateGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateGrantFrom) DeepCopyInto(out *VirtualMachineTemplateGrantFrom) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]VirtualMachineTemplateGrantSubject, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateGrantFrom.
+func (in *VirtualMachineTemplateGrantFrom) DeepCopy() *VirtualMachineTemplateGrantFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateGrantFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateGrantList) DeepCopyInto(out *VirtualMachineTemplateGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineTemplateGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateGrantList.
+func (in *VirtualMachineTemplateGrantList) DeepCopy() *VirtualMachineTemplateGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineTemplateGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateGrantSpec) DeepCopyInto(out *VirtualMachineTemplateGrantSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]VirtualMachineTemplateGrantFrom, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]VirtualMachineTemplateGrantTo, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateGrantSpec.
+func (in *VirtualMachineTemplateGrantSpec) DeepCopy() *VirtualMachineTemplateGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateGrantSubject) DeepCopyInto(out *VirtualMachineTemplateGrantSubject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateGrantSubject.
+func (in *VirtualMachineTemplateGrantSubject) DeepCopy() *VirtualMachineTemplateGrantSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateGrantSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateGrantTo) DeepCopyInto(out *VirtualMachineTemplateGrantTo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateGrantTo.
+func (in *VirtualMachineTemplateGrantTo) DeepCopy() *VirtualMachineTemplateGrantTo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateGrantTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateInstance) DeepCopyInto(out *VirtualMachineTemplateInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateInstance.
+func (in *VirtualMachineTemplateInstance) DeepCopy() *VirtualMachineTemplateInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineTemplateInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateInstanceList) DeepCopyInto(out *VirtualMachineTemplateInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineTemplateInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateInstanceList.
+func (in *VirtualMachineTemplateInstanceList) DeepCopy() *VirtualMachineTemplateInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineTemplateInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateInstanceSpec) DeepCopyInto(out *VirtualMachineTemplateInstanceSpec) {
+	*out = *in
+	out.TemplateRef = in.TemplateRef
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateInstanceSpec.
+func (in *VirtualMachineTemplateInstanceSpec) DeepCopy() *VirtualMachineTemplateInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateInstanceStatus) DeepCopyInto(out *VirtualMachineTemplateInstanceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VirtualMachineRef != nil {
+		in, out := &in.VirtualMachineRef, &out.VirtualMachineRef
+		*out = new(VirtualMachineReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateInstanceStatus.
+func (in *VirtualMachineTemplateInstanceStatus) DeepCopy() *VirtualMachineTemplateInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineTemplateList) DeepCopyInto(out *VirtualMachineTemplateList) {
 	*out = *in
@@ -118,6 +564,21 @@ func (in *VirtualMachineTemplateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineTemplateReference) DeepCopyInto(out *VirtualMachineTemplateReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateReference.
+func (in *VirtualMachineTemplateReference) DeepCopy() *VirtualMachineTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineTemplateRequest) DeepCopyInto(out *VirtualMachineTemplateRequest) {
 	*out = *in
@@ -181,6 +642,11 @@ func (in *VirtualMachineTemplateRequestList) DeepCopyObject() runtime.Object {
 func (in *VirtualMachineTemplateRequestSpec) DeepCopyInto(out *VirtualMachineTemplateRequestSpec) {
 	*out = *in
 	out.VirtualMachineRef = in.VirtualMachineRef
+	if in.VirtualMachineTemplateRef != nil {
+		in, out := &in.VirtualMachineTemplateRef, &out.VirtualMachineTemplateRef
+		*out = new(VirtualMachineTemplateReference)
+		**out = **in
+	}
 	if in.TemplateLabels != nil {
 		in, out := &in.TemplateLabels, &out.TemplateLabels
 		*out = make(map[string]string, len(*in))
@@ -193,6 +659,31 @@ func (in *VirtualMachineTemplateRequestSpec) DeepCopyInto(out *VirtualMachineTem
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Instancetypes != nil {
+		in, out := &in.Instancetypes, &out.Instancetypes
+		*out = new(InstancetypeCapture)
+		**out = **in
+	}
+	if in.Parameterize != nil {
+		in, out := &in.Parameterize, &out.Parameterize
+		*out = new(TemplateParameterization)
+		**out = **in
+	}
+	if in.Sanitization != nil {
+		in, out := &in.Sanitization, &out.Sanitization
+		*out = new(Sanitization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Generalization != nil {
+		in, out := &in.Generalization, &out.Generalization
+		*out = new(Generalization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(StorageOverrides)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateRequestSpec.
@@ -220,6 +711,11 @@ func (in *VirtualMachineTemplateRequestStatus) DeepCopyInto(out *VirtualMachineT
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.SanitizedFields != nil {
+		in, out := &in.SanitizedFields, &out.SanitizedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateRequestStatus.
@@ -245,6 +741,16 @@ func (in *VirtualMachineTemplateSpec) DeepCopyInto(out *VirtualMachineTemplateSp
 		*out = make([]Parameter, len(*in))
 		copy(*out, *in)
 	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PatchablePaths != nil {
+		in, out := &in.PatchablePaths, &out.PatchablePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateSpec.
@@ -267,6 +773,16 @@ func (in *VirtualMachineTemplateStatus) DeepCopyInto(out *VirtualMachineTemplate
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LatestInstance != nil {
+		in, out := &in.LatestInstance, &out.LatestInstance
+		*out = new(VirtualMachineReference)
+		**out = **in
+	}
+	if in.OutdatedInstances != nil {
+		in, out := &in.OutdatedInstances, &out.OutdatedInstances
+		*out = make([]VirtualMachineReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineTemplateStatus.
@@ -278,3 +794,19 @@ func (in *VirtualMachineTemplateStatus) DeepCopy() *VirtualMachineTemplateStatus
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeStorageOverride) DeepCopyInto(out *VolumeStorageOverride) {
+	*out = *in
+	in.StorageOverride.DeepCopyInto(&out.StorageOverride)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeStorageOverride.
+func (in *VolumeStorageOverride) DeepCopy() *VolumeStorageOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeStorageOverride)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -36,6 +36,7 @@ import (
 
 	"kubevirt.io/virt-template/internal/apiserver"
 	"kubevirt.io/virt-template/internal/apiserver/openapi"
+	"kubevirt.io/virt-template/internal/apiserver/storage/virtualmachinetemplate"
 	"kubevirt.io/virt-template/internal/apiserver/storage/virtualmachinetemplate/v1alpha1"
 	"kubevirt.io/virt-template/internal/apiserver/storage/virtualmachinetemplate/v1beta1"
 	templatescheme "kubevirt.io/virt-template/internal/scheme"
@@ -56,18 +57,28 @@ func main() {
 	if err != nil {
 		klog.Fatalf("Failed to create client: %v", err)
 	}
+	clientForUser := virtualmachinetemplate.NewImpersonatingClientForUserFunc(virtClient.Config())
+	templateClientForUser := virtualmachinetemplate.NewImpersonatingTemplateClientForUserFunc(virtClient.Config())
 
 	scheme := templatescheme.New()
 	apiGroups := apiserver.APIGroups{
 		subresourcesv1alpha1.GroupVersion: {
 			templateapi.PluralResourceName:              v1alpha1.NewV1alpha1DummyREST(),
-			templateapi.PluralResourceName + "/process": v1alpha1.NewV1alpha1ProcessREST(client),
-			templateapi.PluralResourceName + "/create":  v1alpha1.NewV1alpha1CreateREST(client, virtClient),
+			templateapi.PluralResourceName + "/process": v1alpha1.NewV1alpha1ProcessREST(client, virtClient),
+			templateapi.PluralResourceName + "/create":  v1alpha1.NewV1alpha1CreateREST(client, virtClient, clientForUser),
 		},
 		subresourcesv1beta1.GroupVersion: {
-			templateapi.PluralResourceName:              v1beta1.NewV1beta1DummyREST(),
-			templateapi.PluralResourceName + "/process": v1beta1.NewV1beta1ProcessREST(client),
-			templateapi.PluralResourceName + "/create":  v1beta1.NewV1beta1CreateREST(client, virtClient),
+			templateapi.PluralResourceName:                     v1beta1.NewV1beta1DummyREST(),
+			templateapi.PluralResourceName + "/process":        v1beta1.NewV1beta1ProcessREST(client, virtClient),
+			templateapi.PluralResourceName + "/create":         v1beta1.NewV1beta1CreateREST(client, virtClient, clientForUser),
+			templateapi.PluralResourceName + "/instances":      v1beta1.NewV1beta1InstancesREST(client, clientForUser),
+			templateapi.PluralResourceName + "/rollback":       v1beta1.NewV1beta1RollbackREST(virtClient, templateClientForUser),
+			templateapi.PluralResourceName + "/diff":           v1beta1.NewV1beta1DiffREST(client, virtClient, clientForUser),
+			templateapi.PluralResourceName + "/upgrade":        v1beta1.NewV1beta1UpgradeREST(client, virtClient, clientForUser),
+			templateapi.PluralClusterResourceName:              v1beta1.NewV1beta1ClusterDummyREST(),
+			templateapi.PluralClusterResourceName + "/process": v1beta1.NewV1beta1ClusterProcessREST(client, virtClient),
+			templateapi.PluralClusterResourceName + "/create":  v1beta1.NewV1beta1ClusterCreateREST(client, virtClient, clientForUser),
+			templateapi.PluralPreviewResourceName:              v1beta1.NewV1beta1PreviewREST(),
 		},
 	}
 
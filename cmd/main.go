@@ -23,6 +23,7 @@ import (
 	"crypto/tls"
 	"flag"
 	"os"
+	"slices"
 	"strings"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -34,7 +35,6 @@ import (
 	cliflag "k8s.io/component-base/cli/flag"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -43,6 +43,8 @@ import (
 
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
 	"kubevirt.io/virt-template/internal/controller"
 	"kubevirt.io/virt-template/internal/scheme"
 	webhookv1alpha1 "kubevirt.io/virt-template/internal/webhook/v1alpha1"
@@ -63,6 +65,7 @@ func main() {
 	var enableHTTP2 bool
 	var cipherSuites string
 	var minTLSVersion string
+	var defaultSanitizationProfile string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -93,10 +96,23 @@ func main() {
 	flag.StringVar(&minTLSVersion, "tls-min-version", "",
 		"Minimum TLS version supported. "+
 			"Possible values: "+strings.Join(cliflag.TLSPossibleVersions(), ", "))
+	flag.StringVar(&defaultSanitizationProfile, "default-sanitization-profile", string(v1beta1.SanitizationProfileMinimal),
+		"The sanitization profile applied to VirtualMachineTemplateRequests that do not select a profile. "+
+			"Possible values: Minimal, Default, Aggressive")
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	sanitizationProfile := v1beta1.SanitizationProfile(defaultSanitizationProfile)
+	if !slices.Contains([]v1beta1.SanitizationProfile{
+		v1beta1.SanitizationProfileMinimal,
+		v1beta1.SanitizationProfileDefault,
+		v1beta1.SanitizationProfileAggressive,
+	}, sanitizationProfile) {
+		setupLog.Error(nil, "Invalid default sanitization profile", "profile", defaultSanitizationProfile)
+		os.Exit(1)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -169,7 +185,8 @@ func main() {
 
 	cfg := ctrl.GetConfigOrDie()
 	discoveryClient := discovery.NewDiscoveryClientForConfigOrDie(cfg)
-	cacheByObject, clientDisableFor := controller.ExternalCRDCacheConfig(discoveryClient)
+	cacheByObject := controller.ExternalCRDCacheConfig(discoveryClient)
+	kubeVirtAvailable := controller.IsKubeVirtAvailable(discoveryClient)
 
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme:                 scheme.New(),
@@ -194,11 +211,6 @@ func main() {
 			DefaultTransform:            cache.TransformStripManagedFields(),
 			ByObject:                    cacheByObject,
 		},
-		Client: client.Options{
-			Cache: &client.CacheOptions{
-				DisableFor: clientDisableFor,
-			},
-		},
 	})
 	if err != nil {
 		setupLog.Error(err, "Failed to start manager")
@@ -212,17 +224,42 @@ func main() {
 	}
 
 	if err := (&controller.VirtualMachineTemplateReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:            mgr.GetClient(),
+		Scheme:            mgr.GetScheme(),
+		KubeVirtAvailable: kubeVirtAvailable,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "Failed to create controller", "controller", "VirtualMachineTemplate")
 		os.Exit(1)
 	}
 
+	if err := (&controller.ClusterVirtualMachineTemplateReconciler{
+		Client:            mgr.GetClient(),
+		Scheme:            mgr.GetScheme(),
+		KubeVirtAvailable: kubeVirtAvailable,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "Failed to create controller", "controller", "ClusterVirtualMachineTemplate")
+		os.Exit(1)
+	}
+
+	// VirtualMachineTemplateInstances are only reconciled if KubeVirt serves VirtualMachines
+	if kubeVirtAvailable {
+		if err := (&controller.VirtualMachineTemplateInstanceReconciler{
+			Client:    mgr.GetClient(),
+			APIReader: mgr.GetAPIReader(),
+			Scheme:    mgr.GetScheme(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "Failed to create controller", "controller", "VirtualMachineTemplateInstance")
+			os.Exit(1)
+		}
+	} else {
+		setupLog.Info("KubeVirt is not available, not tracking VirtualMachines and VirtualMachineTemplateInstances")
+	}
+
 	if err := mgr.Add(&controller.VMTRAvailabilityController{
-		Manager:         mgr,
-		VirtClient:      virtClient,
-		DiscoveryClient: discoveryClient,
+		Manager:                    mgr,
+		VirtClient:                 virtClient,
+		DiscoveryClient:            discoveryClient,
+		DefaultSanitizationProfile: sanitizationProfile,
 	}); err != nil {
 		setupLog.Error(err, "Failed to add VMTR availability controller")
 		os.Exit(1)
@@ -257,6 +294,10 @@ func setupWebhooks(setupLog logr.Logger, mgr ctrl.Manager) {
 		setupLog.Error(err, "Failed to create webhook", "webhook", "VirtualMachineTemplate v1beta1")
 		os.Exit(1)
 	}
+	if err := webhookv1beta1.SetupClusterVirtualMachineTemplateWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "Failed to create webhook", "webhook", "ClusterVirtualMachineTemplate v1beta1")
+		os.Exit(1)
+	}
 }
 
 func appendCipherSuites(setupLog logr.Logger, tlsOpts []func(*tls.Config), cipherSuites string) []func(*tls.Config) {
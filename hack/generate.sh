@@ -42,6 +42,7 @@ _client_gen=$(GOWORK=off go -C "${_base_dir}/tools" tool -n client-gen)
   k8s.io/apimachinery/pkg/version \
   kubevirt.io/api/backup/v1alpha1 \
   kubevirt.io/api/core/v1 \
+  kubevirt.io/api/pool/v1beta1 \
   kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1 \
   kubevirt.io/virt-template-api/core/v1alpha1 \
   kubevirt.io/virt-template-api/core/v1beta1 \
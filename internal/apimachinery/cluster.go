@@ -0,0 +1,51 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package apimachinery
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+)
+
+// ClusterTemplateKind is the kind of cluster-scoped VirtualMachineTemplates.
+const ClusterTemplateKind = "ClusterVirtualMachineTemplate"
+
+// TemplateFromClusterTemplate returns a VirtualMachineTemplate holding a copy of the metadata,
+// spec and status of a ClusterVirtualMachineTemplate, so it can be processed like a namespaced
+// template. The returned template keeps the kind of the cluster-scoped template.
+func TemplateFromClusterTemplate(clusterTpl *v1beta1.ClusterVirtualMachineTemplate) *v1beta1.VirtualMachineTemplate {
+	return &v1beta1.VirtualMachineTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1beta1.GroupVersion.String(),
+			Kind:       ClusterTemplateKind,
+		},
+		ObjectMeta: *clusterTpl.ObjectMeta.DeepCopy(),
+		Spec:       *clusterTpl.Spec.DeepCopy(),
+		Status:     *clusterTpl.Status.DeepCopy(),
+	}
+}
+
+// IsClusterTemplateInstance returns true if an object was processed from a ClusterVirtualMachineTemplate.
+func IsClusterTemplateInstance(obj metav1.Object) bool {
+	_, hasName := obj.GetAnnotations()[v1beta1.AnnotationTemplateName]
+	_, hasNamespace := obj.GetAnnotations()[v1beta1.AnnotationTemplateNamespace]
+	return hasName && !hasNamespace
+}
@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package apimachinery_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+)
+
+var _ = Describe("Cluster templates", func() {
+	var clusterTpl *v1beta1.ClusterVirtualMachineTemplate
+
+	BeforeEach(func() {
+		clusterTpl = &v1beta1.ClusterVirtualMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "my-cluster-template",
+				UID:        types.UID("5678"),
+				Generation: 2,
+			},
+			Spec: v1beta1.VirtualMachineTemplateSpec{
+				VirtualMachine: &runtime.RawExtension{Raw: []byte(`{}`)},
+				Parameters: []v1beta1.Parameter{
+					{Name: "NAME", Value: "my-vm"},
+				},
+			},
+		}
+	})
+
+	It("should convert a cluster template into a template", func() {
+		tpl := apimachinery.TemplateFromClusterTemplate(clusterTpl)
+
+		Expect(tpl.Kind).To(Equal(apimachinery.ClusterTemplateKind))
+		Expect(tpl.Name).To(Equal(clusterTpl.Name))
+		Expect(tpl.Namespace).To(BeEmpty())
+		Expect(tpl.UID).To(Equal(clusterTpl.UID))
+		Expect(tpl.Generation).To(Equal(clusterTpl.Generation))
+		Expect(tpl.Spec).To(Equal(clusterTpl.Spec))
+
+		By("Not sharing the spec with the cluster template")
+		tpl.Spec.Parameters[0].Value = "changed"
+		Expect(clusterTpl.Spec.Parameters[0].Value).To(Equal("my-vm"))
+	})
+
+	It("should not record a namespace for instances of a cluster template", func() {
+		vm := &virtv1.VirtualMachine{}
+		apimachinery.SetTemplateProvenance(vm, apimachinery.TemplateFromClusterTemplate(clusterTpl))
+
+		Expect(vm.Labels).To(HaveKeyWithValue(v1beta1.LabelTemplateUID, string(clusterTpl.UID)))
+		Expect(vm.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateName, clusterTpl.Name))
+		Expect(vm.Annotations).ToNot(HaveKey(v1beta1.AnnotationTemplateNamespace))
+		Expect(apimachinery.IsClusterTemplateInstance(vm)).To(BeTrue())
+	})
+
+	It("should not consider instances of a namespaced template", func() {
+		vm := &virtv1.VirtualMachine{}
+		apimachinery.SetTemplateProvenance(vm, &v1beta1.VirtualMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-template",
+				Namespace: "my-namespace",
+			},
+		})
+
+		Expect(apimachinery.IsClusterTemplateInstance(vm)).To(BeFalse())
+		Expect(apimachinery.IsClusterTemplateInstance(&virtv1.VirtualMachine{})).To(BeFalse())
+	})
+})
@@ -0,0 +1,108 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package apimachinery
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+)
+
+// SetTemplateProvenance labels and annotates an object with a reference to the
+// VirtualMachineTemplate it was processed from and the parameter values it was
+// processed with.
+func SetTemplateProvenance(obj metav1.Object, tpl *v1beta1.VirtualMachineTemplate) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[v1beta1.LabelTemplateUID] = string(tpl.UID)
+	obj.SetLabels(labels)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[v1beta1.AnnotationTemplateName] = tpl.Name
+	// The namespace is not recorded for templates processed from a ClusterVirtualMachineTemplate
+	if tpl.Namespace != "" {
+		annotations[v1beta1.AnnotationTemplateNamespace] = tpl.Namespace
+	}
+	annotations[v1beta1.AnnotationTemplateGeneration] = strconv.FormatInt(tpl.Generation, 10)
+	if tpl.Status.Revision != 0 {
+		annotations[v1beta1.AnnotationTemplateRevision] = strconv.FormatInt(tpl.Status.Revision, 10)
+	}
+	if len(tpl.Spec.Parameters) > 0 {
+		params := make(map[string]string, len(tpl.Spec.Parameters))
+		for _, param := range tpl.Spec.Parameters {
+			params[param.Name] = param.Value
+		}
+		// Marshaling a map of strings cannot fail
+		data, _ := json.Marshal(params)
+		annotations[v1beta1.AnnotationTemplateParameters] = string(data)
+	}
+	obj.SetAnnotations(annotations)
+}
+
+// GetTemplateParameters returns the parameter values an object was processed with.
+// It returns nil if the parameter values are not recorded.
+func GetTemplateParameters(obj metav1.Object) (map[string]string, error) {
+	data, ok := obj.GetAnnotations()[v1beta1.AnnotationTemplateParameters]
+	if !ok {
+		return nil, nil
+	}
+
+	params := map[string]string{}
+	if err := json.Unmarshal([]byte(data), &params); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal template parameters: %w", err)
+	}
+	return params, nil
+}
+
+// GetTemplateGeneration returns the generation of the VirtualMachineTemplate an object
+// was processed from. It returns zero if the generation is not recorded or invalid.
+func GetTemplateGeneration(obj metav1.Object) int64 {
+	generation, err := strconv.ParseInt(obj.GetAnnotations()[v1beta1.AnnotationTemplateGeneration], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return generation
+}
+
+// GetTemplateRevision returns the revision of the VirtualMachineTemplate an object
+// was processed from. It returns zero if the revision is not recorded or invalid.
+func GetTemplateRevision(obj metav1.Object) int64 {
+	revision, err := strconv.ParseInt(obj.GetAnnotations()[v1beta1.AnnotationTemplateRevision], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+// IsOutdatedInstance returns true if an object was processed from an older generation
+// of a VirtualMachineTemplate than the given one. Objects with an unknown generation
+// are considered outdated.
+func IsOutdatedInstance(obj metav1.Object, generation int64) bool {
+	return GetTemplateGeneration(obj) < generation
+}
@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package apimachinery_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+)
+
+var _ = Describe("Template provenance", func() {
+	const (
+		tplName      = "my-template"
+		tplNamespace = "my-namespace"
+		tplUID       = types.UID("1234")
+	)
+
+	var tpl *v1beta1.VirtualMachineTemplate
+
+	BeforeEach(func() {
+		tpl = &v1beta1.VirtualMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       tplName,
+				Namespace:  tplNamespace,
+				UID:        tplUID,
+				Generation: 3,
+			},
+		}
+	})
+
+	It("should set labels and annotations", func() {
+		vm := &virtv1.VirtualMachine{}
+		apimachinery.SetTemplateProvenance(vm, tpl)
+
+		Expect(vm.Labels).To(HaveKeyWithValue(v1beta1.LabelTemplateUID, string(tplUID)))
+		Expect(vm.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateName, tplName))
+		Expect(vm.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateNamespace, tplNamespace))
+		Expect(vm.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateGeneration, "3"))
+		Expect(apimachinery.GetTemplateGeneration(vm)).To(Equal(int64(3)))
+		Expect(vm.Annotations).ToNot(HaveKey(v1beta1.AnnotationTemplateRevision))
+	})
+
+	It("should annotate the revision if known", func() {
+		tpl.Status.Revision = 2
+		vm := &virtv1.VirtualMachine{}
+		apimachinery.SetTemplateProvenance(vm, tpl)
+
+		Expect(vm.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateRevision, "2"))
+		Expect(apimachinery.GetTemplateRevision(vm)).To(Equal(int64(2)))
+	})
+
+	It("should keep existing labels and annotations", func() {
+		vm := &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      map[string]string{"app": "test"},
+				Annotations: map[string]string{"note": "test"},
+			},
+		}
+		apimachinery.SetTemplateProvenance(vm, tpl)
+
+		Expect(vm.Labels).To(HaveKeyWithValue("app", "test"))
+		Expect(vm.Annotations).To(HaveKeyWithValue("note", "test"))
+	})
+
+	It("should record parameter values", func() {
+		tpl.Spec.Parameters = []v1beta1.Parameter{
+			{Name: "NAME", Value: "my-vm"},
+			{Name: "EMPTY"},
+		}
+		vm := &virtv1.VirtualMachine{}
+		apimachinery.SetTemplateProvenance(vm, tpl)
+
+		params, err := apimachinery.GetTemplateParameters(vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params).To(Equal(map[string]string{"NAME": "my-vm", "EMPTY": ""}))
+	})
+
+	It("should return nil if no parameter values are recorded", func() {
+		vm := &virtv1.VirtualMachine{}
+		apimachinery.SetTemplateProvenance(vm, tpl)
+
+		Expect(vm.Annotations).ToNot(HaveKey(v1beta1.AnnotationTemplateParameters))
+		params, err := apimachinery.GetTemplateParameters(vm)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(params).To(BeNil())
+	})
+
+	It("should fail to return invalid parameter values", func() {
+		vm := &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{v1beta1.AnnotationTemplateParameters: "invalid"},
+			},
+		}
+
+		_, err := apimachinery.GetTemplateParameters(vm)
+		Expect(err).To(MatchError(ContainSubstring("failed to unmarshal template parameters")))
+	})
+
+	DescribeTable("IsOutdatedInstance", func(annotation string, outdated bool) {
+		vm := &virtv1.VirtualMachine{}
+		if annotation != "" {
+			vm.Annotations = map[string]string{v1beta1.AnnotationTemplateGeneration: annotation}
+		}
+		Expect(apimachinery.IsOutdatedInstance(vm, tpl.Generation)).To(Equal(outdated))
+	},
+		Entry("with older generation", "2", true),
+		Entry("with current generation", "3", false),
+		Entry("with newer generation", "4", false),
+		Entry("with missing generation", "", true),
+		Entry("with invalid generation", "invalid", true),
+	)
+})
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package apimachinery
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+)
+
+// TemplateRevisionName returns the name of the ControllerRevision holding a revision of a template.
+func TemplateRevisionName(tpl *v1beta1.VirtualMachineTemplate, revision int64) string {
+	return GetStableName(tpl.Name, string(tpl.UID), strconv.FormatInt(revision, 10))
+}
+
+// NewTemplateRevision snapshots the current spec of a template into a ControllerRevision
+// owned by the template.
+func NewTemplateRevision(tpl *v1beta1.VirtualMachineTemplate, revision int64) (*appsv1.ControllerRevision, error) {
+	data, err := marshalRevisionSpec(tpl.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TemplateRevisionName(tpl, revision),
+			Namespace: tpl.Namespace,
+			Labels: map[string]string{
+				v1beta1.LabelTemplateUID: string(tpl.UID),
+			},
+			Annotations: map[string]string{
+				v1beta1.AnnotationTemplateGeneration: strconv.FormatInt(tpl.Generation, 10),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(tpl, v1beta1.GroupVersion.WithKind("VirtualMachineTemplate")),
+			},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: revision,
+	}, nil
+}
+
+// TemplateRevisionMatches returns true if a ControllerRevision holds the current spec of a template.
+func TemplateRevisionMatches(rev *appsv1.ControllerRevision, tpl *v1beta1.VirtualMachineTemplate) (bool, error) {
+	data, err := marshalRevisionSpec(tpl.Spec)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(data, rev.Data.Raw), nil
+}
+
+// ApplyTemplateRevision replaces the spec of a template with the spec recorded in a
+// ControllerRevision. The revision history limit of the template is kept as is.
+func ApplyTemplateRevision(tpl *v1beta1.VirtualMachineTemplate, rev *appsv1.ControllerRevision) error {
+	spec := v1beta1.VirtualMachineTemplateSpec{}
+	if err := json.Unmarshal(rev.Data.Raw, &spec); err != nil {
+		return fmt.Errorf("failed to unmarshal revision %d: %w", rev.Revision, err)
+	}
+	spec.RevisionHistoryLimit = tpl.Spec.RevisionHistoryLimit
+
+	tpl.Spec = spec
+	tpl.Generation = GetTemplateGeneration(rev)
+	tpl.Status.Revision = rev.Revision
+
+	return nil
+}
+
+// marshalRevisionSpec serializes the parts of a template spec that are recorded in revisions.
+func marshalRevisionSpec(spec v1beta1.VirtualMachineTemplateSpec) ([]byte, error) {
+	spec.RevisionHistoryLimit = nil
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal template spec: %w", err)
+	}
+	return data, nil
+}
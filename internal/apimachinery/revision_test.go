@@ -0,0 +1,115 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package apimachinery_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+)
+
+var _ = Describe("Template revisions", func() {
+	var tpl *v1beta1.VirtualMachineTemplate
+
+	BeforeEach(func() {
+		tpl = &v1beta1.VirtualMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "my-template",
+				Namespace:  "my-namespace",
+				UID:        types.UID("1234"),
+				Generation: 3,
+			},
+			Spec: v1beta1.VirtualMachineTemplateSpec{
+				VirtualMachine: &runtime.RawExtension{Raw: []byte(`{"kind":"VirtualMachine"}`)},
+				Parameters: []v1beta1.Parameter{
+					{Name: "NAME", Value: "my-vm"},
+				},
+				Message: "my message",
+			},
+		}
+	})
+
+	It("should create a revision owned by the template", func() {
+		rev, err := apimachinery.NewTemplateRevision(tpl, 2)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(rev.Name).To(Equal(apimachinery.TemplateRevisionName(tpl, 2)))
+		Expect(rev.Namespace).To(Equal(tpl.Namespace))
+		Expect(rev.Revision).To(Equal(int64(2)))
+		Expect(rev.Labels).To(HaveKeyWithValue(v1beta1.LabelTemplateUID, string(tpl.UID)))
+		Expect(apimachinery.GetTemplateGeneration(rev)).To(Equal(tpl.Generation))
+		Expect(metav1.IsControlledBy(rev, tpl)).To(BeTrue())
+	})
+
+	It("should generate distinct names for distinct revisions", func() {
+		Expect(apimachinery.TemplateRevisionName(tpl, 1)).ToNot(Equal(apimachinery.TemplateRevisionName(tpl, 2)))
+	})
+
+	It("should match the template it was created from", func() {
+		rev, err := apimachinery.NewTemplateRevision(tpl, 1)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(apimachinery.TemplateRevisionMatches(rev, tpl)).To(BeTrue())
+
+		By("Ignoring the revision history limit")
+		tpl.Spec.RevisionHistoryLimit = ptr.To[int32](5)
+		Expect(apimachinery.TemplateRevisionMatches(rev, tpl)).To(BeTrue())
+
+		By("Detecting changes of the spec")
+		tpl.Spec.Message = "changed"
+		Expect(apimachinery.TemplateRevisionMatches(rev, tpl)).To(BeFalse())
+	})
+
+	It("should apply a revision to a template", func() {
+		rev, err := apimachinery.NewTemplateRevision(tpl, 1)
+		Expect(err).ToNot(HaveOccurred())
+		oldSpec := tpl.Spec.DeepCopy()
+
+		tpl.Generation = 4
+		tpl.Spec.Message = "changed"
+		tpl.Spec.Parameters = nil
+		tpl.Spec.RevisionHistoryLimit = ptr.To[int32](5)
+		tpl.Status.Revision = 2
+
+		Expect(apimachinery.ApplyTemplateRevision(tpl, rev)).To(Succeed())
+		Expect(tpl.Spec.Message).To(Equal(oldSpec.Message))
+		Expect(tpl.Spec.Parameters).To(Equal(oldSpec.Parameters))
+		Expect(tpl.Spec.VirtualMachine.Raw).To(MatchJSON(oldSpec.VirtualMachine.Raw))
+		Expect(tpl.Spec.RevisionHistoryLimit).To(HaveValue(Equal(int32(5))))
+		Expect(tpl.Generation).To(Equal(int64(3)))
+		Expect(tpl.Status.Revision).To(Equal(int64(1)))
+	})
+
+	It("should fail to apply a corrupt revision", func() {
+		rev, err := apimachinery.NewTemplateRevision(tpl, 1)
+		Expect(err).ToNot(HaveOccurred())
+		rev.Data.Raw = []byte("invalid")
+
+		Expect(apimachinery.ApplyTemplateRevision(tpl, rev)).To(MatchError(ContainSubstring("failed to unmarshal revision 1")))
+	})
+})
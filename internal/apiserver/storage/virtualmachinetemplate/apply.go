@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtualmachinetemplate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+)
+
+const (
+	fieldManagerPrefix    = "virt-template"
+	maxFieldManagerLength = 128
+)
+
+func validateApply(opts *subresourcesv1beta1.ProcessOptions) error {
+	if !opts.Apply {
+		return nil
+	}
+	if opts.IdempotencyKey != "" {
+		return apierrors.NewBadRequest("apply cannot be combined with an idempotencyKey")
+	}
+	if opts.OutputKind != "" && opts.OutputKind != subresourcesv1beta1.OutputKindVirtualMachine {
+		return apierrors.NewBadRequest(fmt.Sprintf("apply is not supported for the output kind %s", opts.OutputKind))
+	}
+	return nil
+}
+
+// applyVirtualMachine server-side applies the processed VirtualMachine as the user of the request
+// with the field manager of the template it was processed from.
+func applyVirtualMachine(
+	ctx context.Context,
+	userClient kubecli.KubevirtClient,
+	vm *virtv1.VirtualMachine,
+	templateRef *corev1.ObjectReference,
+	dryRun []string,
+) (*virtv1.VirtualMachine, error) {
+	if vm.Name == "" {
+		return nil, apierrors.NewBadRequest("the processed VirtualMachine must have a name to be applied")
+	}
+
+	vm.APIVersion = virtv1.GroupVersion.String()
+	vm.Kind = virtv1.VirtualMachineGroupVersionKind.Kind
+	data, err := json.Marshal(vm)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error encoding VirtualMachine: %w", err))
+	}
+
+	applied, err := userClient.VirtualMachine(vm.Namespace).Patch(ctx, vm.Name, types.ApplyPatchType, data, metav1.PatchOptions{
+		DryRun:       dryRun,
+		FieldManager: fieldManagerForTemplate(templateRef),
+	})
+	if apierrors.IsForbidden(err) || apierrors.IsConflict(err) || apierrors.IsInvalid(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error applying VirtualMachine: %w", err))
+	}
+
+	return applied, nil
+}
+
+// fieldManagerForTemplate returns the field manager used to apply VirtualMachines processed from
+// the referenced template, e.g. virt-template/my-namespace/my-template.
+func fieldManagerForTemplate(templateRef *corev1.ObjectReference) string {
+	manager := path.Join(fieldManagerPrefix, templateRef.Namespace, templateRef.Name)
+	if len(manager) > maxFieldManagerLength {
+		manager = manager[:maxFieldManagerLength]
+	}
+	return manager
+}
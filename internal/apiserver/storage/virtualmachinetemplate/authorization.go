@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtualmachinetemplate
+
+import (
+	"context"
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	"kubevirt.io/client-go/kubecli"
+
+	templateapi "kubevirt.io/virt-template-api/core"
+)
+
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// VerbUse is the verb a user needs on a template to process it.
+const VerbUse = "use"
+
+// authorizeUse ensures that the user of the request is allowed to use the named template.
+// Being allowed to get a template only allows browsing it, processing it requires the
+// dedicated use verb. The namespace is empty for cluster-scoped templates.
+func authorizeUse(
+	ctx context.Context,
+	virtClient kubecli.KubevirtClient,
+	resource string,
+	ns string,
+	id string,
+) error {
+	gr := schema.GroupResource{Group: templateapi.GroupName, Resource: resource}
+	u, ok := request.UserFrom(ctx)
+	if !ok {
+		return apierrors.NewForbidden(gr, id, fmt.Errorf("missing user"))
+	}
+
+	extra := make(map[string]authorizationv1.ExtraValue, len(u.GetExtra()))
+	for k, v := range u.GetExtra() {
+		extra[k] = v
+	}
+	sar, err := virtClient.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   u.GetName(),
+			UID:    u.GetUID(),
+			Groups: u.GetGroups(),
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: ns,
+				Verb:      VerbUse,
+				Group:     templateapi.GroupName,
+				Resource:  resource,
+				Name:      id,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("error creating SubjectAccessReview: %w", err))
+	}
+	if !sar.Status.Allowed {
+		return apierrors.NewForbidden(gr, id, fmt.Errorf("user %q cannot %s it", u.GetName(), VerbUse))
+	}
+
+	return nil
+}
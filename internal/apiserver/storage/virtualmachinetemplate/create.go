@@ -0,0 +1,327 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtualmachinetemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+	templateclient "kubevirt.io/virt-template-client-go/virttemplate"
+)
+
+const (
+	// MaxBatchCount is the maximum number of VirtualMachines created by a single request.
+	MaxBatchCount = 100
+	// batchParallelism is the maximum number of VirtualMachines of a batch created concurrently.
+	batchParallelism = 10
+)
+
+// DecodeCreateOptions decodes the ProcessOptions of a request to the create subresource.
+func DecodeCreateOptions(body io.Reader) (*subresourcesv1beta1.ProcessOptions, error) {
+	opts, err := decodeProcessOptions(body)
+	if err != nil {
+		return nil, err
+	}
+	if len(opts.DryRun) > 1 || (len(opts.DryRun) == 1 && opts.DryRun[0] != metav1.DryRunAll) {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid dryRun %v, the only valid value is %s", opts.DryRun, metav1.DryRunAll))
+	}
+	if opts.Count < 0 || opts.Count > MaxBatchCount {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid count %d, it must be between 1 and %d", opts.Count, MaxBatchCount))
+	}
+	if opts.NamePattern != "" && !strings.Contains(opts.NamePattern, subresourcesv1beta1.BatchIndexReference) {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("namePattern must contain %s", subresourcesv1beta1.BatchIndexReference))
+	}
+	if err := validateIdempotencyKey(opts); err != nil {
+		return nil, err
+	}
+	if err := validateApply(opts); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// CreateFromTemplate processes the named template like ProcessTemplate and creates the
+// resulting VirtualMachine as the user of the request. If the request is a dry run, the
+// VirtualMachine is defaulted and validated by the API server but not persisted.
+func CreateFromTemplate(
+	ctx context.Context,
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+	clientForUser ClientForUserFunc,
+	processor Processor,
+	opts *subresourcesv1beta1.ProcessOptions,
+	ns string,
+	id string,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
+	processed, err := processTemplateWithRequest(ctx, client, virtClient, processor, opts, ns, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return createProcessed(ctx, clientForUser, processed, opts)
+}
+
+// CreateFromClusterTemplate processes the named ClusterVirtualMachineTemplate like
+// ProcessClusterTemplate and creates the resulting VirtualMachine like CreateFromTemplate.
+func CreateFromClusterTemplate(
+	ctx context.Context,
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+	clientForUser ClientForUserFunc,
+	processor Processor,
+	opts *subresourcesv1beta1.ProcessOptions,
+	ns string,
+	id string,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
+	processed, err := processClusterTemplateWithRequest(ctx, client, virtClient, processor, opts, ns, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return createProcessed(ctx, clientForUser, processed, opts)
+}
+
+// CreateBatchFromTemplate creates the number of VirtualMachines requested by the Count of the
+// options from the named template. The template is processed anew for each VirtualMachine, and
+// the VirtualMachines are created concurrently. Errors of individual VirtualMachines are reported
+// in the returned batch instead of failing the request.
+func CreateBatchFromTemplate(
+	ctx context.Context,
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+	clientForUser ClientForUserFunc,
+	processor Processor,
+	opts *subresourcesv1beta1.ProcessOptions,
+	ns string,
+	id string,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch, error) {
+	resolved, err := resolveTemplate(ctx, client, virtClient, opts, ns, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return createBatch(ctx, clientForUser, processor, resolved, opts)
+}
+
+// CreateBatchFromClusterTemplate creates a batch of VirtualMachines from the named
+// ClusterVirtualMachineTemplate like CreateBatchFromTemplate.
+func CreateBatchFromClusterTemplate(
+	ctx context.Context,
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+	clientForUser ClientForUserFunc,
+	processor Processor,
+	opts *subresourcesv1beta1.ProcessOptions,
+	ns string,
+	id string,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch, error) {
+	resolved, err := resolveClusterTemplate(ctx, client, virtClient, opts, ns, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return createBatch(ctx, clientForUser, processor, resolved, opts)
+}
+
+func createBatch(
+	ctx context.Context,
+	clientForUser ClientForUserFunc,
+	processor Processor,
+	resolved *resolvedTemplate,
+	opts *subresourcesv1beta1.ProcessOptions,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch, error) {
+	if opts.Count == 0 {
+		return nil, apierrors.NewBadRequest("count is required to create a batch of VirtualMachines")
+	}
+
+	userClient, err := ClientForRequestUser(ctx, clientForUser)
+	if err != nil {
+		return nil, err
+	}
+
+	hasIndexParameter := slices.ContainsFunc(resolved.tpl.Spec.Parameters, func(param v1beta1.Parameter) bool {
+		return param.Name == subresourcesv1beta1.BatchIndexParameter
+	})
+
+	items := make([]subresourcesv1beta1.ProcessedVirtualMachineTemplateBatchItem, opts.Count)
+	sem := make(chan struct{}, batchParallelism)
+	var wg sync.WaitGroup
+	for i := range items {
+		items[i].Index = int32(i)
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(item *subresourcesv1beta1.ProcessedVirtualMachineTemplateBatchItem) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			vm, msg, err := createBatchItem(ctx, userClient, processor, resolved, opts, item.Index, hasIndexParameter)
+			if err != nil {
+				item.Error = batchError(err)
+				return
+			}
+			item.VirtualMachine = vm
+			item.Message = msg
+		}(&items[i])
+	}
+	wg.Wait()
+
+	return &subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch{
+		TemplateRef: resolved.templateRef,
+		Items:       items,
+	}, nil
+}
+
+func createBatchItem(
+	ctx context.Context,
+	userClient kubecli.KubevirtClient,
+	processor Processor,
+	resolved *resolvedTemplate,
+	opts *subresourcesv1beta1.ProcessOptions,
+	idx int32,
+	hasIndexParameter bool,
+) (*virtv1.VirtualMachine, string, error) {
+	index := strconv.FormatInt(int64(idx), 10)
+
+	itemOpts := opts.DeepCopy()
+	if hasIndexParameter {
+		if itemOpts.Parameters == nil {
+			itemOpts.Parameters = map[string]string{}
+		}
+		itemOpts.Parameters[subresourcesv1beta1.BatchIndexParameter] = index
+	}
+
+	processed, err := resolved.process(ctx, processor, itemOpts)
+	if err != nil {
+		return nil, "", err
+	}
+	if opts.NamePattern != "" {
+		processed.VirtualMachine.Name = strings.ReplaceAll(opts.NamePattern, subresourcesv1beta1.BatchIndexReference, index)
+	}
+
+	if itemOpts.IdempotencyKey != "" {
+		itemOpts.IdempotencyKey += "-" + index
+	}
+	vm, err := createOrApplyVirtualMachine(ctx, userClient, processed.VirtualMachine, resolved.templateRef, itemOpts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return vm, processed.Message, nil
+}
+
+func createProcessed(
+	ctx context.Context,
+	clientForUser ClientForUserFunc,
+	processed *subresourcesv1beta1.ProcessedVirtualMachineTemplate,
+	opts *subresourcesv1beta1.ProcessOptions,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
+	userClient, err := ClientForRequestUser(ctx, clientForUser)
+	if err != nil {
+		return nil, err
+	}
+
+	createOpts := metav1.CreateOptions{DryRun: opts.DryRun}
+	switch {
+	case processed.VirtualMachinePool != nil:
+		processed.VirtualMachinePool, err = userClient.VirtualMachinePool(processed.VirtualMachinePool.Namespace).
+			Create(ctx, processed.VirtualMachinePool, createOpts)
+		err = createError("VirtualMachinePool", err)
+	case processed.VirtualMachineInstanceReplicaSet != nil:
+		processed.VirtualMachineInstanceReplicaSet, err = userClient.ReplicaSet(processed.VirtualMachineInstanceReplicaSet.Namespace).
+			Create(ctx, processed.VirtualMachineInstanceReplicaSet, createOpts)
+		err = createError("VirtualMachineInstanceReplicaSet", err)
+	default:
+		processed.VirtualMachine, err = createOrApplyVirtualMachine(ctx, userClient, processed.VirtualMachine, processed.TemplateRef, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return processed, nil
+}
+
+// createOrApplyVirtualMachine applies the processed VirtualMachine if requested by the options
+// and creates it otherwise.
+func createOrApplyVirtualMachine(
+	ctx context.Context,
+	userClient kubecli.KubevirtClient,
+	vm *virtv1.VirtualMachine,
+	templateRef *corev1.ObjectReference,
+	opts *subresourcesv1beta1.ProcessOptions,
+) (*virtv1.VirtualMachine, error) {
+	if opts.Apply {
+		return applyVirtualMachine(ctx, userClient, vm, templateRef, opts.DryRun)
+	}
+	return createOrGetVirtualMachine(ctx, userClient, vm, opts)
+}
+
+func createVirtualMachine(
+	ctx context.Context,
+	userClient kubecli.KubevirtClient,
+	vm *virtv1.VirtualMachine,
+	dryRun []string,
+) (*virtv1.VirtualMachine, error) {
+	created, err := userClient.VirtualMachine(vm.Namespace).Create(ctx, vm, metav1.CreateOptions{DryRun: dryRun})
+	if err != nil {
+		return nil, createError("VirtualMachine", err)
+	}
+
+	return created, nil
+}
+
+// createError passes through the errors of creating an object of the given kind that are
+// meaningful to the requesting user and wraps all other errors in an internal error.
+func createError(kind string, err error) error {
+	if err == nil || apierrors.IsForbidden(err) || apierrors.IsAlreadyExists(err) || apierrors.IsInvalid(err) {
+		return err
+	}
+	return apierrors.NewInternalError(fmt.Errorf("error creating %s: %w", kind, err))
+}
+
+// batchError converts the error of an API request to the error of a batch item, wrapping
+// other errors in an internal error.
+func batchError(err error) *subresourcesv1beta1.ProcessedVirtualMachineTemplateBatchError {
+	var apiStatus apierrors.APIStatus
+	if !errors.As(err, &apiStatus) {
+		apiStatus = apierrors.NewInternalError(err)
+	}
+	status := apiStatus.Status()
+	return &subresourcesv1beta1.ProcessedVirtualMachineTemplateBatchError{
+		Code:    status.Code,
+		Reason:  status.Reason,
+		Message: status.Message,
+	}
+}
@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtualmachinetemplate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/ptr"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	templateapi "kubevirt.io/virt-template-api/core"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+	templateclient "kubevirt.io/virt-template-client-go/virttemplate"
+)
+
+// +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplategrants,verbs=list
+
+const serviceAccountUsernameFmt = "system:serviceaccount:%s:%s"
+
+// authorizeTargetNamespace ensures that a VirtualMachineTemplateGrant in the namespace of the
+// template allows the requesting user to process the template into the target namespace.
+func authorizeTargetNamespace(
+	ctx context.Context,
+	client templateclient.Interface,
+	ns string,
+	id string,
+	targetNamespace string,
+) error {
+	if targetNamespace == ns {
+		return nil
+	}
+	if errs := validation.IsDNS1123Label(targetNamespace); len(errs) > 0 {
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid target namespace %q: %v", targetNamespace, errs))
+	}
+
+	gr := schema.GroupResource{Group: templateapi.GroupName, Resource: templateapi.PluralResourceName}
+	u, ok := request.UserFrom(ctx)
+	if !ok {
+		return apierrors.NewForbidden(gr, id, fmt.Errorf("missing user"))
+	}
+
+	grants, err := client.TemplateV1beta1().VirtualMachineTemplateGrants(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("error listing VirtualMachineTemplateGrants: %w", err))
+	}
+	for i := range grants.Items {
+		if grantAllows(&grants.Items[i], id, targetNamespace, u) {
+			return nil
+		}
+	}
+
+	return apierrors.NewForbidden(gr, id, fmt.Errorf(
+		"no VirtualMachineTemplateGrant in namespace %s allows %s to process it into namespace %s",
+		ns, u.GetName(), targetNamespace,
+	))
+}
+
+func grantAllows(grant *v1beta1.VirtualMachineTemplateGrant, id, targetNamespace string, u user.Info) bool {
+	if len(grant.Spec.To) > 0 && !slices.ContainsFunc(grant.Spec.To, func(to v1beta1.VirtualMachineTemplateGrantTo) bool {
+		return to.Name == id
+	}) {
+		return false
+	}
+
+	return slices.ContainsFunc(grant.Spec.From, func(from v1beta1.VirtualMachineTemplateGrantFrom) bool {
+		if from.Namespace != targetNamespace {
+			return false
+		}
+		return slices.ContainsFunc(from.Subjects, func(s v1beta1.VirtualMachineTemplateGrantSubject) bool {
+			return subjectMatches(s, u)
+		})
+	})
+}
+
+func subjectMatches(s v1beta1.VirtualMachineTemplateGrantSubject, u user.Info) bool {
+	switch s.Kind {
+	case v1beta1.GrantSubjectUser:
+		return u.GetName() == s.Name
+	case v1beta1.GrantSubjectGroup:
+		return slices.Contains(u.GetGroups(), s.Name)
+	case v1beta1.GrantSubjectServiceAccount:
+		return u.GetName() == fmt.Sprintf(serviceAccountUsernameFmt, s.Namespace, s.Name)
+	default:
+		return false
+	}
+}
+
+// setCloneSourceNamespaces sets the namespace of DataVolumeTemplate clone sources without a
+// namespace to the namespace of the template. Otherwise they would refer to the target namespace
+// once the VirtualMachine is processed into another namespace than the one of its template.
+func setCloneSourceNamespaces(vm *virtv1.VirtualMachine, ns string) {
+	for i := range vm.Spec.DataVolumeTemplates {
+		spec := &vm.Spec.DataVolumeTemplates[i].Spec
+		if spec.Source != nil {
+			if spec.Source.PVC != nil && spec.Source.PVC.Namespace == "" {
+				spec.Source.PVC.Namespace = ns
+			}
+			if spec.Source.Snapshot != nil && spec.Source.Snapshot.Namespace == "" {
+				spec.Source.Snapshot.Namespace = ns
+			}
+		}
+		if spec.SourceRef != nil && ptr.Deref(spec.SourceRef.Namespace, "") == "" {
+			spec.SourceRef.Namespace = ptr.To(ns)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtualmachinetemplate
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+)
+
+const maxIdempotencyKeyLength = 128
+
+func validateIdempotencyKey(opts *subresourcesv1beta1.ProcessOptions) error {
+	if opts.IdempotencyKey == "" {
+		return nil
+	}
+	if len(opts.IdempotencyKey) > maxIdempotencyKeyLength {
+		return apierrors.NewBadRequest(fmt.Sprintf("idempotencyKey must not be longer than %d characters", maxIdempotencyKeyLength))
+	}
+	if opts.OutputKind != "" && opts.OutputKind != subresourcesv1beta1.OutputKindVirtualMachine {
+		return apierrors.NewBadRequest(fmt.Sprintf("idempotencyKey is not supported for the output kind %s", opts.OutputKind))
+	}
+	return nil
+}
+
+// createOrGetVirtualMachine creates the processed VirtualMachine as the user of the request. If the
+// request has an idempotency key and a VirtualMachine was already created from the same template
+// with the same key, the existing VirtualMachine is returned instead.
+func createOrGetVirtualMachine(
+	ctx context.Context,
+	userClient kubecli.KubevirtClient,
+	vm *virtv1.VirtualMachine,
+	opts *subresourcesv1beta1.ProcessOptions,
+) (*virtv1.VirtualMachine, error) {
+	key := opts.IdempotencyKey
+	if key == "" {
+		return createVirtualMachine(ctx, userClient, vm, opts.DryRun)
+	}
+
+	existing, err := findVirtualMachineByIdempotencyKey(ctx, userClient, vm, key)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	if vm.Annotations == nil {
+		vm.Annotations = map[string]string{}
+	}
+	vm.Annotations[v1beta1.AnnotationIdempotencyKey] = key
+
+	created, err := createVirtualMachine(ctx, userClient, vm, opts.DryRun)
+	if apierrors.IsAlreadyExists(err) {
+		// A concurrent request with the same key may have created the VirtualMachine in the meantime
+		existing, getErr := userClient.VirtualMachine(vm.Namespace).Get(ctx, vm.Name, metav1.GetOptions{})
+		if getErr == nil && isIdempotentInstance(existing, vm, key) {
+			return existing, nil
+		}
+	}
+
+	return created, err
+}
+
+func findVirtualMachineByIdempotencyKey(
+	ctx context.Context,
+	userClient kubecli.KubevirtClient,
+	vm *virtv1.VirtualMachine,
+	key string,
+) (*virtv1.VirtualMachine, error) {
+	list, err := userClient.VirtualMachine(vm.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{v1beta1.LabelTemplateUID: vm.Labels[v1beta1.LabelTemplateUID]}.String(),
+	})
+	if apierrors.IsForbidden(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error listing VirtualMachines: %w", err))
+	}
+
+	for i := range list.Items {
+		if isIdempotentInstance(&list.Items[i], vm, key) {
+			return &list.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// isIdempotentInstance returns true if existing was created from the same template as vm with the given key.
+func isIdempotentInstance(existing, vm *virtv1.VirtualMachine, key string) bool {
+	return existing.Annotations[v1beta1.AnnotationIdempotencyKey] == key &&
+		existing.Labels[v1beta1.LabelTemplateUID] == vm.Labels[v1beta1.LabelTemplateUID]
+}
@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtualmachinetemplate
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/rest"
+
+	"kubevirt.io/client-go/kubecli"
+
+	templateclient "kubevirt.io/virt-template-client-go/virttemplate"
+)
+
+// +kubebuilder:rbac:groups="",resources=users;groups;serviceaccounts,verbs=impersonate
+// +kubebuilder:rbac:groups=authentication.k8s.io,resources=uids;userextras/*,verbs=impersonate
+
+// ClientForUserFunc returns a KubevirtClient that acts as the given user.
+type ClientForUserFunc func(u user.Info) (kubecli.KubevirtClient, error)
+
+// TemplateClientForUserFunc returns a template client that acts as the given user.
+type TemplateClientForUserFunc func(u user.Info) (templateclient.Interface, error)
+
+// NewImpersonatingClientForUserFunc returns a ClientForUserFunc that impersonates
+// the given user with a copy of the passed config.
+func NewImpersonatingClientForUserFunc(config *rest.Config) ClientForUserFunc {
+	return func(u user.Info) (kubecli.KubevirtClient, error) {
+		return kubecli.GetKubevirtClientFromRESTConfig(impersonatingConfig(config, u))
+	}
+}
+
+// NewImpersonatingTemplateClientForUserFunc returns a TemplateClientForUserFunc that
+// impersonates the given user with a copy of the passed config.
+func NewImpersonatingTemplateClientForUserFunc(config *rest.Config) TemplateClientForUserFunc {
+	return func(u user.Info) (templateclient.Interface, error) {
+		return templateclient.NewForConfig(impersonatingConfig(config, u))
+	}
+}
+
+func impersonatingConfig(config *rest.Config, u user.Info) *rest.Config {
+	userConfig := rest.CopyConfig(config)
+	userConfig.Impersonate = rest.ImpersonationConfig{
+		UserName: u.GetName(),
+		UID:      u.GetUID(),
+		Groups:   u.GetGroups(),
+		Extra:    u.GetExtra(),
+	}
+	return userConfig
+}
+
+// ClientForRequestUser returns a KubevirtClient that acts as the user of the request,
+// so that authorization, quota attribution and audit logs reflect the real user.
+func ClientForRequestUser(ctx context.Context, clientForUser ClientForUserFunc) (kubecli.KubevirtClient, error) {
+	u, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("missing user"))
+	}
+
+	c, err := clientForUser(u)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error creating client for user %s: %w", u.GetName(), err))
+	}
+
+	return c, nil
+}
+
+// TemplateClientForRequestUser returns a template client that acts as the user of the request.
+func TemplateClientForRequestUser(ctx context.Context, clientForUser TemplateClientForUserFunc) (templateclient.Interface, error) {
+	u, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("missing user"))
+	}
+
+	c, err := clientForUser(u)
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error creating client for user %s: %w", u.GetName(), err))
+	}
+
+	return c, nil
+}
@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtualmachinetemplate
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+	templateclient "kubevirt.io/virt-template-client-go/virttemplate"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+)
+
+// ListTemplateInstances fetches the named template and returns the VirtualMachines
+// that were created from it. The VirtualMachines are listed as the user of the request,
+// across all namespaces if the user may list them cluster-wide and in the namespace of
+// the template otherwise.
+func ListTemplateInstances(
+	ctx context.Context,
+	client templateclient.Interface,
+	clientForUser ClientForUserFunc,
+	ns string,
+	id string,
+) (*subresourcesv1beta1.VirtualMachineTemplateInstances, error) {
+	tpl, err := client.TemplateV1beta1().VirtualMachineTemplates(ns).Get(ctx, id, metav1.GetOptions{})
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error getting VirtualMachineTemplate: %w", err))
+	}
+
+	userClient, err := ClientForRequestUser(ctx, clientForUser)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := metav1.ListOptions{
+		LabelSelector: labels.Set{v1beta1.LabelTemplateUID: string(tpl.UID)}.String(),
+	}
+	vms, err := userClient.VirtualMachine(metav1.NamespaceAll).List(ctx, opts)
+	if apierrors.IsForbidden(err) {
+		vms, err = userClient.VirtualMachine(ns).List(ctx, opts)
+	}
+	if apierrors.IsForbidden(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error listing VirtualMachines: %w", err))
+	}
+
+	instances := make([]subresourcesv1beta1.TemplateInstance, 0, len(vms.Items))
+	for i := range vms.Items {
+		vm := &vms.Items[i]
+		instances = append(instances, subresourcesv1beta1.TemplateInstance{
+			Namespace:          vm.Namespace,
+			Name:               vm.Name,
+			CreationTimestamp:  vm.CreationTimestamp,
+			TemplateGeneration: apimachinery.GetTemplateGeneration(vm),
+			Outdated:           apimachinery.IsOutdatedInstance(vm, tpl.Generation),
+		})
+	}
+	slices.SortFunc(instances, func(a, b subresourcesv1beta1.TemplateInstance) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	return &subresourcesv1beta1.VirtualMachineTemplateInstances{
+		TemplateRef: &corev1.ObjectReference{
+			Namespace: ns,
+			Name:      id,
+		},
+		TemplateGeneration: tpl.Generation,
+		Instances:          instances,
+	}, nil
+}
@@ -0,0 +1,157 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtualmachinetemplate
+
+import (
+	"fmt"
+	"maps"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1beta1 "kubevirt.io/api/pool/v1beta1"
+
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+)
+
+func validateOutputOptions(opts *subresourcesv1beta1.ProcessOptions) error {
+	switch opts.OutputKind {
+	case "", subresourcesv1beta1.OutputKindVirtualMachine:
+		if opts.Replicas != nil {
+			return apierrors.NewBadRequest("replicas are only supported for the output kinds " +
+				string(subresourcesv1beta1.OutputKindVirtualMachinePool) + " and " +
+				string(subresourcesv1beta1.OutputKindVirtualMachineInstanceReplicaSet))
+		}
+		return nil
+	case subresourcesv1beta1.OutputKindVirtualMachinePool, subresourcesv1beta1.OutputKindVirtualMachineInstanceReplicaSet:
+		if opts.Replicas != nil && *opts.Replicas < 0 {
+			return apierrors.NewBadRequest(fmt.Sprintf("invalid replicas %d, it must not be negative", *opts.Replicas))
+		}
+		if opts.Count != 0 {
+			return apierrors.NewBadRequest(fmt.Sprintf("count is not supported for the output kind %s", opts.OutputKind))
+		}
+		return nil
+	default:
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid outputKind %q", opts.OutputKind))
+	}
+}
+
+// setOutput wraps the processed VirtualMachine into the object of the output kind selected by the options.
+// The wrapped VirtualMachine is removed from the result, as it is not created itself.
+func setOutput(processed *subresourcesv1beta1.ProcessedVirtualMachineTemplate, opts *subresourcesv1beta1.ProcessOptions) error {
+	switch opts.OutputKind {
+	case subresourcesv1beta1.OutputKindVirtualMachinePool:
+		// The name of the VirtualMachine is the name of the pool and its selector
+		if processed.VirtualMachine.Name == "" {
+			return apierrors.NewBadRequest("the processed VirtualMachine must have a name to be wrapped into a VirtualMachinePool")
+		}
+		processed.VirtualMachinePool = newVirtualMachinePool(processed.VirtualMachine, opts.Replicas)
+	case subresourcesv1beta1.OutputKindVirtualMachineInstanceReplicaSet:
+		// The name of the VirtualMachine is the name of the ReplicaSet and its selector
+		if processed.VirtualMachine.Name == "" {
+			return apierrors.NewBadRequest(
+				"the processed VirtualMachine must have a name to be wrapped into a VirtualMachineInstanceReplicaSet")
+		}
+		rs, err := newVirtualMachineInstanceReplicaSet(processed.VirtualMachine, opts.Replicas)
+		if err != nil {
+			return err
+		}
+		processed.VirtualMachineInstanceReplicaSet = rs
+	default:
+		return nil
+	}
+	processed.VirtualMachine = nil
+	return nil
+}
+
+func newVirtualMachinePool(vm *virtv1.VirtualMachine, replicas *int32) *poolv1beta1.VirtualMachinePool {
+	selector := map[string]string{v1beta1.LabelOutputName: vm.Name}
+
+	return &poolv1beta1.VirtualMachinePool{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: poolv1beta1.SchemeGroupVersion.String(),
+			Kind:       poolv1beta1.VirtualMachinePoolKind,
+		},
+		ObjectMeta: outputObjectMeta(vm),
+		Spec: poolv1beta1.VirtualMachinePoolSpec{
+			Replicas: replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			VirtualMachineTemplate: &poolv1beta1.VirtualMachineTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      withLabels(vm.Labels, selector),
+					Annotations: maps.Clone(vm.Annotations),
+				},
+				Spec: *vm.Spec.DeepCopy(),
+			},
+		},
+	}
+}
+
+func newVirtualMachineInstanceReplicaSet(
+	vm *virtv1.VirtualMachine, replicas *int32,
+) (*virtv1.VirtualMachineInstanceReplicaSet, error) {
+	if vm.Spec.Template == nil {
+		return nil, apierrors.NewBadRequest("the processed VirtualMachine has no template for a VirtualMachineInstanceReplicaSet")
+	}
+	if len(vm.Spec.DataVolumeTemplates) > 0 {
+		return nil, apierrors.NewBadRequest(
+			"VirtualMachines with dataVolumeTemplates cannot be processed into a VirtualMachineInstanceReplicaSet",
+		)
+	}
+
+	selector := map[string]string{v1beta1.LabelOutputName: vm.Name}
+	template := vm.Spec.Template.DeepCopy()
+	template.ObjectMeta.Labels = withLabels(template.ObjectMeta.Labels, selector)
+
+	return &virtv1.VirtualMachineInstanceReplicaSet{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: virtv1.VirtualMachineInstanceReplicaSetGroupVersionKind.GroupVersion().String(),
+			Kind:       virtv1.VirtualMachineInstanceReplicaSetGroupVersionKind.Kind,
+		},
+		ObjectMeta: outputObjectMeta(vm),
+		Spec: virtv1.VirtualMachineInstanceReplicaSetSpec{
+			Replicas: replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: template,
+		},
+	}, nil
+}
+
+// outputObjectMeta returns the metadata of the object wrapping a processed VirtualMachine,
+// which keeps the provenance recorded on the VirtualMachine.
+func outputObjectMeta(vm *virtv1.VirtualMachine) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        vm.Name,
+		Namespace:   vm.Namespace,
+		Labels:      maps.Clone(vm.Labels),
+		Annotations: maps.Clone(vm.Annotations),
+	}
+}
+
+func withLabels(labels, additional map[string]string) map[string]string {
+	merged := maps.Clone(labels)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	maps.Copy(merged, additional)
+	return merged
+}
@@ -0,0 +1,244 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtualmachinetemplate
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	templateapi "kubevirt.io/virt-template-api/core"
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+)
+
+// applyOverrides applies the overrides of a request to the processed VirtualMachine. Changes to the
+// provenance of the VirtualMachine and changes outside of the patchable paths of the template are rejected.
+// The applied overrides are recorded on the VirtualMachine, so they can be reapplied on upgrades.
+func applyOverrides(
+	vm *virtv1.VirtualMachine,
+	overrides *subresourcesv1beta1.VirtualMachineOverrides,
+	patchablePaths []string,
+	fldPath *field.Path,
+) (*virtv1.VirtualMachine, *field.Error) {
+	if overrides == nil {
+		return vm, nil
+	}
+
+	original, err := json.Marshal(vm)
+	if err != nil {
+		return nil, field.InternalError(fldPath, err)
+	}
+
+	overridden := vm.DeepCopy()
+	if len(overrides.Labels) > 0 {
+		if overridden.Labels == nil {
+			overridden.Labels = map[string]string{}
+		}
+		maps.Copy(overridden.Labels, overrides.Labels)
+	}
+	if len(overrides.Annotations) > 0 {
+		if overridden.Annotations == nil {
+			overridden.Annotations = map[string]string{}
+		}
+		maps.Copy(overridden.Annotations, overrides.Annotations)
+	}
+	if overrides.RunStrategy != nil {
+		overridden.Spec.RunStrategy = overrides.RunStrategy
+	}
+
+	data, err := json.Marshal(overridden)
+	if err != nil {
+		return nil, field.InternalError(fldPath, err)
+	}
+	patched := overridden
+	if overrides.Patch != nil {
+		data, err = applyPatch(data, overrides.Patch)
+		if err != nil {
+			return nil, field.Invalid(fldPath.Child("patch"), overrides.Patch.Data, err.Error())
+		}
+		patched = &virtv1.VirtualMachine{}
+		if err := json.Unmarshal(data, patched); err != nil {
+			return nil, field.Invalid(fldPath.Child("patch"), overrides.Patch.Data, err.Error())
+		}
+	}
+	if err := validateProvenanceUnchanged(vm, patched, fldPath); err != nil {
+		return nil, err
+	}
+	if err := validatePatchablePaths(original, data, patchablePaths, fldPath); err != nil {
+		return nil, err
+	}
+
+	recorded, err := json.Marshal(overrides)
+	if err != nil {
+		return nil, field.InternalError(fldPath, err)
+	}
+	metav1.SetMetaDataAnnotation(&patched.ObjectMeta, v1beta1.AnnotationTemplateOverrides, string(recorded))
+
+	return patched, nil
+}
+
+// getTemplateOverrides returns the overrides a VirtualMachine was processed with.
+// It returns nil if no overrides are recorded.
+func getTemplateOverrides(vm *virtv1.VirtualMachine) (*subresourcesv1beta1.VirtualMachineOverrides, error) {
+	data, ok := vm.Annotations[v1beta1.AnnotationTemplateOverrides]
+	if !ok {
+		return nil, nil
+	}
+
+	overrides := &subresourcesv1beta1.VirtualMachineOverrides{}
+	if err := json.Unmarshal([]byte(data), overrides); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal template overrides: %w", err)
+	}
+	return overrides, nil
+}
+
+func applyPatch(data []byte, patch *subresourcesv1beta1.VirtualMachinePatch) ([]byte, error) {
+	switch patch.Type {
+	case subresourcesv1beta1.PatchTypeJSON:
+		jsonPatch, err := jsonpatch.DecodePatch([]byte(patch.Data))
+		if err != nil {
+			return nil, err
+		}
+		return jsonPatch.Apply(data)
+	case subresourcesv1beta1.PatchTypeStrategicMerge:
+		return strategicpatch.StrategicMergePatch(data, []byte(patch.Data), virtv1.VirtualMachine{})
+	default:
+		return nil, fmt.Errorf("unsupported patch type %q", patch.Type)
+	}
+}
+
+// validateProvenanceUnchanged rejects changes to the labels and annotations of the template API group.
+func validateProvenanceUnchanged(original, patched *virtv1.VirtualMachine, fldPath *field.Path) *field.Error {
+	for _, m := range []struct{ original, patched map[string]string }{
+		{original.Labels, patched.Labels},
+		{original.Annotations, patched.Annotations},
+	} {
+		for _, key := range changedKeys(m.original, m.patched) {
+			if strings.HasPrefix(key, templateapi.GroupName+"/") {
+				return field.Forbidden(fldPath, fmt.Sprintf("%s cannot be overridden", key))
+			}
+		}
+	}
+	return nil
+}
+
+func changedKeys(original, patched map[string]string) []string {
+	var keys []string
+	for key, value := range original {
+		if patchedValue, ok := patched[key]; !ok || patchedValue != value {
+			keys = append(keys, key)
+		}
+	}
+	for key := range patched {
+		if _, ok := original[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// validatePatchablePaths rejects changes between the original and the patched VirtualMachine
+// that are not at or below any of the patchable paths. All changes are allowed if there are
+// no patchable paths.
+func validatePatchablePaths(original, patched []byte, patchablePaths []string, fldPath *field.Path) *field.Error {
+	if len(patchablePaths) == 0 {
+		return nil
+	}
+
+	var originalDoc, patchedDoc any
+	if err := json.Unmarshal(original, &originalDoc); err != nil {
+		return field.InternalError(fldPath, err)
+	}
+	if err := json.Unmarshal(patched, &patchedDoc); err != nil {
+		return field.InternalError(fldPath, err)
+	}
+
+	changed := changedPaths("", originalDoc, patchedDoc)
+	slices.Sort(changed)
+	for _, changed := range changed {
+		if !isPatchable(changed, patchablePaths) {
+			return field.Forbidden(fldPath, fmt.Sprintf("%s is not a patchable path of the template", changed))
+		}
+	}
+	return nil
+}
+
+// changedPaths returns the JSON pointers of the values that differ between two JSON documents.
+// Objects and arrays of the same length are compared element by element.
+func changedPaths(path string, original, patched any) []string {
+	switch o := original.(type) {
+	case map[string]any:
+		p, ok := patched.(map[string]any)
+		if !ok {
+			break
+		}
+		var paths []string
+		for key := range o {
+			paths = append(paths, changedPaths(path+"/"+escapeJSONPointer(key), o[key], p[key])...)
+		}
+		for key := range p {
+			if _, ok := o[key]; !ok {
+				paths = append(paths, path+"/"+escapeJSONPointer(key))
+			}
+		}
+		return paths
+	case []any:
+		p, ok := patched.([]any)
+		if !ok || len(o) != len(p) {
+			break
+		}
+		var paths []string
+		for i := range o {
+			paths = append(paths, changedPaths(path+"/"+strconv.Itoa(i), o[i], p[i])...)
+		}
+		return paths
+	}
+
+	if reflect.DeepEqual(original, patched) {
+		return nil
+	}
+	return []string{path}
+}
+
+func isPatchable(path string, patchablePaths []string) bool {
+	for _, patchable := range patchablePaths {
+		patchable = strings.TrimSuffix(patchable, "/")
+		if path == patchable || strings.HasPrefix(path, patchable+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func escapeJSONPointer(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
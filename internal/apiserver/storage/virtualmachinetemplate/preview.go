@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtualmachinetemplate
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/warning"
+
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+	"kubevirt.io/virt-template-engine/template"
+)
+
+// PreviewTemplate processes the inline template of a VirtualMachineTemplatePreview into the
+// namespace of the request without persisting anything. Errors found while validating and
+// processing the template are aggregated in the status of the returned preview instead of
+// failing the request. Provenance is not recorded, as the template does not have to exist.
+func PreviewTemplate(
+	ctx context.Context,
+	processor Processor,
+	preview *subresourcesv1beta1.VirtualMachineTemplatePreview,
+	ns string,
+) (*subresourcesv1beta1.VirtualMachineTemplatePreview, error) {
+	if preview.Spec.Template == nil {
+		return nil, apierrors.NewBadRequest("spec.template is required")
+	}
+	opts := preview.Spec.Options
+	if opts == nil {
+		opts = &subresourcesv1beta1.ProcessOptions{}
+	}
+	if opts.Revision != 0 {
+		return nil, apierrors.NewBadRequest("revisions are not supported when previewing a template")
+	}
+	if opts.TargetNamespace != "" && opts.TargetNamespace != ns {
+		return nil, apierrors.NewBadRequest("templates are previewed in the namespace of the request")
+	}
+	if err := validateOutputOptions(opts); err != nil {
+		return nil, err
+	}
+
+	result := preview.DeepCopy()
+	result.Namespace = ns
+	result.Status = subresourcesv1beta1.VirtualMachineTemplatePreviewStatus{}
+
+	tpl := preview.Spec.Template.DeepCopy()
+	tpl.Namespace = ns
+
+	var errs field.ErrorList
+	var err error
+	tpl.Spec.Parameters, err = template.MergeParameters(tpl.Spec.Parameters, opts.Parameters)
+	if err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("spec", "options", "parameters"), opts.Parameters, err.Error()))
+	}
+
+	warnings, refErrs := template.ValidateParameterReferences(tpl)
+	for _, w := range warnings {
+		warning.AddWarning(ctx, "", w)
+	}
+	result.Status.Warnings = warnings
+	errs = append(errs, refErrs...)
+
+	if len(errs) == 0 {
+		result.Status.Processed, errs = previewProcessing(processor, tpl, opts, ns)
+	}
+	if result.Status.Processed != nil {
+		if err := setOutput(result.Status.Processed, opts); err != nil {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "options", "outputKind"), opts.OutputKind, err.Error()))
+			result.Status.Processed = nil
+		}
+	}
+	result.Status.Errors = statusCauses(errs)
+
+	return result, nil
+}
+
+func previewProcessing(
+	processor Processor,
+	tpl *v1beta1.VirtualMachineTemplate,
+	opts *subresourcesv1beta1.ProcessOptions,
+	ns string,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, field.ErrorList) {
+	if gErr := processor.GenerateParameterValues(tpl); gErr != nil {
+		return nil, field.ErrorList{gErr}
+	}
+
+	vm, msg, pErr := processor.Process(tpl)
+	if pErr != nil {
+		return nil, field.ErrorList{pErr}
+	}
+	vm, oErr := applyOverrides(vm, opts.Overrides, tpl.Spec.PatchablePaths, field.NewPath("spec", "options", "overrides"))
+	if oErr != nil {
+		return nil, field.ErrorList{oErr}
+	}
+	if vm.Namespace != "" && vm.Namespace != ns {
+		return nil, field.ErrorList{field.Invalid(
+			field.NewPath("spec", "virtualMachine", "metadata", "namespace"), vm.Namespace,
+			fmt.Sprintf("must match the namespace %s of the request", ns),
+		)}
+	}
+	vm.Namespace = ns
+
+	return &subresourcesv1beta1.ProcessedVirtualMachineTemplate{
+		TemplateRef: &corev1.ObjectReference{
+			Namespace: ns,
+			Name:      tpl.Name,
+		},
+		VirtualMachine: vm,
+		Message:        msg,
+	}, nil
+}
+
+func statusCauses(errs field.ErrorList) []metav1.StatusCause {
+	if len(errs) == 0 {
+		return nil
+	}
+	causes := make([]metav1.StatusCause, 0, len(errs))
+	for _, err := range errs {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseType(err.Type),
+			Message: err.ErrorBody(),
+			Field:   err.Field,
+		})
+	}
+	return causes
+}
@@ -33,16 +33,21 @@ import (
 	"k8s.io/apiserver/pkg/warning"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
 
+	templateapi "kubevirt.io/virt-template-api/core"
 	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
 	"kubevirt.io/virt-template-api/core/v1beta1"
 	templateclient "kubevirt.io/virt-template-client-go/virttemplate"
 	"kubevirt.io/virt-template-engine/template"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
 )
 
 // +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplates,verbs=get
 // +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplates/status,verbs=get
-// +kubebuilder:rbac:groups=kubevirt.io,resources=virtualmachines,verbs=create
+// +kubebuilder:rbac:groups=template.kubevirt.io,resources=clustervirtualmachinetemplates,verbs=get
+// +kubebuilder:rbac:groups=apps,resources=controllerrevisions,verbs=get
 
 const (
 	// JSONBufferSize determines how far into the stream the decoder will look for JSON.
@@ -53,22 +58,77 @@ const (
 
 // Processor processes a VirtualMachineTemplate into a VirtualMachine.
 type Processor interface {
+	GenerateParameterValues(tpl *v1beta1.VirtualMachineTemplate) *field.Error
 	Process(tpl *v1beta1.VirtualMachineTemplate) (*virtv1.VirtualMachine, string, *field.Error)
 }
 
 // ProcessTemplate fetches the named template, merges parameters from the
-// request body, and returns a ProcessedVirtualMachineTemplate.
+// request body, and returns a ProcessedVirtualMachineTemplate. If the request
+// selects a revision, the template is processed as recorded in that revision.
+// The requesting user must be allowed to use the template. If the request selects
+// another target namespace, a VirtualMachineTemplateGrant must allow the requesting
+// user to process the template into it.
 func ProcessTemplate(
 	ctx context.Context,
 	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
 	processor Processor,
 	body io.Reader,
 	ns string,
 	id string,
 ) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
-	opts := &subresourcesv1beta1.ProcessOptions{}
-	if err := yaml.NewYAMLOrJSONDecoder(body, JSONBufferSize).Decode(opts); err != nil {
-		return nil, apierrors.NewBadRequest(fmt.Sprintf("error parsing ProcessOptions: %v", err))
+	opts, err := decodeProcessOptions(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return processTemplateWithRequest(ctx, client, virtClient, processor, opts, ns, id)
+}
+
+func processTemplateWithRequest(
+	ctx context.Context,
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+	processor Processor,
+	opts *subresourcesv1beta1.ProcessOptions,
+	ns string,
+	id string,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
+	resolved, err := resolveTemplate(ctx, client, virtClient, opts, ns, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved.process(ctx, processor, opts)
+}
+
+// resolvedTemplate is a template the requesting user is allowed to process into targetNamespace.
+type resolvedTemplate struct {
+	tpl             *v1beta1.VirtualMachineTemplate
+	templateRef     *corev1.ObjectReference
+	targetNamespace string
+}
+
+// resolveTemplate authorizes the request and fetches the named template, or the revision of it
+// selected by the request.
+func resolveTemplate(
+	ctx context.Context,
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+	opts *subresourcesv1beta1.ProcessOptions,
+	ns string,
+	id string,
+) (*resolvedTemplate, error) {
+	if err := authorizeUse(ctx, virtClient, templateapi.PluralResourceName, ns, id); err != nil {
+		return nil, err
+	}
+
+	targetNamespace := ns
+	if opts.TargetNamespace != "" {
+		targetNamespace = opts.TargetNamespace
+	}
+	if err := authorizeTargetNamespace(ctx, client, ns, id, targetNamespace); err != nil {
+		return nil, err
 	}
 
 	tpl, err := client.TemplateV1beta1().VirtualMachineTemplates(ns).Get(ctx, id, metav1.GetOptions{})
@@ -76,33 +136,195 @@ func ProcessTemplate(
 		return nil, apierrors.NewInternalError(fmt.Errorf("error getting VirtualMachineTemplate: %w", err))
 	}
 
+	if opts.Revision != 0 {
+		rev, err := getTemplateRevision(ctx, virtClient, tpl, opts.Revision)
+		if err != nil {
+			return nil, err
+		}
+		if err := apimachinery.ApplyTemplateRevision(tpl, rev); err != nil {
+			return nil, apierrors.NewInternalError(err)
+		}
+	}
+
+	return &resolvedTemplate{
+		tpl: tpl,
+		templateRef: &corev1.ObjectReference{
+			Namespace: ns,
+			Name:      id,
+		},
+		targetNamespace: targetNamespace,
+	}, nil
+}
+
+// ProcessClusterTemplate fetches the named ClusterVirtualMachineTemplate, merges parameters
+// from the request body, and returns a ProcessedVirtualMachineTemplate for the namespace of
+// the request. The requesting user must be allowed to use the template. Revisions are not
+// recorded for cluster-scoped templates and cannot be selected.
+func ProcessClusterTemplate(
+	ctx context.Context,
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+	processor Processor,
+	body io.Reader,
+	ns string,
+	id string,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
+	opts, err := decodeProcessOptions(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return processClusterTemplateWithRequest(ctx, client, virtClient, processor, opts, ns, id)
+}
+
+func processClusterTemplateWithRequest(
+	ctx context.Context,
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+	processor Processor,
+	opts *subresourcesv1beta1.ProcessOptions,
+	ns string,
+	id string,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
+	resolved, err := resolveClusterTemplate(ctx, client, virtClient, opts, ns, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolved.process(ctx, processor, opts)
+}
+
+// resolveClusterTemplate authorizes the request and fetches the named ClusterVirtualMachineTemplate.
+func resolveClusterTemplate(
+	ctx context.Context,
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+	opts *subresourcesv1beta1.ProcessOptions,
+	ns string,
+	id string,
+) (*resolvedTemplate, error) {
+	if opts.Revision != 0 {
+		return nil, apierrors.NewBadRequest("revisions are not supported for ClusterVirtualMachineTemplates")
+	}
+	if opts.TargetNamespace != "" && opts.TargetNamespace != ns {
+		return nil, apierrors.NewBadRequest("ClusterVirtualMachineTemplates are processed into the namespace of the request")
+	}
+	if err := authorizeUse(ctx, virtClient, templateapi.PluralClusterResourceName, "", id); err != nil {
+		return nil, err
+	}
+
+	clusterTpl, err := client.TemplateV1beta1().ClusterVirtualMachineTemplates().Get(ctx, id, metav1.GetOptions{})
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error getting ClusterVirtualMachineTemplate: %w", err))
+	}
+
+	return &resolvedTemplate{
+		tpl: apimachinery.TemplateFromClusterTemplate(clusterTpl),
+		templateRef: &corev1.ObjectReference{
+			APIVersion: v1beta1.GroupVersion.String(),
+			Kind:       apimachinery.ClusterTemplateKind,
+			Name:       id,
+		},
+		targetNamespace: ns,
+	}, nil
+}
+
+// process processes a copy of the resolved template, so that it can be processed repeatedly.
+func (r *resolvedTemplate) process(
+	ctx context.Context,
+	processor Processor,
+	opts *subresourcesv1beta1.ProcessOptions,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
+	processed, err := processTemplateWithOptions(ctx, processor, r.tpl.DeepCopy(), opts, r.targetNamespace, r.templateRef)
+	if err != nil {
+		return nil, err
+	}
+	// Templates processed from a ClusterVirtualMachineTemplate have no namespace to clone from
+	if r.templateRef.Namespace != "" && r.targetNamespace != r.templateRef.Namespace {
+		setCloneSourceNamespaces(processed.VirtualMachine, r.templateRef.Namespace)
+	}
+	if err := setOutput(processed, opts); err != nil {
+		return nil, err
+	}
+
+	return processed, nil
+}
+
+func decodeProcessOptions(body io.Reader) (*subresourcesv1beta1.ProcessOptions, error) {
+	opts := &subresourcesv1beta1.ProcessOptions{}
+	if err := yaml.NewYAMLOrJSONDecoder(body, JSONBufferSize).Decode(opts); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("error parsing ProcessOptions: %v", err))
+	}
+	if err := validateOutputOptions(opts); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+func processTemplateWithOptions(
+	ctx context.Context,
+	processor Processor,
+	tpl *v1beta1.VirtualMachineTemplate,
+	opts *subresourcesv1beta1.ProcessOptions,
+	targetNamespace string,
+	templateRef *corev1.ObjectReference,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
+	var err error
 	tpl.Spec.Parameters, err = template.MergeParameters(tpl.Spec.Parameters, opts.Parameters)
 	if err != nil {
 		return nil, apierrors.NewConflict(schema.GroupResource{
 			Group:    tpl.GroupVersionKind().Group,
 			Resource: tpl.Kind,
-		}, id, err)
+		}, templateRef.Name, err)
 	}
 
+	vm, msg, err := processTemplate(ctx, processor, tpl, templateRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	vm, fErr := applyOverrides(vm, opts.Overrides, tpl.Spec.PatchablePaths, field.NewPath("overrides"))
+	if fErr != nil {
+		return nil, apierrors.NewBadRequest(fErr.Error())
+	}
+	if vm.Namespace != "" && vm.Namespace != targetNamespace {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf(
+			"namespace %s of the processed VirtualMachine does not match the target namespace %s", vm.Namespace, targetNamespace,
+		))
+	}
+	vm.Namespace = targetNamespace
+
+	return &subresourcesv1beta1.ProcessedVirtualMachineTemplate{
+		TemplateRef:    templateRef,
+		VirtualMachine: vm,
+		Message:        msg,
+	}, nil
+}
+
+// processTemplate validates the parameter references of a template, generates its
+// parameter values and processes it into a VirtualMachine that records its provenance.
+func processTemplate(
+	ctx context.Context,
+	processor Processor,
+	tpl *v1beta1.VirtualMachineTemplate,
+	id string,
+) (*virtv1.VirtualMachine, string, error) {
 	warnings, errs := template.ValidateParameterReferences(tpl)
 	for _, w := range warnings {
 		warning.AddWarning(ctx, "", w)
 	}
 	if len(errs) > 0 {
-		return nil, apierrors.NewInvalid(tpl.GroupVersionKind().GroupKind(), id, errs)
+		return nil, "", apierrors.NewInvalid(tpl.GroupVersionKind().GroupKind(), id, errs)
+	}
+
+	if gErr := processor.GenerateParameterValues(tpl); gErr != nil {
+		return nil, "", apierrors.NewInvalid(tpl.GroupVersionKind().GroupKind(), id, field.ErrorList{gErr})
 	}
 
 	vm, msg, pErr := processor.Process(tpl)
 	if pErr != nil {
-		return nil, apierrors.NewInvalid(tpl.GroupVersionKind().GroupKind(), id, field.ErrorList{pErr})
+		return nil, "", apierrors.NewInvalid(tpl.GroupVersionKind().GroupKind(), id, field.ErrorList{pErr})
 	}
+	apimachinery.SetTemplateProvenance(vm, tpl)
 
-	return &subresourcesv1beta1.ProcessedVirtualMachineTemplate{
-		TemplateRef: &corev1.ObjectReference{
-			Namespace: ns,
-			Name:      id,
-		},
-		VirtualMachine: vm,
-		Message:        msg,
-	}, nil
+	return vm, msg, nil
 }
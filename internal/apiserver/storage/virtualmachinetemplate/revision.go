@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtualmachinetemplate
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"kubevirt.io/client-go/kubecli"
+
+	templateapi "kubevirt.io/virt-template-api/core"
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+)
+
+// RollbackTemplate fetches the named template and restores its spec from the
+// revision selected in the request body. The template is read and updated as the
+// user of the request, so the user needs permission to update the template.
+func RollbackTemplate(
+	ctx context.Context,
+	virtClient kubecli.KubevirtClient,
+	clientForUser TemplateClientForUserFunc,
+	body io.Reader,
+	ns string,
+	id string,
+) (*subresourcesv1beta1.RolledBackVirtualMachineTemplate, error) {
+	opts := &subresourcesv1beta1.RollbackOptions{}
+	if err := yaml.NewYAMLOrJSONDecoder(body, JSONBufferSize).Decode(opts); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("error parsing RollbackOptions: %v", err))
+	}
+	if opts.Revision <= 0 {
+		return nil, apierrors.NewBadRequest("revision must be a positive number")
+	}
+
+	client, err := TemplateClientForRequestUser(ctx, clientForUser)
+	if err != nil {
+		return nil, err
+	}
+
+	tpl, err := client.TemplateV1beta1().VirtualMachineTemplates(ns).Get(ctx, id, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error getting VirtualMachineTemplate: %w", err))
+	}
+
+	rev, err := getTemplateRevision(ctx, virtClient, tpl, opts.Revision)
+	if err != nil {
+		return nil, err
+	}
+	if err := apimachinery.ApplyTemplateRevision(tpl, rev); err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	tpl, err = client.TemplateV1beta1().VirtualMachineTemplates(ns).Update(ctx, tpl, metav1.UpdateOptions{})
+	if apierrors.IsConflict(err) {
+		return nil, apierrors.NewConflict(v1beta1.GroupVersion.WithResource(templateapi.PluralResourceName).GroupResource(), id, err)
+	}
+	if apierrors.IsForbidden(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error updating VirtualMachineTemplate: %w", err))
+	}
+
+	return &subresourcesv1beta1.RolledBackVirtualMachineTemplate{
+		TemplateRef: &corev1.ObjectReference{
+			Namespace: ns,
+			Name:      id,
+		},
+		Revision:           opts.Revision,
+		TemplateGeneration: tpl.Generation,
+	}, nil
+}
+
+func getTemplateRevision(
+	ctx context.Context,
+	virtClient kubecli.KubevirtClient,
+	tpl *v1beta1.VirtualMachineTemplate,
+	revision int64,
+) (*appsv1.ControllerRevision, error) {
+	name := apimachinery.TemplateRevisionName(tpl, revision)
+	rev, err := virtClient.AppsV1().ControllerRevisions(tpl.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("revision %d of VirtualMachineTemplate does not exist", revision))
+	}
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error getting revision of VirtualMachineTemplate: %w", err))
+	}
+	if !metav1.IsControlledBy(rev, tpl) || rev.Revision != revision {
+		return nil, apierrors.NewInternalError(fmt.Errorf("ControllerRevision %s does not belong to VirtualMachineTemplate", name))
+	}
+
+	return rev, nil
+}
@@ -0,0 +1,284 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtualmachinetemplate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"gomodules.xyz/jsonpatch/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	templateapi "kubevirt.io/virt-template-api/core"
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+	templateclient "kubevirt.io/virt-template-client-go/virttemplate"
+	"kubevirt.io/virt-template-engine/template"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+)
+
+// DiffVirtualMachine re-renders the named template with the parameter values recorded
+// on the VirtualMachine selected in the request body and returns the changes between
+// the spec of the VirtualMachine and the rendered spec. The VirtualMachine is read as
+// the user of the request.
+func DiffVirtualMachine(
+	ctx context.Context,
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+	clientForUser ClientForUserFunc,
+	processor Processor,
+	body io.Reader,
+	ns string,
+	id string,
+) (*subresourcesv1beta1.VirtualMachineUpgrade, error) {
+	userClient, err := ClientForRequestUser(ctx, clientForUser)
+	if err != nil {
+		return nil, err
+	}
+	_, _, upgrade, err := renderUpgrade(ctx, client, virtClient, userClient, processor, body, ns, id)
+	return upgrade, err
+}
+
+// UpgradeVirtualMachine re-renders the named template like DiffVirtualMachine and
+// updates the spec of the VirtualMachine with the rendered spec as the user of the
+// request. The update fails with a conflict if the VirtualMachine was modified concurrently.
+func UpgradeVirtualMachine(
+	ctx context.Context,
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+	clientForUser ClientForUserFunc,
+	processor Processor,
+	body io.Reader,
+	ns string,
+	id string,
+) (*subresourcesv1beta1.VirtualMachineUpgrade, error) {
+	userClient, err := ClientForRequestUser(ctx, clientForUser)
+	if err != nil {
+		return nil, err
+	}
+	opts, vm, upgrade, err := renderUpgrade(ctx, client, virtClient, userClient, processor, body, ns, id)
+	if err != nil {
+		return nil, err
+	}
+
+	vmResource := virtv1.SchemeGroupVersion.WithResource("virtualmachines").GroupResource()
+	if opts.ResourceVersion != "" && opts.ResourceVersion != vm.ResourceVersion {
+		return nil, apierrors.NewConflict(vmResource, vm.Name,
+			fmt.Errorf("expected resourceVersion %s but VirtualMachine has resourceVersion %s", opts.ResourceVersion, vm.ResourceVersion))
+	}
+
+	// The rendered VirtualMachine carries the updated provenance of the template
+	vm.Spec = upgrade.VirtualMachine.Spec
+	for _, key := range []string{
+		v1beta1.AnnotationTemplateGeneration,
+		v1beta1.AnnotationTemplateRevision,
+		v1beta1.AnnotationTemplateParameters,
+		v1beta1.AnnotationTemplateOverrides,
+	} {
+		if value, ok := upgrade.VirtualMachine.Annotations[key]; ok {
+			metav1.SetMetaDataAnnotation(&vm.ObjectMeta, key, value)
+		}
+	}
+
+	upgrade.VirtualMachine, err = userClient.VirtualMachine(ns).Update(ctx, vm, metav1.UpdateOptions{})
+	if apierrors.IsConflict(err) {
+		return nil, apierrors.NewConflict(vmResource, vm.Name, err)
+	}
+	if apierrors.IsForbidden(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error updating VirtualMachine: %w", err))
+	}
+	upgrade.UpToDate = true
+
+	return upgrade, nil
+}
+
+func renderUpgrade(
+	ctx context.Context,
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+	userClient kubecli.KubevirtClient,
+	processor Processor,
+	body io.Reader,
+	ns string,
+	id string,
+) (*subresourcesv1beta1.UpgradeOptions, *virtv1.VirtualMachine, *subresourcesv1beta1.VirtualMachineUpgrade, error) {
+	opts := &subresourcesv1beta1.UpgradeOptions{}
+	if err := yaml.NewYAMLOrJSONDecoder(body, JSONBufferSize).Decode(opts); err != nil {
+		return nil, nil, nil, apierrors.NewBadRequest(fmt.Sprintf("error parsing UpgradeOptions: %v", err))
+	}
+	if opts.VirtualMachineName == "" {
+		return nil, nil, nil, apierrors.NewBadRequest("virtualMachineName must be set")
+	}
+
+	if err := authorizeUse(ctx, virtClient, templateapi.PluralResourceName, ns, id); err != nil {
+		return nil, nil, nil, err
+	}
+
+	tpl, err := client.TemplateV1beta1().VirtualMachineTemplates(ns).Get(ctx, id, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil, nil, err
+	}
+	if err != nil {
+		return nil, nil, nil, apierrors.NewInternalError(fmt.Errorf("error getting VirtualMachineTemplate: %w", err))
+	}
+	if opts.Revision != 0 {
+		rev, rErr := getTemplateRevision(ctx, virtClient, tpl, opts.Revision)
+		if rErr != nil {
+			return nil, nil, nil, rErr
+		}
+		if err := apimachinery.ApplyTemplateRevision(tpl, rev); err != nil {
+			return nil, nil, nil, apierrors.NewInternalError(err)
+		}
+	}
+
+	vm, err := userClient.VirtualMachine(ns).Get(ctx, opts.VirtualMachineName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+		return nil, nil, nil, err
+	}
+	if err != nil {
+		return nil, nil, nil, apierrors.NewInternalError(fmt.Errorf("error getting VirtualMachine: %w", err))
+	}
+	if vm.Labels[v1beta1.LabelTemplateUID] != string(tpl.UID) {
+		return nil, nil, nil, apierrors.NewBadRequest(
+			fmt.Sprintf("VirtualMachine %s was not created from VirtualMachineTemplate %s", vm.Name, id))
+	}
+
+	if err := restoreParameterValues(tpl, vm); err != nil {
+		return nil, nil, nil, err
+	}
+	rendered, _, err := processTemplate(ctx, processor, tpl, id)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if rendered, err = reapplyOverrides(rendered, vm, tpl.Spec.PatchablePaths); err != nil {
+		return nil, nil, nil, err
+	}
+	// The run strategy is controlled by the user and not subject to upgrades
+	rendered.Spec.RunStrategy = vm.Spec.RunStrategy
+	rendered.Spec.Running = vm.Spec.Running //nolint:staticcheck
+
+	changes, err := diffVirtualMachineSpecs(&vm.Spec, &rendered.Spec)
+	if err != nil {
+		return nil, nil, nil, apierrors.NewInternalError(err)
+	}
+
+	return opts, vm, &subresourcesv1beta1.VirtualMachineUpgrade{
+		TemplateRef: &corev1.ObjectReference{
+			Namespace: ns,
+			Name:      id,
+		},
+		Revision:       tpl.Status.Revision,
+		UpToDate:       len(changes) == 0,
+		Changes:        changes,
+		VirtualMachine: rendered,
+	}, nil
+}
+
+// restoreParameterValues sets the parameter values recorded on a VirtualMachine on the
+// template. Recorded values of parameters that no longer exist in the template are ignored.
+func restoreParameterValues(tpl *v1beta1.VirtualMachineTemplate, vm *virtv1.VirtualMachine) error {
+	recorded, err := apimachinery.GetTemplateParameters(vm)
+	if err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+	if recorded == nil && len(tpl.Spec.Parameters) > 0 {
+		return apierrors.NewBadRequest(
+			fmt.Sprintf("VirtualMachine %s does not record the parameter values it was created with", vm.Name))
+	}
+
+	params := map[string]string{}
+	for _, param := range tpl.Spec.Parameters {
+		if value, ok := recorded[param.Name]; ok {
+			params[param.Name] = value
+		}
+	}
+	tpl.Spec.Parameters, err = template.MergeParameters(tpl.Spec.Parameters, params)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	return nil
+}
+
+// reapplyOverrides applies the overrides recorded on a VirtualMachine to the VirtualMachine
+// rendered for an upgrade, so the overridden values are not reported as changes.
+func reapplyOverrides(rendered, vm *virtv1.VirtualMachine, patchablePaths []string) (*virtv1.VirtualMachine, error) {
+	overrides, err := getTemplateOverrides(vm)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	fldPath := field.NewPath("metadata", "annotations").Key(v1beta1.AnnotationTemplateOverrides)
+	rendered, fErr := applyOverrides(rendered, overrides, patchablePaths, fldPath)
+	if fErr != nil {
+		return nil, apierrors.NewBadRequest(
+			fmt.Sprintf("overrides recorded on VirtualMachine %s no longer apply: %v", vm.Name, fErr))
+	}
+	return rendered, nil
+}
+
+func diffVirtualMachineSpecs(live, rendered *virtv1.VirtualMachineSpec) ([]subresourcesv1beta1.VirtualMachineSpecChange, error) {
+	liveData, err := json.Marshal(live)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VirtualMachine spec: %w", err)
+	}
+	renderedData, err := json.Marshal(rendered)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal rendered VirtualMachine spec: %w", err)
+	}
+
+	ops, err := jsonpatch.CreatePatch(liveData, renderedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff VirtualMachine specs: %w", err)
+	}
+	sort.Sort(jsonpatch.ByPath(ops))
+
+	changes := make([]subresourcesv1beta1.VirtualMachineSpecChange, 0, len(ops))
+	for _, op := range ops {
+		change := subresourcesv1beta1.VirtualMachineSpecChange{
+			Operation: op.Operation,
+			Path:      op.Path,
+		}
+		if op.Operation != "remove" {
+			value, err := json.Marshal(op.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal changed value: %w", err)
+			}
+			change.Value = &runtime.RawExtension{Raw: value}
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
@@ -25,7 +25,6 @@ import (
 	"net/http"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -34,6 +33,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/virt-template-api/core/subresourcesv1alpha1"
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
 	templateclient "kubevirt.io/virt-template-client-go/virttemplate"
 	"kubevirt.io/virt-template-engine/template"
 
@@ -41,19 +41,22 @@ import (
 )
 
 type V1alpha1CreateREST struct {
-	client     templateclient.Interface
-	virtClient kubecli.KubevirtClient
-	processor  virtualmachinetemplate.Processor
+	client        templateclient.Interface
+	virtClient    kubecli.KubevirtClient
+	clientForUser virtualmachinetemplate.ClientForUserFunc
+	processor     virtualmachinetemplate.Processor
 }
 
 func NewV1alpha1CreateREST(
 	client templateclient.Interface,
 	virtClient kubecli.KubevirtClient,
+	clientForUser virtualmachinetemplate.ClientForUserFunc,
 ) *V1alpha1CreateREST {
 	return &V1alpha1CreateREST{
-		client:     client,
-		virtClient: virtClient,
-		processor:  template.GetDefaultProcessor(),
+		client:        client,
+		virtClient:    virtClient,
+		clientForUser: clientForUser,
+		processor:     template.GetDefaultProcessor(),
 	}
 }
 
@@ -77,15 +80,22 @@ func (c *V1alpha1CreateREST) Connect(ctx context.Context, id string, _ runtime.O
 	return http.HandlerFunc(func(_ http.ResponseWriter, req *http.Request) {
 		klog.V(virtualmachinetemplate.DebugLogLevel).Infof("POST /create (v1alpha1) for VirtualMachineTemplate %s/%s", ns, id)
 
-		processed, err := virtualmachinetemplate.ProcessTemplate(ctx, c.client, c.processor, req.Body, ns, id)
+		opts, err := virtualmachinetemplate.DecodeCreateOptions(req.Body)
 		if err != nil {
 			r.Error(err)
 			return
 		}
+		// v1alpha1 has no result type for batches, so they must not silently create a single VirtualMachine
+		if opts.Count > 0 || opts.NamePattern != "" {
+			r.Error(apierrors.NewBadRequest("count and namePattern are only supported by " + subresourcesv1beta1.GroupVersion.String()))
+			return
+		}
 
-		processed.VirtualMachine, err = c.virtClient.VirtualMachine(ns).Create(ctx, processed.VirtualMachine, metav1.CreateOptions{})
+		processed, err := virtualmachinetemplate.CreateFromTemplate(
+			ctx, c.client, c.virtClient, c.clientForUser, c.processor, opts, ns, id,
+		)
 		if err != nil {
-			r.Error(apierrors.NewInternalError(fmt.Errorf("error creating VirtualMachine: %w", err)))
+			r.Error(err)
 			return
 		}
 
@@ -22,9 +22,13 @@ package v1alpha1_test
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/endpoints/request"
 
 	"kubevirt.io/virt-template-api/core/subresourcesv1alpha1"
@@ -43,7 +47,7 @@ var _ = Describe("CreateREST", func() {
 	BeforeEach(func() {
 		fakeClient = virttemplatefake.NewSimpleClientset(newVirtualMachineTemplate())
 		fakeVirtClient = &fakeKubevirtClient{}
-		createREST = vmtv1alpha1.NewV1alpha1CreateREST(fakeClient, fakeVirtClient)
+		createREST = vmtv1alpha1.NewV1alpha1CreateREST(fakeClient, fakeVirtClient, fakeVirtClient.clientForUser)
 	})
 
 	It("NewCreateREST should create a new CreateREST instance", func() {
@@ -79,7 +83,7 @@ var _ = Describe("CreateREST", func() {
 		)
 
 		BeforeEach(func() {
-			ctx = request.WithNamespace(context.Background(), testNamespace)
+			ctx = request.WithUser(request.WithNamespace(context.Background(), testNamespace), &user.DefaultInfo{Name: testUser})
 			responder = &fakeResponder{}
 		})
 
@@ -106,6 +110,20 @@ var _ = Describe("CreateREST", func() {
 			Expect(responder.err).To(MatchError(ContainSubstring("not found")))
 		})
 
+		DescribeTable("should reject batch options", func(body string) {
+			handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			req := httptest.NewRequestWithContext(ctx, http.MethodPost, "/", strings.NewReader(body))
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			Expect(responder.err).To(MatchError(apierrors.IsBadRequest, "apierrors.IsBadRequest"))
+			Expect(responder.err).To(MatchError(ContainSubstring("count and namePattern are only supported by")))
+			Expect(fakeVirtClient.createdVM).To(BeNil())
+		},
+			Entry("count", `{"count": 5}`),
+			Entry("namePattern", `{"namePattern": "vm-${INDEX}"}`),
+		)
+
 		It("should return error when VM creation fails", func() {
 			fakeVirtClient.createErr = context.DeadlineExceeded
 
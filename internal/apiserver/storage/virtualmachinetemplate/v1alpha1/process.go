@@ -30,6 +30,8 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/klog/v2"
 
+	"kubevirt.io/client-go/kubecli"
+
 	"kubevirt.io/virt-template-api/core/subresourcesv1alpha1"
 	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
 	templateclient "kubevirt.io/virt-template-client-go/virttemplate"
@@ -39,14 +41,19 @@ import (
 )
 
 type V1alpha1ProcessREST struct {
-	client    templateclient.Interface
-	processor virtualmachinetemplate.Processor
+	client     templateclient.Interface
+	virtClient kubecli.KubevirtClient
+	processor  virtualmachinetemplate.Processor
 }
 
-func NewV1alpha1ProcessREST(client templateclient.Interface) *V1alpha1ProcessREST {
+func NewV1alpha1ProcessREST(
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+) *V1alpha1ProcessREST {
 	return &V1alpha1ProcessREST{
-		client:    client,
-		processor: template.GetDefaultProcessor(),
+		client:     client,
+		virtClient: virtClient,
+		processor:  template.GetDefaultProcessor(),
 	}
 }
 
@@ -70,7 +77,7 @@ func (p *V1alpha1ProcessREST) Connect(ctx context.Context, id string, _ runtime.
 	return http.HandlerFunc(func(_ http.ResponseWriter, req *http.Request) {
 		klog.V(virtualmachinetemplate.DebugLogLevel).Infof("POST /process (v1alpha1) for VirtualMachineTemplate %s/%s", ns, id)
 
-		processed, err := virtualmachinetemplate.ProcessTemplate(ctx, p.client, p.processor, req.Body, ns, id)
+		processed, err := virtualmachinetemplate.ProcessTemplate(ctx, p.client, p.virtClient, p.processor, req.Body, ns, id)
 		if err != nil {
 			r.Error(err)
 			return
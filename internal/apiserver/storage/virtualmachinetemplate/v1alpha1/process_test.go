@@ -27,6 +27,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/endpoints/request"
 
 	"kubevirt.io/virt-template-api/core/subresourcesv1alpha1"
@@ -43,7 +44,7 @@ var _ = Describe("ProcessREST", func() {
 
 	BeforeEach(func() {
 		fakeClient = virttemplatefake.NewSimpleClientset(newVirtualMachineTemplate())
-		processREST = vmtv1alpha1.NewV1alpha1ProcessREST(fakeClient)
+		processREST = vmtv1alpha1.NewV1alpha1ProcessREST(fakeClient, &fakeKubevirtClient{})
 	})
 
 	It("NewProcessREST should create a new ProcessREST instance", func() {
@@ -79,7 +80,7 @@ var _ = Describe("ProcessREST", func() {
 		)
 
 		BeforeEach(func() {
-			ctx = request.WithNamespace(context.Background(), testNamespace)
+			ctx = request.WithUser(request.WithNamespace(context.Background(), testNamespace), &user.DefaultInfo{Name: testUser})
 			responder = &fakeResponder{}
 		})
 
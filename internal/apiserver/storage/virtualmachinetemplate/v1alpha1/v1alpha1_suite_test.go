@@ -31,8 +31,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	authorizationv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	k8stesting "k8s.io/client-go/testing"
 
 	virtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -50,6 +55,7 @@ func TestVirtualMachineTemplateStorage(t *testing.T) {
 const (
 	testNamespace    = "test-namespace"
 	testTemplateName = "test-template"
+	testUser         = "test-user"
 	testVMName       = "test-vm"
 	testParamName    = "NAME"
 	vmJSON           = `{"apiVersion":"kubevirt.io/v1","kind":"VirtualMachine","metadata":{"name":"${NAME}"}}`
@@ -72,8 +78,27 @@ func (f *fakeResponder) Error(err error) {
 
 type fakeKubevirtClient struct {
 	kubecli.KubevirtClient
-	createErr error
-	createdVM *virtv1.VirtualMachine
+	createErr        error
+	createdVM        *virtv1.VirtualMachine
+	impersonatedUser user.Info
+	useDenied        bool
+	accessReviews    []*authorizationv1.SubjectAccessReview
+}
+
+func (f *fakeKubevirtClient) clientForUser(u user.Info) (kubecli.KubevirtClient, error) {
+	f.impersonatedUser = u
+	return f, nil
+}
+
+func (f *fakeKubevirtClient) AuthorizationV1() authorizationv1client.AuthorizationV1Interface {
+	c := k8sfake.NewSimpleClientset()
+	c.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		sar := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		f.accessReviews = append(f.accessReviews, sar)
+		sar.Status.Allowed = !f.useDenied
+		return true, sar, nil
+	})
+	return c.AuthorizationV1()
 }
 
 func (f *fakeKubevirtClient) VirtualMachine(_ string) kubecli.VirtualMachineInterface {
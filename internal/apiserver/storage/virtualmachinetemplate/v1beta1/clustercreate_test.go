@@ -0,0 +1,131 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+	virttemplatefake "kubevirt.io/virt-template-client-go/virttemplate/fake"
+
+	vmtv1beta1 "kubevirt.io/virt-template/internal/apiserver/storage/virtualmachinetemplate/v1beta1"
+)
+
+var _ = Describe("ClusterCreateREST", func() {
+	var (
+		createREST     *vmtv1beta1.V1beta1ClusterCreateREST
+		fakeClient     *virttemplatefake.Clientset
+		fakeVirtClient *fakeKubevirtClient
+	)
+
+	BeforeEach(func() {
+		fakeClient = virttemplatefake.NewSimpleClientset(newClusterVirtualMachineTemplate())
+		fakeVirtClient = &fakeKubevirtClient{}
+		createREST = vmtv1beta1.NewV1beta1ClusterCreateREST(fakeClient, fakeVirtClient, fakeVirtClient.clientForUser)
+	})
+
+	It("New should return a ProcessedVirtualMachineTemplate object", func() {
+		_, ok := createREST.New().(*subresourcesv1beta1.ProcessedVirtualMachineTemplate)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("ConnectMethods should return POST method only", func() {
+		Expect(createREST.ConnectMethods()).To(ConsistOf(http.MethodPost))
+	})
+
+	Context("Connect", func() {
+		var (
+			ctx       context.Context
+			responder *fakeResponder
+		)
+
+		BeforeEach(func() {
+			ctx = request.WithUser(request.WithNamespace(context.Background(), testNamespace), &user.DefaultInfo{Name: testUser})
+			responder = &fakeResponder{}
+		})
+
+		It("should return error when namespace is missing from context", func() {
+			handler, err := createREST.Connect(context.Background(), testTemplateName, nil, nil)
+			Expect(err).To(MatchError("missing namespace"))
+			Expect(handler).To(BeNil())
+		})
+
+		It("should process cluster template and create VM successfully", func() {
+			handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.err).ToNot(HaveOccurred())
+			Expect(responder.statusCode).To(Equal(http.StatusOK))
+			processed, ok := responder.obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplate)
+			Expect(ok).To(BeTrue())
+			Expect(fakeVirtClient.createdVM).To(Equal(processed.VirtualMachine))
+			Expect(fakeVirtClient.impersonatedUser.GetName()).To(Equal(testUser))
+			Expect(processed.VirtualMachine.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateName, testTemplateName))
+			Expect(processed.VirtualMachine.Annotations).ToNot(HaveKey(v1beta1.AnnotationTemplateNamespace))
+		})
+
+		It("should return error when cluster template is not found", func() {
+			handler, err := createREST.Connect(ctx, "nonexistent", nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.err).To(MatchError(ContainSubstring("not found")))
+		})
+
+		It("should return error when VM creation fails", func() {
+			fakeVirtClient.createErr = context.DeadlineExceeded
+
+			handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.err).To(MatchError(ContainSubstring(context.DeadlineExceeded.Error())))
+		})
+
+		It("should create a batch of VMs in the namespace of the request", func() {
+			handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+				Count:       2,
+				NamePattern: "lab-${INDEX}",
+			})
+			Expect(responder.err).ToNot(HaveOccurred())
+			batch, ok := responder.obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch)
+			Expect(ok).To(BeTrue())
+			Expect(batch.Items).To(HaveLen(2))
+			for i, item := range batch.Items {
+				Expect(item.Error).To(BeNil())
+				Expect(item.VirtualMachine.Name).To(Equal(fmt.Sprintf("lab-%d", i)))
+				Expect(item.VirtualMachine.Namespace).To(Equal(testNamespace))
+			}
+			Expect(fakeVirtClient.createdVMs).To(HaveLen(2))
+		})
+	})
+})
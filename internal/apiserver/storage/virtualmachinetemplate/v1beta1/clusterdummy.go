@@ -0,0 +1,56 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	templateapi "kubevirt.io/virt-template-api/core"
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+)
+
+// V1beta1ClusterDummyREST is required to satisfy the k8s.io/apiserver conventions.
+// A subresource cannot be served without a storage for its parent resource.
+// Although ClusterVirtualMachineTemplates are cluster-scoped, their subresources are
+// served in the namespace of the caller, which is the namespace the template is processed into.
+type V1beta1ClusterDummyREST struct{}
+
+func NewV1beta1ClusterDummyREST() *V1beta1ClusterDummyREST {
+	return &V1beta1ClusterDummyREST{}
+}
+
+var (
+	_ = rest.Storage(&V1beta1ClusterDummyREST{})
+	_ = rest.Scoper(&V1beta1ClusterDummyREST{})
+	_ = rest.SingularNameProvider(&V1beta1ClusterDummyREST{})
+)
+
+func (r *V1beta1ClusterDummyREST) New() runtime.Object {
+	return &subresourcesv1beta1.ClusterVirtualMachineTemplate{}
+}
+
+func (r *V1beta1ClusterDummyREST) Destroy() {}
+
+func (r *V1beta1ClusterDummyREST) NamespaceScoped() bool { return true }
+
+func (r *V1beta1ClusterDummyREST) GetSingularName() string {
+	return templateapi.SingularClusterResourceName
+}
@@ -0,0 +1,147 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	templateapi "kubevirt.io/virt-template-api/core"
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+	virttemplatefake "kubevirt.io/virt-template-client-go/virttemplate/fake"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+	vmtv1beta1 "kubevirt.io/virt-template/internal/apiserver/storage/virtualmachinetemplate/v1beta1"
+)
+
+var _ = Describe("ClusterProcessREST", func() {
+	var (
+		processREST    *vmtv1beta1.V1beta1ClusterProcessREST
+		fakeClient     *virttemplatefake.Clientset
+		fakeVirtClient *fakeKubevirtClient
+	)
+
+	BeforeEach(func() {
+		fakeClient = virttemplatefake.NewSimpleClientset(newClusterVirtualMachineTemplate())
+		fakeVirtClient = &fakeKubevirtClient{}
+		processREST = vmtv1beta1.NewV1beta1ClusterProcessREST(fakeClient, fakeVirtClient)
+	})
+
+	It("New should return a ProcessedVirtualMachineTemplate object", func() {
+		_, ok := processREST.New().(*subresourcesv1beta1.ProcessedVirtualMachineTemplate)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("ConnectMethods should return POST method only", func() {
+		Expect(processREST.ConnectMethods()).To(ConsistOf(http.MethodPost))
+	})
+
+	Context("Connect", func() {
+		var (
+			ctx       context.Context
+			responder *fakeResponder
+		)
+
+		BeforeEach(func() {
+			ctx = request.WithUser(request.WithNamespace(context.Background(), testNamespace), &user.DefaultInfo{Name: testUser})
+			responder = &fakeResponder{}
+		})
+
+		It("should return error when namespace is missing from context", func() {
+			handler, err := processREST.Connect(context.Background(), testTemplateName, nil, nil)
+			Expect(err).To(MatchError("missing namespace"))
+			Expect(handler).To(BeNil())
+		})
+
+		It("should process cluster template successfully", func() {
+			handler, err := processREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.err).ToNot(HaveOccurred())
+			Expect(responder.statusCode).To(Equal(http.StatusOK))
+			processed, ok := responder.obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplate)
+			Expect(ok).To(BeTrue())
+			Expect(processed.TemplateRef.Kind).To(Equal(apimachinery.ClusterTemplateKind))
+			Expect(processed.TemplateRef.Name).To(Equal(testTemplateName))
+			Expect(processed.TemplateRef.Namespace).To(BeEmpty())
+			Expect(processed.VirtualMachine.Namespace).To(Equal(testNamespace))
+			Expect(processed.VirtualMachine.Name).To(Equal(testVMName))
+			Expect(processed.VirtualMachine.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateName, testTemplateName))
+			Expect(processed.VirtualMachine.Annotations).ToNot(HaveKey(v1beta1.AnnotationTemplateNamespace))
+
+			Expect(fakeVirtClient.accessReviews).To(HaveLen(1))
+			Expect(fakeVirtClient.accessReviews[0].Spec.User).To(Equal(testUser))
+			Expect(fakeVirtClient.accessReviews[0].Spec.ResourceAttributes).To(Equal(&authorizationv1.ResourceAttributes{
+				Verb:     "use",
+				Group:    templateapi.GroupName,
+				Resource: templateapi.PluralClusterResourceName,
+				Name:     testTemplateName,
+			}))
+		})
+
+		It("should reject processing when the user may not use the cluster template", func() {
+			fakeVirtClient.useDenied = true
+
+			handler, err := processREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.err).To(MatchError(k8serrors.IsForbidden, "k8serrors.IsForbidden"))
+			Expect(responder.err).To(MatchError(ContainSubstring(`user "test-user" cannot use it`)))
+		})
+
+		It("should return error when cluster template is not found", func() {
+			handler, err := processREST.Connect(ctx, "nonexistent", nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.err).To(MatchError(ContainSubstring("not found")))
+		})
+
+		It("should reject processing a revision", func() {
+			handler, err := processREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+				Revision: 1,
+			})
+			Expect(responder.err).To(MatchError(ContainSubstring("revisions are not supported for ClusterVirtualMachineTemplates")))
+		})
+
+		It("should reject processing into another namespace than the one of the request", func() {
+			handler, err := processREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+				TargetNamespace: "other-namespace",
+			})
+			Expect(responder.err).To(MatchError(ContainSubstring("ClusterVirtualMachineTemplates are processed into the namespace of the request")))
+		})
+	})
+})
@@ -24,8 +24,6 @@ import (
 	"fmt"
 	"net/http"
 
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -41,19 +39,22 @@ import (
 )
 
 type V1beta1CreateREST struct {
-	client     templateclient.Interface
-	virtClient kubecli.KubevirtClient
-	processor  virtualmachinetemplate.Processor
+	client        templateclient.Interface
+	virtClient    kubecli.KubevirtClient
+	clientForUser virtualmachinetemplate.ClientForUserFunc
+	processor     virtualmachinetemplate.Processor
 }
 
 func NewV1beta1CreateREST(
 	client templateclient.Interface,
 	virtClient kubecli.KubevirtClient,
+	clientForUser virtualmachinetemplate.ClientForUserFunc,
 ) *V1beta1CreateREST {
 	return &V1beta1CreateREST{
-		client:     client,
-		virtClient: virtClient,
-		processor:  template.GetDefaultProcessor(),
+		client:        client,
+		virtClient:    virtClient,
+		clientForUser: clientForUser,
+		processor:     template.GetDefaultProcessor(),
 	}
 }
 
@@ -77,19 +78,28 @@ func (c *V1beta1CreateREST) Connect(ctx context.Context, id string, _ runtime.Ob
 	return http.HandlerFunc(func(_ http.ResponseWriter, req *http.Request) {
 		klog.V(virtualmachinetemplate.DebugLogLevel).Infof("POST /create for VirtualMachineTemplate %s/%s", ns, id)
 
-		processed, err := virtualmachinetemplate.ProcessTemplate(ctx, c.client, c.processor, req.Body, ns, id)
+		opts, err := virtualmachinetemplate.DecodeCreateOptions(req.Body)
 		if err != nil {
 			r.Error(err)
 			return
 		}
 
-		processed.VirtualMachine, err = c.virtClient.VirtualMachine(ns).Create(ctx, processed.VirtualMachine, metav1.CreateOptions{})
+		var result runtime.Object
+		if opts.Count > 0 {
+			result, err = virtualmachinetemplate.CreateBatchFromTemplate(
+				ctx, c.client, c.virtClient, c.clientForUser, c.processor, opts, ns, id,
+			)
+		} else {
+			result, err = virtualmachinetemplate.CreateFromTemplate(
+				ctx, c.client, c.virtClient, c.clientForUser, c.processor, opts, ns, id,
+			)
+		}
 		if err != nil {
-			r.Error(apierrors.NewInternalError(fmt.Errorf("error creating VirtualMachine: %w", err)))
+			r.Error(err)
 			return
 		}
 
-		r.Object(http.StatusOK, processed)
+		r.Object(http.StatusOK, result)
 	}), nil
 }
 
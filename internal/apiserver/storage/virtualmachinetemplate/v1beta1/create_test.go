@@ -21,13 +21,23 @@ package v1beta1_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/endpoints/request"
+	virtv1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
 	virttemplatefake "kubevirt.io/virt-template-client-go/virttemplate/fake"
 
 	vmtv1beta1 "kubevirt.io/virt-template/internal/apiserver/storage/virtualmachinetemplate/v1beta1"
@@ -43,7 +53,7 @@ var _ = Describe("CreateREST", func() {
 	BeforeEach(func() {
 		fakeClient = virttemplatefake.NewSimpleClientset(newVirtualMachineTemplate())
 		fakeVirtClient = &fakeKubevirtClient{}
-		createREST = vmtv1beta1.NewV1beta1CreateREST(fakeClient, fakeVirtClient)
+		createREST = vmtv1beta1.NewV1beta1CreateREST(fakeClient, fakeVirtClient, fakeVirtClient.clientForUser)
 	})
 
 	It("NewCreateREST should create a new CreateREST instance", func() {
@@ -79,7 +89,7 @@ var _ = Describe("CreateREST", func() {
 		)
 
 		BeforeEach(func() {
-			ctx = request.WithNamespace(context.Background(), testNamespace)
+			ctx = request.WithUser(request.WithNamespace(context.Background(), testNamespace), &user.DefaultInfo{Name: testUser})
 			responder = &fakeResponder{}
 		})
 
@@ -102,6 +112,10 @@ var _ = Describe("CreateREST", func() {
 			invokeHandler(handler, nil)
 			processed := expectSuccessfulProcess(responder)
 			Expect(fakeVirtClient.createdVM).To(Equal(processed.VirtualMachine))
+			Expect(fakeVirtClient.impersonatedUser.GetName()).To(Equal(testUser))
+			Expect(fakeVirtClient.createOptions.DryRun).To(BeEmpty())
+			Expect(processed.VirtualMachine.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateName, testTemplateName))
+			Expect(processed.VirtualMachine.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateNamespace, testNamespace))
 		})
 
 		It("should return error when template is not found", func() {
@@ -112,6 +126,80 @@ var _ = Describe("CreateREST", func() {
 			Expect(responder.err).To(MatchError(ContainSubstring("not found")))
 		})
 
+		It("should create the VM in a granted target namespace", func() {
+			const targetNamespace = "target-namespace"
+			_, err := fakeClient.TemplateV1beta1().VirtualMachineTemplateGrants(testNamespace).Create(context.Background(),
+				newVirtualMachineTemplateGrant(grantedTo(targetNamespace)), metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+				TargetNamespace: targetNamespace,
+			})
+			expectSuccessfulProcess(responder)
+			Expect(fakeVirtClient.createdVM.Namespace).To(Equal(targetNamespace))
+		})
+
+		It("should return the error when the user is not allowed to create the VM", func() {
+			fakeVirtClient.createErr = k8serrors.NewForbidden(
+				virtv1.SchemeGroupVersion.WithResource("virtualmachines").GroupResource(), testVMName, errors.New("denied"),
+			)
+
+			handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.err).To(MatchError(k8serrors.IsForbidden, "k8serrors.IsForbidden"))
+		})
+
+		It("should return error when the request has no user", func() {
+			handler, err := createREST.Connect(request.WithNamespace(context.Background(), testNamespace), testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.err).To(MatchError(ContainSubstring("missing user")))
+			Expect(fakeVirtClient.createdVM).To(BeNil())
+		})
+
+		It("should pass dry run to the VM creation", func() {
+			handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+				DryRun: []string{metav1.DryRunAll},
+			})
+			processed := expectSuccessfulProcess(responder)
+			Expect(fakeVirtClient.createdVM).To(Equal(processed.VirtualMachine))
+			Expect(fakeVirtClient.createOptions.DryRun).To(ConsistOf(metav1.DryRunAll))
+		})
+
+		It("should reject an invalid dry run value", func() {
+			handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+				DryRun: []string{"Some"},
+			})
+			Expect(responder.err).To(MatchError(k8serrors.IsBadRequest, "k8serrors.IsBadRequest"))
+			Expect(fakeVirtClient.createdVM).To(BeNil())
+		})
+
+		It("should return the validation error of the VM creation", func() {
+			fakeVirtClient.createErr = k8serrors.NewInvalid(
+				virtv1.SchemeGroupVersion.WithKind("VirtualMachine").GroupKind(), testVMName, nil,
+			)
+
+			handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+				DryRun: []string{metav1.DryRunAll},
+			})
+			Expect(responder.err).To(MatchError(k8serrors.IsInvalid, "k8serrors.IsInvalid"))
+		})
+
 		It("should return error when VM creation fails", func() {
 			fakeVirtClient.createErr = context.DeadlineExceeded
 
@@ -121,5 +209,323 @@ var _ = Describe("CreateREST", func() {
 			invokeHandler(handler, nil)
 			Expect(responder.err).To(MatchError(ContainSubstring(context.DeadlineExceeded.Error())))
 		})
+
+		It("should create a VirtualMachinePool instead of the VM", func() {
+			handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+				OutputKind: subresourcesv1beta1.OutputKindVirtualMachinePool,
+			})
+			Expect(responder.err).ToNot(HaveOccurred())
+			processed, ok := responder.obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplate)
+			Expect(ok).To(BeTrue())
+			Expect(processed.VirtualMachine).To(BeNil())
+			Expect(fakeVirtClient.createdVM).To(BeNil())
+			Expect(fakeVirtClient.createdPool).ToNot(BeNil())
+			Expect(fakeVirtClient.createdPool).To(Equal(processed.VirtualMachinePool))
+			Expect(fakeVirtClient.createdPool.Namespace).To(Equal(testNamespace))
+		})
+
+		It("should create a VirtualMachineInstanceReplicaSet instead of the VM", func() {
+			tpl := newVirtualMachineTemplate()
+			tpl.Name = "replicaset-template"
+			tpl.Spec.VirtualMachine.Raw = []byte(`{"apiVersion":"kubevirt.io/v1","kind":"VirtualMachine",` +
+				`"metadata":{"name":"${NAME}"},"spec":{"template":{"spec":{"domain":{"devices":{}}}}}}`)
+			_, err := fakeClient.TemplateV1beta1().VirtualMachineTemplates(testNamespace).Create(context.Background(), tpl, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			handler, err := createREST.Connect(ctx, tpl.Name, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+				OutputKind: subresourcesv1beta1.OutputKindVirtualMachineInstanceReplicaSet,
+			})
+			Expect(responder.err).ToNot(HaveOccurred())
+			processed, ok := responder.obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplate)
+			Expect(ok).To(BeTrue())
+			Expect(processed.VirtualMachine).To(BeNil())
+			Expect(fakeVirtClient.createdVM).To(BeNil())
+			Expect(fakeVirtClient.createdRS).ToNot(BeNil())
+			Expect(fakeVirtClient.createdRS).To(Equal(processed.VirtualMachineInstanceReplicaSet))
+		})
+
+		Context("with apply", func() {
+			It("should apply the VM with the field manager of the template", func() {
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{Apply: true, DryRun: []string{metav1.DryRunAll}})
+				processed := expectSuccessfulProcess(responder)
+				Expect(fakeVirtClient.createdVM).To(BeNil())
+				Expect(fakeVirtClient.appliedVM).To(Equal(processed.VirtualMachine))
+				Expect(fakeVirtClient.appliedVM.Kind).To(Equal("VirtualMachine"))
+				Expect(fakeVirtClient.patchType).To(Equal(types.ApplyPatchType))
+				Expect(fakeVirtClient.patchOptions.FieldManager).To(Equal("virt-template/" + testNamespace + "/" + testTemplateName))
+				Expect(fakeVirtClient.patchOptions.DryRun).To(ConsistOf(metav1.DryRunAll))
+			})
+
+			It("should pass through a conflict with another field manager", func() {
+				fakeVirtClient.patchErr = k8serrors.NewConflict(
+					virtv1.SchemeGroupVersion.WithResource("virtualmachines").GroupResource(), testVMName, errors.New("conflict"),
+				)
+
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{Apply: true})
+				Expect(responder.err).To(MatchError(k8serrors.IsConflict, "k8serrors.IsConflict"))
+			})
+
+			It("should wrap other errors in an internal error", func() {
+				fakeVirtClient.patchErr = errors.New("test error")
+
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{Apply: true})
+				Expect(responder.err).To(MatchError(k8serrors.IsInternalError, "k8serrors.IsInternalError"))
+			})
+
+			DescribeTable("should reject invalid options", func(opts *subresourcesv1beta1.ProcessOptions) {
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, opts)
+				Expect(responder.err).To(MatchError(k8serrors.IsBadRequest, "k8serrors.IsBadRequest"))
+			},
+				Entry("with an idempotency key", &subresourcesv1beta1.ProcessOptions{Apply: true, IdempotencyKey: "test-key"}),
+				Entry("with another output kind", &subresourcesv1beta1.ProcessOptions{
+					Apply:      true,
+					OutputKind: subresourcesv1beta1.OutputKindVirtualMachinePool,
+				}),
+			)
+		})
+
+		Context("with an idempotency key", func() {
+			const idempotencyKey = "test-key"
+
+			newExistingVM := func(key, templateUID string) *virtv1.VirtualMachine {
+				return &virtv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        testVMName,
+						Namespace:   testNamespace,
+						Labels:      map[string]string{v1beta1.LabelTemplateUID: templateUID},
+						Annotations: map[string]string{v1beta1.AnnotationIdempotencyKey: key},
+						UID:         "existing-vm-uid",
+					},
+				}
+			}
+
+			It("should record the key on the created VM", func() {
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{IdempotencyKey: idempotencyKey})
+				processed := expectSuccessfulProcess(responder)
+				Expect(fakeVirtClient.createdVM).To(Equal(processed.VirtualMachine))
+				Expect(processed.VirtualMachine.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationIdempotencyKey, idempotencyKey))
+			})
+
+			It("should return the VM created by a previous request with the same key", func() {
+				existing := newExistingVM(idempotencyKey, testTemplateUID)
+				fakeVirtClient.listedVMs = []virtv1.VirtualMachine{*existing}
+
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{IdempotencyKey: idempotencyKey})
+				processed := expectSuccessfulProcess(responder)
+				Expect(processed.VirtualMachine.UID).To(Equal(existing.UID))
+				Expect(fakeVirtClient.createdVM).To(BeNil())
+			})
+
+			DescribeTable("should create a new VM if no previous request matches", func(key, templateUID string) {
+				fakeVirtClient.listedVMs = []virtv1.VirtualMachine{*newExistingVM(key, templateUID)}
+
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{IdempotencyKey: idempotencyKey})
+				processed := expectSuccessfulProcess(responder)
+				Expect(fakeVirtClient.createdVM).To(Equal(processed.VirtualMachine))
+			},
+				Entry("with another key", "other-key", testTemplateUID),
+				Entry("with another template", idempotencyKey, "other-template-uid"),
+			)
+
+			It("should return the VM created concurrently with the same key", func() {
+				fakeVirtClient.vm = newExistingVM(idempotencyKey, testTemplateUID)
+				fakeVirtClient.createErr = k8serrors.NewAlreadyExists(
+					virtv1.SchemeGroupVersion.WithResource("virtualmachines").GroupResource(), testVMName,
+				)
+
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{IdempotencyKey: idempotencyKey})
+				processed := expectSuccessfulProcess(responder)
+				Expect(processed.VirtualMachine.UID).To(Equal(fakeVirtClient.vm.UID))
+			})
+
+			It("should return the conflict if the existing VM was not created with the same key", func() {
+				fakeVirtClient.vm = newExistingVM("other-key", testTemplateUID)
+				fakeVirtClient.createErr = k8serrors.NewAlreadyExists(
+					virtv1.SchemeGroupVersion.WithResource("virtualmachines").GroupResource(), testVMName,
+				)
+
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{IdempotencyKey: idempotencyKey})
+				Expect(responder.err).To(MatchError(k8serrors.IsAlreadyExists, "k8serrors.IsAlreadyExists"))
+			})
+
+			It("should append the index to the key of each VM of a batch", func() {
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+					IdempotencyKey: idempotencyKey,
+					Count:          2,
+					NamePattern:    "lab-${INDEX}",
+				})
+				Expect(responder.err).ToNot(HaveOccurred())
+				batch, ok := responder.obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch)
+				Expect(ok).To(BeTrue())
+				Expect(batch.Items).To(HaveLen(2))
+				for i, item := range batch.Items {
+					Expect(item.VirtualMachine.Annotations).To(
+						HaveKeyWithValue(v1beta1.AnnotationIdempotencyKey, fmt.Sprintf("%s-%d", idempotencyKey, i)),
+					)
+				}
+			})
+
+			DescribeTable("should reject invalid options", func(opts *subresourcesv1beta1.ProcessOptions) {
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, opts)
+				Expect(responder.err).To(MatchError(k8serrors.IsBadRequest, "k8serrors.IsBadRequest"))
+			},
+				Entry("with a too long key", &subresourcesv1beta1.ProcessOptions{IdempotencyKey: strings.Repeat("a", 129)}),
+				Entry("with another output kind", &subresourcesv1beta1.ProcessOptions{
+					IdempotencyKey: idempotencyKey,
+					OutputKind:     subresourcesv1beta1.OutputKindVirtualMachinePool,
+				}),
+			)
+		})
+
+		Context("with a count", func() {
+			const batchTemplateName = "batch-template"
+
+			expectBatch := func() *subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch {
+				ExpectWithOffset(1, responder.err).ToNot(HaveOccurred())
+				ExpectWithOffset(1, responder.statusCode).To(Equal(http.StatusOK))
+				batch, ok := responder.obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch)
+				ExpectWithOffset(1, ok).To(BeTrue())
+				return batch
+			}
+
+			vmNames := func(batch *subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch) []string {
+				var names []string
+				for _, item := range batch.Items {
+					if item.VirtualMachine != nil {
+						names = append(names, item.VirtualMachine.Name)
+					}
+				}
+				return names
+			}
+
+			It("should create the VMs named by the name pattern", func() {
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+					Count:       3,
+					NamePattern: "lab-${INDEX}",
+				})
+				batch := expectBatch()
+				Expect(batch.TemplateRef.Name).To(Equal(testTemplateName))
+				Expect(batch.Items).To(HaveLen(3))
+				for i, item := range batch.Items {
+					Expect(item.Index).To(BeEquivalentTo(i))
+					Expect(item.Error).To(BeNil())
+				}
+				Expect(vmNames(batch)).To(Equal([]string{"lab-0", "lab-1", "lab-2"}))
+				Expect(fakeVirtClient.createdVMs).To(HaveLen(3))
+				Expect(fakeVirtClient.accessReviews).To(HaveLen(1))
+			})
+
+			It("should set the index parameter and regenerate generated parameters", func() {
+				tpl := newVirtualMachineTemplate()
+				tpl.Name = batchTemplateName
+				tpl.Spec.VirtualMachine = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"kubevirt.io/v1","kind":"VirtualMachine","metadata":{"name":"${NAME}-${INDEX}-${SUFFIX}"}}`),
+				}
+				tpl.Spec.Parameters = append(tpl.Spec.Parameters,
+					v1beta1.Parameter{Name: subresourcesv1beta1.BatchIndexParameter, Value: "0"},
+					v1beta1.Parameter{Name: "SUFFIX", Generate: "expression", From: "[a-z]{16}"},
+				)
+				_, err := fakeClient.TemplateV1beta1().VirtualMachineTemplates(testNamespace).Create(context.Background(), tpl, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				handler, err := createREST.Connect(ctx, batchTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{Count: 2})
+				names := vmNames(expectBatch())
+				Expect(names).To(HaveLen(2))
+				Expect(names[0]).To(HavePrefix(testVMName + "-0-"))
+				Expect(names[1]).To(HavePrefix(testVMName + "-1-"))
+				Expect(strings.TrimPrefix(names[0], testVMName+"-0-")).ToNot(Equal(strings.TrimPrefix(names[1], testVMName+"-1-")))
+			})
+
+			It("should report errors of individual VMs", func() {
+				fakeVirtClient.createErrs = map[string]error{
+					"lab-1": k8serrors.NewAlreadyExists(virtv1.SchemeGroupVersion.WithResource("virtualmachines").GroupResource(), "lab-1"),
+				}
+
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+					Count:       3,
+					NamePattern: "lab-${INDEX}",
+				})
+				batch := expectBatch()
+				Expect(vmNames(batch)).To(Equal([]string{"lab-0", "lab-2"}))
+				Expect(batch.Items[1].VirtualMachine).To(BeNil())
+				Expect(batch.Items[1].Error).ToNot(BeNil())
+				Expect(batch.Items[1].Error.Code).To(BeEquivalentTo(http.StatusConflict))
+				Expect(batch.Items[1].Error.Reason).To(Equal(metav1.StatusReasonAlreadyExists))
+			})
+
+			It("should pass dry run to each VM creation", func() {
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+					Count:       2,
+					NamePattern: "lab-${INDEX}",
+					DryRun:      []string{metav1.DryRunAll},
+				})
+				Expect(vmNames(expectBatch())).To(HaveLen(2))
+				Expect(fakeVirtClient.createOptions.DryRun).To(ConsistOf(metav1.DryRunAll))
+			})
+
+			DescribeTable("should reject invalid options", func(opts *subresourcesv1beta1.ProcessOptions) {
+				handler, err := createREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, opts)
+				Expect(responder.err).To(MatchError(k8serrors.IsBadRequest, "k8serrors.IsBadRequest"))
+				Expect(fakeVirtClient.createdVMs).To(BeEmpty())
+			},
+				Entry("with a negative count", &subresourcesv1beta1.ProcessOptions{Count: -1}),
+				Entry("with a count above the maximum", &subresourcesv1beta1.ProcessOptions{Count: 101}),
+				Entry("with a name pattern without index", &subresourcesv1beta1.ProcessOptions{Count: 2, NamePattern: "lab"}),
+			)
+		})
 	})
 })
@@ -0,0 +1,165 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+	virttemplatefake "kubevirt.io/virt-template-client-go/virttemplate/fake"
+
+	vmtv1beta1 "kubevirt.io/virt-template/internal/apiserver/storage/virtualmachinetemplate/v1beta1"
+)
+
+var _ = Describe("InstancesREST", func() {
+	var (
+		instancesREST  *vmtv1beta1.V1beta1InstancesREST
+		fakeVirtClient *fakeKubevirtClient
+	)
+
+	newInstance := func(namespace, name, generation string) virtv1.VirtualMachine {
+		return virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+				Annotations: map[string]string{
+					v1beta1.AnnotationTemplateGeneration: generation,
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		tpl := newVirtualMachineTemplate()
+		tpl.Generation = 2
+		fakeVirtClient = &fakeKubevirtClient{}
+		instancesREST = vmtv1beta1.NewV1beta1InstancesREST(virttemplatefake.NewSimpleClientset(tpl), fakeVirtClient.clientForUser)
+	})
+
+	It("New should return a VirtualMachineTemplateInstances object", func() {
+		_, ok := instancesREST.New().(*subresourcesv1beta1.VirtualMachineTemplateInstances)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("ConnectMethods should return GET method only", func() {
+		Expect(instancesREST.ConnectMethods()).To(ConsistOf(http.MethodGet))
+	})
+
+	Context("Connect", func() {
+		var (
+			ctx       context.Context
+			responder *fakeResponder
+		)
+
+		BeforeEach(func() {
+			ctx = request.WithNamespace(context.Background(), testNamespace)
+			ctx = request.WithUser(ctx, &user.DefaultInfo{Name: testUser})
+			responder = &fakeResponder{}
+		})
+
+		It("should return error when namespace is missing from context", func() {
+			handler, err := instancesREST.Connect(context.Background(), testTemplateName, nil, nil)
+			Expect(err).To(MatchError("missing namespace"))
+			Expect(handler).To(BeNil())
+		})
+
+		It("should list instances of the template", func() {
+			fakeVirtClient.listedVMs = []virtv1.VirtualMachine{
+				newInstance("ns-b", "vm", "2"),
+				newInstance("ns-a", "vm-2", "2"),
+				newInstance("ns-a", "vm-1", "1"),
+			}
+
+			handler, err := instancesREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.statusCode).To(Equal(http.StatusOK))
+
+			instances, ok := responder.obj.(*subresourcesv1beta1.VirtualMachineTemplateInstances)
+			Expect(ok).To(BeTrue())
+			Expect(instances.TemplateRef.Namespace).To(Equal(testNamespace))
+			Expect(instances.TemplateRef.Name).To(Equal(testTemplateName))
+			Expect(instances.TemplateGeneration).To(Equal(int64(2)))
+			Expect(instances.Instances).To(Equal([]subresourcesv1beta1.TemplateInstance{
+				{Namespace: "ns-a", Name: "vm-1", TemplateGeneration: 1, Outdated: true},
+				{Namespace: "ns-a", Name: "vm-2", TemplateGeneration: 2},
+				{Namespace: "ns-b", Name: "vm", TemplateGeneration: 2},
+			}))
+			Expect(fakeVirtClient.impersonatedUser.GetName()).To(Equal(testUser))
+		})
+
+		It("should only list instances in the namespace of the template if the user may not list them cluster-wide", func() {
+			fakeVirtClient.listAllDenied = true
+			fakeVirtClient.listedVMs = []virtv1.VirtualMachine{
+				newInstance(testNamespace, "vm", "2"),
+				newInstance("ns-a", "vm", "2"),
+			}
+
+			handler, err := instancesREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.statusCode).To(Equal(http.StatusOK))
+
+			instances, ok := responder.obj.(*subresourcesv1beta1.VirtualMachineTemplateInstances)
+			Expect(ok).To(BeTrue())
+			Expect(instances.Instances).To(Equal([]subresourcesv1beta1.TemplateInstance{
+				{Namespace: testNamespace, Name: "vm", TemplateGeneration: 2},
+			}))
+		})
+
+		It("should return error when the request has no user", func() {
+			handler, err := instancesREST.Connect(request.WithNamespace(context.Background(), testNamespace), testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.err).To(MatchError(ContainSubstring("missing user")))
+		})
+
+		It("should return error when template is not found", func() {
+			handler, err := instancesREST.Connect(ctx, "nonexistent", nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.err).To(MatchError(ContainSubstring("not found")))
+		})
+
+		It("should return error when listing VirtualMachines fails", func() {
+			fakeVirtClient.listErr = context.DeadlineExceeded
+
+			handler, err := instancesREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.err).To(MatchError(ContainSubstring(context.DeadlineExceeded.Error())))
+		})
+	})
+})
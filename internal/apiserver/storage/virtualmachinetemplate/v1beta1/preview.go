@@ -0,0 +1,87 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/klog/v2"
+
+	templateapi "kubevirt.io/virt-template-api/core"
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-engine/template"
+
+	"kubevirt.io/virt-template/internal/apiserver/storage/virtualmachinetemplate"
+)
+
+// V1beta1PreviewREST serves VirtualMachineTemplatePreviews. Like reviews in the
+// Kubernetes API they can only be created and are never persisted.
+type V1beta1PreviewREST struct {
+	processor virtualmachinetemplate.Processor
+}
+
+func NewV1beta1PreviewREST() *V1beta1PreviewREST {
+	return &V1beta1PreviewREST{
+		processor: template.GetDefaultProcessor(),
+	}
+}
+
+var (
+	_ = rest.Storage(&V1beta1PreviewREST{})
+	_ = rest.Creater(&V1beta1PreviewREST{})
+	_ = rest.Scoper(&V1beta1PreviewREST{})
+	_ = rest.SingularNameProvider(&V1beta1PreviewREST{})
+)
+
+func (p *V1beta1PreviewREST) New() runtime.Object {
+	return &subresourcesv1beta1.VirtualMachineTemplatePreview{}
+}
+
+func (p *V1beta1PreviewREST) Destroy() {}
+
+func (p *V1beta1PreviewREST) NamespaceScoped() bool { return true }
+
+func (p *V1beta1PreviewREST) GetSingularName() string {
+	return templateapi.SingularPreviewResourceName
+}
+
+func (p *V1beta1PreviewREST) Create(
+	ctx context.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions,
+) (runtime.Object, error) {
+	ns, ok := request.NamespaceFrom(ctx)
+	if !ok {
+		return nil, fmt.Errorf("missing namespace")
+	}
+
+	preview, ok := obj.(*subresourcesv1beta1.VirtualMachineTemplatePreview)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a VirtualMachineTemplatePreview but got %T", obj))
+	}
+
+	klog.V(virtualmachinetemplate.DebugLogLevel).Infof("POST VirtualMachineTemplatePreview in namespace %s", ns)
+
+	return virtualmachinetemplate.PreviewTemplate(ctx, p.processor, preview, ns)
+}
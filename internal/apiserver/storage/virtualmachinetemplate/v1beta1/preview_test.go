@@ -0,0 +1,200 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	templateapi "kubevirt.io/virt-template-api/core"
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	vmtv1beta1 "kubevirt.io/virt-template/internal/apiserver/storage/virtualmachinetemplate/v1beta1"
+)
+
+var _ = Describe("PreviewREST", func() {
+	var (
+		previewREST *vmtv1beta1.V1beta1PreviewREST
+		ctx         context.Context
+		tpl         *v1beta1.VirtualMachineTemplate
+	)
+
+	BeforeEach(func() {
+		previewREST = vmtv1beta1.NewV1beta1PreviewREST()
+		ctx = request.WithNamespace(context.Background(), testNamespace)
+		tpl = newVirtualMachineTemplate()
+		tpl.Namespace = ""
+		tpl.UID = ""
+	})
+
+	newPreview := func(options *subresourcesv1beta1.ProcessOptions) *subresourcesv1beta1.VirtualMachineTemplatePreview {
+		return &subresourcesv1beta1.VirtualMachineTemplatePreview{
+			Spec: subresourcesv1beta1.VirtualMachineTemplatePreviewSpec{
+				Template: tpl,
+				Options:  options,
+			},
+		}
+	}
+
+	create := func(preview runtime.Object) (*subresourcesv1beta1.VirtualMachineTemplatePreview, error) {
+		obj, err := previewREST.Create(ctx, preview, nil, &metav1.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		result, ok := obj.(*subresourcesv1beta1.VirtualMachineTemplatePreview)
+		ExpectWithOffset(1, ok).To(BeTrue())
+		return result, nil
+	}
+
+	It("New should return a VirtualMachineTemplatePreview object", func() {
+		_, ok := previewREST.New().(*subresourcesv1beta1.VirtualMachineTemplatePreview)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should be namespace scoped", func() {
+		Expect(previewREST.NamespaceScoped()).To(BeTrue())
+		Expect(previewREST.GetSingularName()).To(Equal(templateapi.SingularPreviewResourceName))
+	})
+
+	It("should return error when namespace is missing from context", func() {
+		_, err := previewREST.Create(context.Background(), newPreview(nil), nil, &metav1.CreateOptions{})
+		Expect(err).To(MatchError("missing namespace"))
+	})
+
+	It("should reject unexpected objects", func() {
+		_, err := create(&subresourcesv1beta1.ProcessOptions{})
+		Expect(err).To(MatchError(k8serrors.IsBadRequest, "k8serrors.IsBadRequest"))
+	})
+
+	It("should reject a preview without template", func() {
+		_, err := create(&subresourcesv1beta1.VirtualMachineTemplatePreview{})
+		Expect(err).To(MatchError(ContainSubstring("spec.template is required")))
+	})
+
+	It("should reject a revision", func() {
+		_, err := create(newPreview(&subresourcesv1beta1.ProcessOptions{Revision: 1}))
+		Expect(err).To(MatchError(k8serrors.IsBadRequest, "k8serrors.IsBadRequest"))
+	})
+
+	It("should reject another target namespace", func() {
+		_, err := create(newPreview(&subresourcesv1beta1.ProcessOptions{TargetNamespace: "other-namespace"}))
+		Expect(err).To(MatchError(k8serrors.IsBadRequest, "k8serrors.IsBadRequest"))
+	})
+
+	It("should process the inline template into the namespace of the request", func() {
+		result, err := create(newPreview(nil))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(result.Namespace).To(Equal(testNamespace))
+		Expect(result.Status.Errors).To(BeEmpty())
+		Expect(result.Status.Processed).ToNot(BeNil())
+		Expect(result.Status.Processed.TemplateRef.Name).To(Equal(testTemplateName))
+		Expect(result.Status.Processed.TemplateRef.Namespace).To(Equal(testNamespace))
+		Expect(result.Status.Processed.VirtualMachine.Name).To(Equal(testVMName))
+		Expect(result.Status.Processed.VirtualMachine.Namespace).To(Equal(testNamespace))
+		Expect(result.Status.Processed.VirtualMachine.Labels).ToNot(HaveKey(v1beta1.LabelTemplateUID))
+	})
+
+	It("should merge the parameters of the options", func() {
+		result, err := create(newPreview(&subresourcesv1beta1.ProcessOptions{
+			Parameters: map[string]string{testParamName: "other-vm"},
+		}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status.Errors).To(BeEmpty())
+		Expect(result.Status.Processed.VirtualMachine.Name).To(Equal("other-vm"))
+	})
+
+	It("should wrap the processed VM into the requested output kind", func() {
+		result, err := create(newPreview(&subresourcesv1beta1.ProcessOptions{
+			OutputKind: subresourcesv1beta1.OutputKindVirtualMachinePool,
+		}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status.Errors).To(BeEmpty())
+		Expect(result.Status.Processed.VirtualMachinePool).ToNot(BeNil())
+		Expect(result.Status.Processed.VirtualMachinePool.Name).To(Equal(testVMName))
+	})
+
+	It("should report a template that cannot be wrapped into the output kind in the status", func() {
+		result, err := create(newPreview(&subresourcesv1beta1.ProcessOptions{
+			OutputKind: subresourcesv1beta1.OutputKindVirtualMachineInstanceReplicaSet,
+		}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status.Processed).To(BeNil())
+		Expect(result.Status.Errors).To(ConsistOf(HaveField("Field", "spec.options.outputKind")))
+	})
+
+	It("should apply the overrides of the options", func() {
+		result, err := create(newPreview(&subresourcesv1beta1.ProcessOptions{
+			Overrides: &subresourcesv1beta1.VirtualMachineOverrides{Labels: map[string]string{"team": "blue"}},
+		}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status.Errors).To(BeEmpty())
+		Expect(result.Status.Processed.VirtualMachine.Labels).To(HaveKeyWithValue("team", "blue"))
+	})
+
+	It("should report overrides outside of the patchable paths in the status", func() {
+		tpl.Spec.PatchablePaths = []string{"/metadata/annotations"}
+		result, err := create(newPreview(&subresourcesv1beta1.ProcessOptions{
+			Overrides: &subresourcesv1beta1.VirtualMachineOverrides{Labels: map[string]string{"team": "blue"}},
+		}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status.Processed).To(BeNil())
+		Expect(result.Status.Errors).To(ConsistOf(HaveField("Field", "spec.options.overrides")))
+	})
+
+	It("should report unknown parameters in the status", func() {
+		result, err := create(newPreview(&subresourcesv1beta1.ProcessOptions{
+			Parameters: map[string]string{"UNKNOWN": "value"},
+		}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status.Processed).To(BeNil())
+		Expect(result.Status.Errors).To(ContainElement(HaveField("Field", "spec.options.parameters")))
+	})
+
+	It("should report invalid parameter references in the status", func() {
+		tpl.Spec.VirtualMachine = &runtime.RawExtension{
+			Raw: []byte(`{"apiVersion":"kubevirt.io/v1","kind":"VirtualMachine","metadata":{"name":"${NAME}-${UNKNOWN}"}}`),
+		}
+
+		result, err := create(newPreview(nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status.Processed).To(BeNil())
+		Expect(result.Status.Errors).ToNot(BeEmpty())
+	})
+
+	It("should report a namespace not matching the request in the status", func() {
+		tpl.Spec.VirtualMachine = &runtime.RawExtension{
+			Raw: []byte(`{"apiVersion":"kubevirt.io/v1","kind":"VirtualMachine","metadata":{"name":"${NAME}","namespace":"${NAMESPACE}"}}`),
+		}
+		tpl.Spec.Parameters = append(tpl.Spec.Parameters, v1beta1.Parameter{Name: "NAMESPACE", Value: "other-namespace"})
+
+		result, err := create(newPreview(nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Status.Processed).To(BeNil())
+		Expect(result.Status.Errors).To(ConsistOf(HaveField("Field", "spec.virtualMachine.metadata.namespace")))
+	})
+})
@@ -29,6 +29,8 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/klog/v2"
 
+	"kubevirt.io/client-go/kubecli"
+
 	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
 	templateclient "kubevirt.io/virt-template-client-go/virttemplate"
 	"kubevirt.io/virt-template-engine/template"
@@ -37,14 +39,19 @@ import (
 )
 
 type V1beta1ProcessREST struct {
-	client    templateclient.Interface
-	processor virtualmachinetemplate.Processor
+	client     templateclient.Interface
+	virtClient kubecli.KubevirtClient
+	processor  virtualmachinetemplate.Processor
 }
 
-func NewV1beta1ProcessREST(client templateclient.Interface) *V1beta1ProcessREST {
+func NewV1beta1ProcessREST(
+	client templateclient.Interface,
+	virtClient kubecli.KubevirtClient,
+) *V1beta1ProcessREST {
 	return &V1beta1ProcessREST{
-		client:    client,
-		processor: template.GetDefaultProcessor(),
+		client:     client,
+		virtClient: virtClient,
+		processor:  template.GetDefaultProcessor(),
 	}
 }
 
@@ -68,7 +75,7 @@ func (p *V1beta1ProcessREST) Connect(ctx context.Context, id string, _ runtime.O
 	return http.HandlerFunc(func(_ http.ResponseWriter, req *http.Request) {
 		klog.V(virtualmachinetemplate.DebugLogLevel).Infof("POST /process for VirtualMachineTemplate %s/%s", ns, id)
 
-		processed, err := virtualmachinetemplate.ProcessTemplate(ctx, p.client, p.processor, req.Body, ns, id)
+		processed, err := virtualmachinetemplate.ProcessTemplate(ctx, p.client, p.virtClient, p.processor, req.Body, ns, id)
 		if err != nil {
 			r.Error(err)
 			return
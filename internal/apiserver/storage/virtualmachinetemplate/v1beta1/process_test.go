@@ -27,9 +27,20 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/endpoints/request"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1beta1 "kubevirt.io/api/pool/v1beta1"
+
+	templateapi "kubevirt.io/virt-template-api/core"
 	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
 	virttemplatefake "kubevirt.io/virt-template-client-go/virttemplate/fake"
 
 	vmtv1beta1 "kubevirt.io/virt-template/internal/apiserver/storage/virtualmachinetemplate/v1beta1"
@@ -37,13 +48,17 @@ import (
 
 var _ = Describe("ProcessREST", func() {
 	var (
-		processREST *vmtv1beta1.V1beta1ProcessREST
-		fakeClient  *virttemplatefake.Clientset
+		processREST    *vmtv1beta1.V1beta1ProcessREST
+		fakeClient     *virttemplatefake.Clientset
+		fakeVirtClient *fakeKubevirtClient
 	)
 
 	BeforeEach(func() {
 		fakeClient = virttemplatefake.NewSimpleClientset(newVirtualMachineTemplate())
-		processREST = vmtv1beta1.NewV1beta1ProcessREST(fakeClient)
+		fakeVirtClient = &fakeKubevirtClient{
+			kubeClient: k8sfake.NewSimpleClientset(newTemplateRevision(1, "revision-vm")),
+		}
+		processREST = vmtv1beta1.NewV1beta1ProcessREST(fakeClient, fakeVirtClient)
 	})
 
 	It("NewProcessREST should create a new ProcessREST instance", func() {
@@ -79,7 +94,7 @@ var _ = Describe("ProcessREST", func() {
 		)
 
 		BeforeEach(func() {
-			ctx = request.WithNamespace(context.Background(), testNamespace)
+			ctx = request.WithUser(request.WithNamespace(context.Background(), testNamespace), &user.DefaultInfo{Name: testUser})
 			responder = &fakeResponder{}
 		})
 
@@ -101,6 +116,27 @@ var _ = Describe("ProcessREST", func() {
 
 			invokeHandler(handler, nil)
 			expectSuccessfulProcess(responder)
+
+			Expect(fakeVirtClient.accessReviews).To(HaveLen(1))
+			Expect(fakeVirtClient.accessReviews[0].Spec.User).To(Equal(testUser))
+			Expect(fakeVirtClient.accessReviews[0].Spec.ResourceAttributes).To(Equal(&authorizationv1.ResourceAttributes{
+				Namespace: testNamespace,
+				Verb:      "use",
+				Group:     templateapi.GroupName,
+				Resource:  templateapi.PluralResourceName,
+				Name:      testTemplateName,
+			}))
+		})
+
+		It("should reject processing when the user may not use the template", func() {
+			fakeVirtClient.useDenied = true
+
+			handler, err := processREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, nil)
+			Expect(responder.err).To(MatchError(k8serrors.IsForbidden, "k8serrors.IsForbidden"))
+			Expect(responder.err).To(MatchError(ContainSubstring(`user "test-user" cannot use it`)))
 		})
 
 		It("should return error when template is not found", func() {
@@ -138,5 +174,419 @@ var _ = Describe("ProcessREST", func() {
 			Expect(ok).To(BeTrue())
 			Expect(processed.VirtualMachine.Name).To(Equal(overriddenName))
 		})
+
+		It("should process the selected revision of the template", func() {
+			handler, err := processREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+				Revision: 1,
+			})
+
+			Expect(responder.statusCode).To(Equal(http.StatusOK))
+			processed, ok := responder.obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplate)
+			Expect(ok).To(BeTrue())
+			Expect(processed.VirtualMachine.Name).To(Equal("revision-vm"))
+			Expect(processed.VirtualMachine.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateRevision, "1"))
+			Expect(processed.VirtualMachine.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateGeneration, "1"))
+		})
+
+		It("should return error when the selected revision does not exist", func() {
+			handler, err := processREST.Connect(ctx, testTemplateName, nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+				Revision: 2,
+			})
+
+			Expect(responder.err).To(MatchError(ContainSubstring("revision 2 of VirtualMachineTemplate does not exist")))
+		})
+
+		Context("with a target namespace", func() {
+			const targetNamespace = "target-namespace"
+
+			createGrant := func(from v1beta1.VirtualMachineTemplateGrantFrom, to ...v1beta1.VirtualMachineTemplateGrantTo) {
+				_, err := fakeClient.TemplateV1beta1().VirtualMachineTemplateGrants(testNamespace).Create(
+					context.Background(), newVirtualMachineTemplateGrant(from, to...), metav1.CreateOptions{},
+				)
+				ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			}
+
+			processIntoTarget := func() {
+				handler, err := processREST.Connect(ctx, testTemplateName, nil, responder)
+				ExpectWithOffset(1, err).ToNot(HaveOccurred())
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+					TargetNamespace: targetNamespace,
+				})
+			}
+
+			BeforeEach(func() {
+				ctx = request.WithUser(ctx, &user.DefaultInfo{
+					Name:   testUser,
+					Groups: []string{"test-group"},
+				})
+			})
+
+			It("should set the namespace of the VirtualMachine to the namespace of the template by default", func() {
+				handler, err := processREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, nil)
+				processed := expectSuccessfulProcess(responder)
+				Expect(processed.VirtualMachine.Namespace).To(Equal(testNamespace))
+			})
+
+			It("should reject processing into another namespace without a grant", func() {
+				processIntoTarget()
+				Expect(responder.err).To(MatchError(k8serrors.IsForbidden, "k8serrors.IsForbidden"))
+				Expect(responder.err).To(MatchError(ContainSubstring(
+					"no VirtualMachineTemplateGrant in namespace test-namespace allows test-user to process it into namespace target-namespace",
+				)))
+			})
+
+			It("should reject an invalid target namespace", func() {
+				handler, err := processREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+					TargetNamespace: "Invalid_Namespace",
+				})
+				Expect(responder.err).To(MatchError(k8serrors.IsBadRequest, "k8serrors.IsBadRequest"))
+			})
+
+			It("should process into a granted namespace", func() {
+				createGrant(grantedTo(targetNamespace))
+
+				processIntoTarget()
+				processed := expectSuccessfulProcess(responder)
+				Expect(processed.VirtualMachine.Namespace).To(Equal(targetNamespace))
+				Expect(processed.VirtualMachine.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateNamespace, testNamespace))
+			})
+
+			It("should reject processing into a namespace granted without subjects", func() {
+				createGrant(v1beta1.VirtualMachineTemplateGrantFrom{Namespace: targetNamespace})
+
+				processIntoTarget()
+				Expect(responder.err).To(MatchError(k8serrors.IsForbidden, "k8serrors.IsForbidden"))
+			})
+
+			It("should reject processing into a namespace that is granted for another namespace", func() {
+				createGrant(grantedTo("other-namespace"))
+
+				processIntoTarget()
+				Expect(responder.err).To(MatchError(k8serrors.IsForbidden, "k8serrors.IsForbidden"))
+			})
+
+			It("should reject processing a template that is not granted", func() {
+				createGrant(grantedTo(targetNamespace),
+					v1beta1.VirtualMachineTemplateGrantTo{Name: "other-template"})
+
+				processIntoTarget()
+				Expect(responder.err).To(MatchError(k8serrors.IsForbidden, "k8serrors.IsForbidden"))
+			})
+
+			It("should reject processing without a user", func() {
+				ctx = request.WithNamespace(context.Background(), testNamespace)
+				createGrant(grantedTo(targetNamespace))
+
+				processIntoTarget()
+				Expect(responder.err).To(MatchError(k8serrors.IsForbidden, "k8serrors.IsForbidden"))
+			})
+
+			DescribeTable("should match the subjects of a grant", func(subject v1beta1.VirtualMachineTemplateGrantSubject, allowed bool) {
+				createGrant(v1beta1.VirtualMachineTemplateGrantFrom{
+					Namespace: targetNamespace,
+					Subjects:  []v1beta1.VirtualMachineTemplateGrantSubject{subject},
+				})
+
+				processIntoTarget()
+				if allowed {
+					expectSuccessfulProcess(responder)
+				} else {
+					Expect(responder.err).To(MatchError(k8serrors.IsForbidden, "k8serrors.IsForbidden"))
+				}
+			},
+				Entry("matching user", v1beta1.VirtualMachineTemplateGrantSubject{
+					Kind: v1beta1.GrantSubjectUser, Name: testUser,
+				}, true),
+				Entry("other user", v1beta1.VirtualMachineTemplateGrantSubject{
+					Kind: v1beta1.GrantSubjectUser, Name: "other-user",
+				}, false),
+				Entry("matching group", v1beta1.VirtualMachineTemplateGrantSubject{
+					Kind: v1beta1.GrantSubjectGroup, Name: "test-group",
+				}, true),
+				Entry("other group", v1beta1.VirtualMachineTemplateGrantSubject{
+					Kind: v1beta1.GrantSubjectGroup, Name: "other-group",
+				}, false),
+				Entry("service account", v1beta1.VirtualMachineTemplateGrantSubject{
+					Kind: v1beta1.GrantSubjectServiceAccount, Namespace: targetNamespace, Name: testUser,
+				}, false),
+			)
+
+			It("should process into a namespace granted to a service account", func() {
+				ctx = request.WithUser(ctx, &user.DefaultInfo{
+					Name: "system:serviceaccount:" + targetNamespace + ":test-sa",
+				})
+				createGrant(v1beta1.VirtualMachineTemplateGrantFrom{
+					Namespace: targetNamespace,
+					Subjects: []v1beta1.VirtualMachineTemplateGrantSubject{{
+						Kind: v1beta1.GrantSubjectServiceAccount, Namespace: targetNamespace, Name: "test-sa",
+					}},
+				})
+
+				processIntoTarget()
+				expectSuccessfulProcess(responder)
+			})
+
+			It("should keep clone sources in the namespace of the template", func() {
+				createGrant(grantedTo(targetNamespace))
+				tpl := newVirtualMachineTemplate()
+				tpl.Name = "clone-template"
+				tpl.Spec.VirtualMachine.Raw = []byte(`{"apiVersion":"kubevirt.io/v1","kind":"VirtualMachine",` +
+					`"metadata":{"name":"${NAME}"},"spec":{"dataVolumeTemplates":[` +
+					`{"metadata":{"name":"pvc"},"spec":{"source":{"pvc":{"name":"golden-pvc"}}}},` +
+					`{"metadata":{"name":"snapshot"},"spec":{"source":{"snapshot":{"name":"golden-snapshot"}}}},` +
+					`{"metadata":{"name":"datasource"},"spec":{"sourceRef":{"kind":"DataSource","name":"golden-ds"}}},` +
+					`{"metadata":{"name":"other"},"spec":{"sourceRef":{"kind":"DataSource","namespace":"other","name":"other-ds"}}}]}}`)
+				_, err := fakeClient.TemplateV1beta1().VirtualMachineTemplates(testNamespace).Create(
+					context.Background(), tpl, metav1.CreateOptions{},
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				handler, err := processREST.Connect(ctx, tpl.Name, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+					TargetNamespace: targetNamespace,
+				})
+
+				Expect(responder.err).ToNot(HaveOccurred())
+				processed, ok := responder.obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplate)
+				Expect(ok).To(BeTrue())
+				dvts := processed.VirtualMachine.Spec.DataVolumeTemplates
+				Expect(dvts).To(HaveLen(4))
+				Expect(dvts[0].Spec.Source.PVC.Namespace).To(Equal(testNamespace))
+				Expect(dvts[1].Spec.Source.Snapshot.Namespace).To(Equal(testNamespace))
+				Expect(dvts[2].Spec.SourceRef.Namespace).To(HaveValue(Equal(testNamespace)))
+				Expect(dvts[3].Spec.SourceRef.Namespace).To(HaveValue(Equal("other")))
+			})
+		})
+
+		Context("with an output kind", func() {
+			const outputTemplateName = "output-template"
+
+			BeforeEach(func() {
+				tpl := newVirtualMachineTemplate()
+				tpl.Name = outputTemplateName
+				tpl.Spec.VirtualMachine.Raw = []byte(`{"apiVersion":"kubevirt.io/v1","kind":"VirtualMachine",` +
+					`"metadata":{"name":"${NAME}","labels":{"app":"fleet"}},` +
+					`"spec":{"runStrategy":"Always","template":{"metadata":{"labels":{"tier":"web"}},"spec":{"domain":{"devices":{}}}}}}`)
+				_, err := fakeClient.TemplateV1beta1().VirtualMachineTemplates(testNamespace).Create(
+					context.Background(), tpl, metav1.CreateOptions{},
+				)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			process := func(opts *subresourcesv1beta1.ProcessOptions) *subresourcesv1beta1.ProcessedVirtualMachineTemplate {
+				handler, err := processREST.Connect(ctx, outputTemplateName, nil, responder)
+				ExpectWithOffset(1, err).ToNot(HaveOccurred())
+				invokeHandler(handler, opts)
+
+				ExpectWithOffset(1, responder.err).ToNot(HaveOccurred())
+				processed, ok := responder.obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplate)
+				ExpectWithOffset(1, ok).To(BeTrue())
+				return processed
+			}
+
+			It("should wrap the VM into a VirtualMachinePool", func() {
+				processed := process(&subresourcesv1beta1.ProcessOptions{
+					OutputKind: subresourcesv1beta1.OutputKindVirtualMachinePool,
+					Replicas:   ptr.To[int32](3),
+				})
+				Expect(processed.VirtualMachine).To(BeNil())
+				Expect(processed.VirtualMachineInstanceReplicaSet).To(BeNil())
+				pool := processed.VirtualMachinePool
+				Expect(pool).ToNot(BeNil())
+				Expect(pool.Kind).To(Equal(poolv1beta1.VirtualMachinePoolKind))
+				Expect(pool.Name).To(Equal(testVMName))
+				Expect(pool.Namespace).To(Equal(testNamespace))
+				Expect(pool.Labels).To(HaveKeyWithValue(v1beta1.LabelTemplateUID, testTemplateUID))
+				Expect(pool.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateName, outputTemplateName))
+				Expect(pool.Spec.Replicas).To(HaveValue(BeEquivalentTo(3)))
+				Expect(pool.Spec.Selector.MatchLabels).To(Equal(map[string]string{v1beta1.LabelOutputName: testVMName}))
+				Expect(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels).To(HaveKeyWithValue(v1beta1.LabelOutputName, testVMName))
+				Expect(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels).To(HaveKeyWithValue("app", "fleet"))
+				Expect(pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels).To(HaveKeyWithValue("tier", "web"))
+			})
+
+			It("should wrap the VMI template of the VM into a VirtualMachineInstanceReplicaSet", func() {
+				processed := process(&subresourcesv1beta1.ProcessOptions{
+					OutputKind: subresourcesv1beta1.OutputKindVirtualMachineInstanceReplicaSet,
+					Replicas:   ptr.To[int32](2),
+				})
+				Expect(processed.VirtualMachine).To(BeNil())
+				Expect(processed.VirtualMachinePool).To(BeNil())
+				rs := processed.VirtualMachineInstanceReplicaSet
+				Expect(rs).ToNot(BeNil())
+				Expect(rs.Kind).To(Equal("VirtualMachineInstanceReplicaSet"))
+				Expect(rs.Name).To(Equal(testVMName))
+				Expect(rs.Namespace).To(Equal(testNamespace))
+				Expect(rs.Spec.Replicas).To(HaveValue(BeEquivalentTo(2)))
+				Expect(rs.Spec.Selector.MatchLabels).To(Equal(map[string]string{v1beta1.LabelOutputName: testVMName}))
+				Expect(rs.Spec.Template.ObjectMeta.Labels).To(Equal(map[string]string{"tier": "web", v1beta1.LabelOutputName: testVMName}))
+			})
+
+			It("should reject a VirtualMachineInstanceReplicaSet for VMs without a VMI template", func() {
+				handler, err := processREST.Connect(ctx, testTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{
+					OutputKind: subresourcesv1beta1.OutputKindVirtualMachineInstanceReplicaSet,
+				})
+				Expect(responder.err).To(MatchError(k8serrors.IsBadRequest, "k8serrors.IsBadRequest"))
+			})
+
+			DescribeTable("should reject wrapping a VM without a name", func(outputKind subresourcesv1beta1.OutputKind) {
+				tpl := newVirtualMachineTemplate()
+				tpl.Name = "generate-name-template"
+				tpl.Spec.VirtualMachine.Raw = []byte(`{"apiVersion":"kubevirt.io/v1","kind":"VirtualMachine",` +
+					`"metadata":{"generateName":"${NAME}-"},"spec":{"template":{"spec":{"domain":{"devices":{}}}}}}`)
+				_, err := fakeClient.TemplateV1beta1().VirtualMachineTemplates(testNamespace).Create(
+					context.Background(), tpl, metav1.CreateOptions{},
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				handler, err := processREST.Connect(ctx, tpl.Name, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{OutputKind: outputKind})
+				Expect(responder.err).To(MatchError(k8serrors.IsBadRequest, "k8serrors.IsBadRequest"))
+				Expect(responder.err).To(MatchError(ContainSubstring("must have a name")))
+			},
+				Entry("into a VirtualMachinePool", subresourcesv1beta1.OutputKindVirtualMachinePool),
+				Entry("into a VirtualMachineInstanceReplicaSet", subresourcesv1beta1.OutputKindVirtualMachineInstanceReplicaSet),
+			)
+
+			DescribeTable("should reject invalid options", func(opts *subresourcesv1beta1.ProcessOptions) {
+				handler, err := processREST.Connect(ctx, outputTemplateName, nil, responder)
+				Expect(err).ToNot(HaveOccurred())
+
+				invokeHandler(handler, opts)
+				Expect(responder.err).To(MatchError(k8serrors.IsBadRequest, "k8serrors.IsBadRequest"))
+			},
+				Entry("with an unknown output kind", &subresourcesv1beta1.ProcessOptions{OutputKind: "Deployment"}),
+				Entry("with replicas for a VM", &subresourcesv1beta1.ProcessOptions{Replicas: ptr.To[int32](2)}),
+				Entry("with negative replicas", &subresourcesv1beta1.ProcessOptions{
+					OutputKind: subresourcesv1beta1.OutputKindVirtualMachinePool,
+					Replicas:   ptr.To[int32](-1),
+				}),
+				Entry("with a count", &subresourcesv1beta1.ProcessOptions{
+					OutputKind: subresourcesv1beta1.OutputKindVirtualMachinePool,
+					Count:      2,
+				}),
+			)
+		})
+
+		Context("with overrides", func() {
+			const overridesTemplateName = "overrides-template"
+
+			createTemplate := func(patchablePaths ...string) {
+				tpl := newVirtualMachineTemplate()
+				tpl.Name = overridesTemplateName
+				tpl.Spec.VirtualMachine.Raw = []byte(`{"apiVersion":"kubevirt.io/v1","kind":"VirtualMachine",` +
+					`"metadata":{"name":"${NAME}","labels":{"app":"web"}},` +
+					`"spec":{"runStrategy":"Always","template":{"spec":{"domain":{"devices":{},` +
+					`"resources":{"requests":{"memory":"1Gi"}}}}}}}`)
+				tpl.Spec.PatchablePaths = patchablePaths
+				_, err := fakeClient.TemplateV1beta1().VirtualMachineTemplates(testNamespace).Create(
+					context.Background(), tpl, metav1.CreateOptions{},
+				)
+				ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			}
+
+			process := func(overrides *subresourcesv1beta1.VirtualMachineOverrides) {
+				handler, err := processREST.Connect(ctx, overridesTemplateName, nil, responder)
+				ExpectWithOffset(1, err).ToNot(HaveOccurred())
+				invokeHandler(handler, &subresourcesv1beta1.ProcessOptions{Overrides: overrides})
+			}
+
+			processed := func() *subresourcesv1beta1.ProcessedVirtualMachineTemplate {
+				ExpectWithOffset(1, responder.err).ToNot(HaveOccurred())
+				processed, ok := responder.obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplate)
+				ExpectWithOffset(1, ok).To(BeTrue())
+				return processed
+			}
+
+			It("should add labels and annotations and override the runStrategy", func() {
+				createTemplate()
+				process(&subresourcesv1beta1.VirtualMachineOverrides{
+					Labels:      map[string]string{"team": "blue"},
+					Annotations: map[string]string{"owner": "alice"},
+					RunStrategy: ptr.To(virtv1.RunStrategyHalted),
+				})
+				vm := processed().VirtualMachine
+				Expect(vm.Labels).To(HaveKeyWithValue("app", "web"))
+				Expect(vm.Labels).To(HaveKeyWithValue("team", "blue"))
+				Expect(vm.Labels).To(HaveKeyWithValue(v1beta1.LabelTemplateUID, testTemplateUID))
+				Expect(vm.Annotations).To(HaveKeyWithValue("owner", "alice"))
+				Expect(vm.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateOverrides,
+					`{"labels":{"team":"blue"},"annotations":{"owner":"alice"},"runStrategy":"Halted"}`))
+				Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyHalted)))
+			})
+
+			DescribeTable("should apply a patch", func(patch *subresourcesv1beta1.VirtualMachinePatch) {
+				createTemplate()
+				process(&subresourcesv1beta1.VirtualMachineOverrides{Patch: patch})
+				vm := processed().VirtualMachine
+				Expect(vm.Spec.Template.Spec.Domain.Resources.Requests.Memory().String()).To(Equal("2Gi"))
+			},
+				Entry("with a JSON patch", &subresourcesv1beta1.VirtualMachinePatch{
+					Type: subresourcesv1beta1.PatchTypeJSON,
+					Data: `[{"op":"replace","path":"/spec/template/spec/domain/resources/requests/memory","value":"2Gi"}]`,
+				}),
+				Entry("with a strategic merge patch", &subresourcesv1beta1.VirtualMachinePatch{
+					Type: subresourcesv1beta1.PatchTypeStrategicMerge,
+					Data: `{"spec":{"template":{"spec":{"domain":{"resources":{"requests":{"memory":"2Gi"}}}}}}}`,
+				}),
+			)
+
+			It("should allow overrides within the patchable paths of the template", func() {
+				createTemplate("/metadata/labels", "/spec/template/spec/domain/resources/")
+				process(&subresourcesv1beta1.VirtualMachineOverrides{
+					Labels: map[string]string{"team": "blue"},
+					Patch: &subresourcesv1beta1.VirtualMachinePatch{
+						Type: subresourcesv1beta1.PatchTypeStrategicMerge,
+						Data: `{"spec":{"template":{"spec":{"domain":{"resources":{"requests":{"memory":"2Gi"}}}}}}}`,
+					},
+				})
+				vm := processed().VirtualMachine
+				Expect(vm.Labels).To(HaveKeyWithValue("team", "blue"))
+				Expect(vm.Spec.Template.Spec.Domain.Resources.Requests.Memory().String()).To(Equal("2Gi"))
+			})
+
+			DescribeTable("should reject invalid overrides", func(overrides *subresourcesv1beta1.VirtualMachineOverrides) {
+				createTemplate("/metadata/labels")
+				process(overrides)
+				Expect(responder.err).To(MatchError(k8serrors.IsBadRequest, "k8serrors.IsBadRequest"))
+			},
+				Entry("outside of the patchable paths", &subresourcesv1beta1.VirtualMachineOverrides{
+					RunStrategy: ptr.To(virtv1.RunStrategyHalted),
+				}),
+				Entry("changing the provenance", &subresourcesv1beta1.VirtualMachineOverrides{
+					Labels: map[string]string{v1beta1.LabelTemplateUID: "other-uid"},
+				}),
+				Entry("removing the provenance", &subresourcesv1beta1.VirtualMachineOverrides{
+					Patch: &subresourcesv1beta1.VirtualMachinePatch{
+						Type: subresourcesv1beta1.PatchTypeJSON,
+						Data: `[{"op":"remove","path":"/metadata/labels/template.kubevirt.io~1TemplateUID"}]`,
+					},
+				}),
+				Entry("with an invalid patch", &subresourcesv1beta1.VirtualMachineOverrides{
+					Patch: &subresourcesv1beta1.VirtualMachinePatch{Type: subresourcesv1beta1.PatchTypeJSON, Data: `{}`},
+				}),
+				Entry("with an unknown patch type", &subresourcesv1beta1.VirtualMachineOverrides{
+					Patch: &subresourcesv1beta1.VirtualMachinePatch{Type: "Merge", Data: `{}`},
+				}),
+			)
+		})
 	})
 })
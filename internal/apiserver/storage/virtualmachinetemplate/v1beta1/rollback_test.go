@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	templateclient "kubevirt.io/virt-template-client-go/virttemplate"
+	virttemplatefake "kubevirt.io/virt-template-client-go/virttemplate/fake"
+
+	vmtv1beta1 "kubevirt.io/virt-template/internal/apiserver/storage/virtualmachinetemplate/v1beta1"
+)
+
+var _ = Describe("RollbackREST", func() {
+	var (
+		rollbackREST     *vmtv1beta1.V1beta1RollbackREST
+		fakeClient       *virttemplatefake.Clientset
+		impersonatedUser user.Info
+	)
+
+	BeforeEach(func() {
+		fakeClient = virttemplatefake.NewSimpleClientset(newVirtualMachineTemplate())
+		fakeVirtClient := &fakeKubevirtClient{
+			kubeClient: k8sfake.NewSimpleClientset(newTemplateRevision(1, "revision-vm")),
+		}
+		rollbackREST = vmtv1beta1.NewV1beta1RollbackREST(fakeVirtClient, func(u user.Info) (templateclient.Interface, error) {
+			impersonatedUser = u
+			return fakeClient, nil
+		})
+	})
+
+	It("New should return a RolledBackVirtualMachineTemplate object", func() {
+		_, ok := rollbackREST.New().(*subresourcesv1beta1.RolledBackVirtualMachineTemplate)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("ConnectMethods should return POST method only", func() {
+		Expect(rollbackREST.ConnectMethods()).To(ConsistOf(http.MethodPost))
+	})
+
+	Context("Connect", func() {
+		var (
+			ctx       context.Context
+			responder *fakeResponder
+		)
+
+		invokeRollback := func(revision int64) {
+			handler, err := rollbackREST.Connect(ctx, testTemplateName, nil, responder)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			invokeHandler(handler, &subresourcesv1beta1.RollbackOptions{Revision: revision})
+		}
+
+		BeforeEach(func() {
+			ctx = request.WithNamespace(context.Background(), testNamespace)
+			ctx = request.WithUser(ctx, &user.DefaultInfo{Name: testUser})
+			responder = &fakeResponder{}
+		})
+
+		It("should return error when namespace is missing from context", func() {
+			handler, err := rollbackREST.Connect(context.Background(), testTemplateName, nil, nil)
+			Expect(err).To(MatchError("missing namespace"))
+			Expect(handler).To(BeNil())
+		})
+
+		It("should roll back the template to the selected revision", func() {
+			invokeRollback(1)
+			Expect(responder.err).ToNot(HaveOccurred())
+			Expect(responder.statusCode).To(Equal(http.StatusOK))
+
+			rolledBack, ok := responder.obj.(*subresourcesv1beta1.RolledBackVirtualMachineTemplate)
+			Expect(ok).To(BeTrue())
+			Expect(rolledBack.TemplateRef.Namespace).To(Equal(testNamespace))
+			Expect(rolledBack.TemplateRef.Name).To(Equal(testTemplateName))
+			Expect(rolledBack.Revision).To(Equal(int64(1)))
+
+			tpl, err := fakeClient.TemplateV1beta1().VirtualMachineTemplates(testNamespace).Get(ctx, testTemplateName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tpl.Spec.Parameters[0].Value).To(Equal("revision-vm"))
+		})
+
+		It("should read and update the template as the user of the request", func() {
+			invokeRollback(1)
+			Expect(responder.err).ToNot(HaveOccurred())
+			Expect(impersonatedUser.GetName()).To(Equal(testUser))
+		})
+
+		It("should return error when no revision is selected", func() {
+			invokeRollback(0)
+			Expect(responder.err).To(MatchError("revision must be a positive number"))
+		})
+
+		It("should return error when the selected revision does not exist", func() {
+			invokeRollback(2)
+			Expect(responder.err).To(MatchError(ContainSubstring("revision 2 of VirtualMachineTemplate does not exist")))
+		})
+
+		It("should return error when template is not found", func() {
+			handler, err := rollbackREST.Connect(ctx, "nonexistent", nil, responder)
+			Expect(err).ToNot(HaveOccurred())
+
+			invokeHandler(handler, &subresourcesv1beta1.RollbackOptions{Revision: 1})
+			Expect(responder.err).To(MatchError(apierrors.IsNotFound, "apierrors.IsNotFound"))
+		})
+	})
+})
@@ -0,0 +1,255 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+	virttemplatefake "kubevirt.io/virt-template-client-go/virttemplate/fake"
+
+	vmtv1beta1 "kubevirt.io/virt-template/internal/apiserver/storage/virtualmachinetemplate/v1beta1"
+)
+
+var _ = Describe("DiffREST and UpgradeREST", func() {
+	const testResourceVersion = "42"
+
+	var (
+		fakeClient     *virttemplatefake.Clientset
+		diffREST       *vmtv1beta1.V1beta1DiffREST
+		upgradeREST    *vmtv1beta1.V1beta1UpgradeREST
+		fakeVirtClient *fakeKubevirtClient
+		ctx            context.Context
+		responder      *fakeResponder
+	)
+
+	BeforeEach(func() {
+		tpl := newVirtualMachineTemplate()
+		tpl.Generation = 2
+
+		fakeClient = virttemplatefake.NewSimpleClientset(tpl)
+		fakeVirtClient = &fakeKubevirtClient{
+			kubeClient: k8sfake.NewSimpleClientset(newTemplateRevision(1, "revision-vm")),
+			vm: &virtv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            testVMName,
+					Namespace:       testNamespace,
+					ResourceVersion: testResourceVersion,
+					Labels: map[string]string{
+						v1beta1.LabelTemplateUID: testTemplateUID,
+					},
+					Annotations: map[string]string{
+						v1beta1.AnnotationTemplateGeneration: "1",
+						v1beta1.AnnotationTemplateParameters: `{"NAME":"` + testVMName + `"}`,
+					},
+				},
+				Spec: virtv1.VirtualMachineSpec{
+					RunStrategy: ptr.To(virtv1.RunStrategyAlways),
+					Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"outdated": "true"},
+						},
+					},
+				},
+			},
+		}
+
+		diffREST = vmtv1beta1.NewV1beta1DiffREST(fakeClient, fakeVirtClient, fakeVirtClient.clientForUser)
+		upgradeREST = vmtv1beta1.NewV1beta1UpgradeREST(fakeClient, fakeVirtClient, fakeVirtClient.clientForUser)
+
+		ctx = request.WithUser(request.WithNamespace(context.Background(), testNamespace), &user.DefaultInfo{Name: testUser})
+		responder = &fakeResponder{}
+	})
+
+	invoke := func(connecter rest.Connecter, opts *subresourcesv1beta1.UpgradeOptions) *subresourcesv1beta1.VirtualMachineUpgrade {
+		handler, err := connecter.Connect(ctx, testTemplateName, nil, responder)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		invokeHandler(handler, opts)
+		if responder.err != nil {
+			return nil
+		}
+		ExpectWithOffset(1, responder.statusCode).To(Equal(http.StatusOK))
+		upgrade, ok := responder.obj.(*subresourcesv1beta1.VirtualMachineUpgrade)
+		ExpectWithOffset(1, ok).To(BeTrue())
+		return upgrade
+	}
+
+	recordOverrides := func(overrides *subresourcesv1beta1.VirtualMachineOverrides) {
+		data, err := json.Marshal(overrides)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		fakeVirtClient.vm.Annotations[v1beta1.AnnotationTemplateOverrides] = string(data)
+	}
+
+	It("should return the changes between the VirtualMachine and the template", func() {
+		upgrade := invoke(diffREST, &subresourcesv1beta1.UpgradeOptions{VirtualMachineName: testVMName})
+		Expect(responder.err).ToNot(HaveOccurred())
+		Expect(upgrade.TemplateRef.Name).To(Equal(testTemplateName))
+		Expect(upgrade.UpToDate).To(BeFalse())
+		Expect(upgrade.Changes).To(ConsistOf(subresourcesv1beta1.VirtualMachineSpecChange{
+			Operation: "replace",
+			Path:      "/template",
+			Value:     &runtime.RawExtension{Raw: []byte("null")},
+		}))
+		Expect(upgrade.VirtualMachine.Name).To(Equal(testVMName))
+		Expect(upgrade.VirtualMachine.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyAlways)))
+		Expect(fakeVirtClient.updatedVM).To(BeNil())
+		Expect(fakeVirtClient.impersonatedUser.GetName()).To(Equal(testUser))
+	})
+
+	It("should render the selected revision with the recorded parameter values", func() {
+		upgrade := invoke(diffREST, &subresourcesv1beta1.UpgradeOptions{
+			VirtualMachineName: testVMName,
+			Revision:           1,
+		})
+		Expect(responder.err).ToNot(HaveOccurred())
+		Expect(upgrade.Revision).To(Equal(int64(1)))
+		Expect(upgrade.VirtualMachine.Name).To(Equal(testVMName))
+		Expect(upgrade.VirtualMachine.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateRevision, "1"))
+	})
+
+	It("should report an up to date VirtualMachine", func() {
+		fakeVirtClient.vm.Spec.Template = nil
+
+		upgrade := invoke(diffREST, &subresourcesv1beta1.UpgradeOptions{VirtualMachineName: testVMName})
+		Expect(responder.err).ToNot(HaveOccurred())
+		Expect(upgrade.UpToDate).To(BeTrue())
+		Expect(upgrade.Changes).To(BeEmpty())
+	})
+
+	It("should reapply the overrides recorded on the VirtualMachine", func() {
+		recordOverrides(&subresourcesv1beta1.VirtualMachineOverrides{
+			Patch: &subresourcesv1beta1.VirtualMachinePatch{
+				Type: subresourcesv1beta1.PatchTypeStrategicMerge,
+				Data: `{"spec":{"template":{"metadata":{"labels":{"outdated":"true"}}}}}`,
+			},
+		})
+
+		upgrade := invoke(diffREST, &subresourcesv1beta1.UpgradeOptions{VirtualMachineName: testVMName})
+		Expect(responder.err).ToNot(HaveOccurred())
+		Expect(upgrade.UpToDate).To(BeTrue())
+		Expect(upgrade.Changes).To(BeEmpty())
+		Expect(upgrade.VirtualMachine.Annotations).To(HaveKeyWithValue(
+			v1beta1.AnnotationTemplateOverrides, fakeVirtClient.vm.Annotations[v1beta1.AnnotationTemplateOverrides]))
+	})
+
+	It("should fail if the recorded overrides no longer apply", func() {
+		recordOverrides(&subresourcesv1beta1.VirtualMachineOverrides{
+			Patch: &subresourcesv1beta1.VirtualMachinePatch{
+				Type: subresourcesv1beta1.PatchTypeJSON,
+				Data: `[{"op":"remove","path":"/spec/dataVolumeTemplates"}]`,
+			},
+		})
+
+		invoke(diffREST, &subresourcesv1beta1.UpgradeOptions{VirtualMachineName: testVMName})
+		Expect(responder.err).To(MatchError(apierrors.IsBadRequest, "apierrors.IsBadRequest"))
+		Expect(responder.err).To(MatchError(ContainSubstring("no longer apply")))
+	})
+
+	It("should upgrade the VirtualMachine", func() {
+		upgrade := invoke(upgradeREST, &subresourcesv1beta1.UpgradeOptions{
+			VirtualMachineName: testVMName,
+			ResourceVersion:    testResourceVersion,
+		})
+		Expect(responder.err).ToNot(HaveOccurred())
+		Expect(upgrade.UpToDate).To(BeTrue())
+		Expect(upgrade.Changes).To(HaveLen(1))
+
+		Expect(fakeVirtClient.updatedVM).ToNot(BeNil())
+		Expect(fakeVirtClient.updatedVM.ResourceVersion).To(Equal(testResourceVersion))
+		Expect(fakeVirtClient.updatedVM.Spec.Template).To(BeNil())
+		Expect(fakeVirtClient.updatedVM.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyAlways)))
+		Expect(fakeVirtClient.updatedVM.Annotations).To(HaveKeyWithValue(v1beta1.AnnotationTemplateGeneration, "2"))
+		Expect(upgrade.VirtualMachine).To(Equal(fakeVirtClient.updatedVM))
+		Expect(fakeVirtClient.impersonatedUser.GetName()).To(Equal(testUser))
+	})
+
+	It("should reject upgrading when the user may not use the template", func() {
+		fakeVirtClient.useDenied = true
+
+		invoke(upgradeREST, &subresourcesv1beta1.UpgradeOptions{VirtualMachineName: testVMName})
+		Expect(responder.err).To(MatchError(apierrors.IsForbidden, "apierrors.IsForbidden"))
+		Expect(fakeVirtClient.updatedVM).To(BeNil())
+	})
+
+	It("should fail to upgrade a modified VirtualMachine", func() {
+		invoke(upgradeREST, &subresourcesv1beta1.UpgradeOptions{
+			VirtualMachineName: testVMName,
+			ResourceVersion:    "1",
+		})
+		Expect(apierrors.IsConflict(responder.err)).To(BeTrue())
+		Expect(fakeVirtClient.updatedVM).To(BeNil())
+	})
+
+	It("should fail if the VirtualMachine was updated concurrently", func() {
+		fakeVirtClient.updateErr = apierrors.NewConflict(
+			virtv1.SchemeGroupVersion.WithResource("virtualmachines").GroupResource(), testVMName, context.Canceled)
+
+		invoke(upgradeREST, &subresourcesv1beta1.UpgradeOptions{VirtualMachineName: testVMName})
+		Expect(apierrors.IsConflict(responder.err)).To(BeTrue())
+	})
+
+	It("should fail without a VirtualMachine name", func() {
+		invoke(diffREST, &subresourcesv1beta1.UpgradeOptions{})
+		Expect(responder.err).To(MatchError("virtualMachineName must be set"))
+	})
+
+	It("should fail for a VirtualMachine created from another template", func() {
+		fakeVirtClient.vm.Labels[v1beta1.LabelTemplateUID] = "other-uid"
+
+		invoke(diffREST, &subresourcesv1beta1.UpgradeOptions{VirtualMachineName: testVMName})
+		Expect(responder.err).To(MatchError(ContainSubstring("was not created from VirtualMachineTemplate")))
+	})
+
+	It("should fail for a VirtualMachine without recorded parameter values", func() {
+		delete(fakeVirtClient.vm.Annotations, v1beta1.AnnotationTemplateParameters)
+
+		invoke(diffREST, &subresourcesv1beta1.UpgradeOptions{VirtualMachineName: testVMName})
+		Expect(responder.err).To(MatchError(ContainSubstring("does not record the parameter values")))
+	})
+
+	It("should fail for a nonexistent VirtualMachine", func() {
+		invoke(diffREST, &subresourcesv1beta1.UpgradeOptions{VirtualMachineName: "nonexistent"})
+		Expect(responder.err).To(MatchError(apierrors.IsNotFound, "apierrors.IsNotFound"))
+	})
+
+	It("should fail for a nonexistent template", func() {
+		Expect(fakeClient.TemplateV1beta1().VirtualMachineTemplates(testNamespace).Delete(
+			context.Background(), testTemplateName, metav1.DeleteOptions{})).To(Succeed())
+
+		invoke(diffREST, &subresourcesv1beta1.UpgradeOptions{VirtualMachineName: testVMName})
+		Expect(responder.err).To(MatchError(apierrors.IsNotFound, "apierrors.IsNotFound"))
+	})
+})
@@ -23,23 +23,38 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/authentication/user"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	appsv1client "k8s.io/client-go/kubernetes/typed/apps/v1"
+	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	k8stesting "k8s.io/client-go/testing"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	poolv1beta1 "kubevirt.io/api/pool/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	kvpoolv1beta1 "kubevirt.io/client-go/kubevirt/typed/pool/v1beta1"
 
 	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
 	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
 )
 
 func TestVirtualMachineTemplateStorage(t *testing.T) {
@@ -50,8 +65,10 @@ func TestVirtualMachineTemplateStorage(t *testing.T) {
 const (
 	testNamespace    = "test-namespace"
 	testTemplateName = "test-template"
+	testUser         = "test-user"
 	testVMName       = "test-vm"
 	testParamName    = "NAME"
+	testTemplateUID  = "test-template-uid"
 	vmJSON           = `{"apiVersion":"kubevirt.io/v1","kind":"VirtualMachine","metadata":{"name":"${NAME}"}}`
 )
 
@@ -72,38 +89,198 @@ func (f *fakeResponder) Error(err error) {
 
 type fakeKubevirtClient struct {
 	kubecli.KubevirtClient
-	createErr error
-	createdVM *virtv1.VirtualMachine
+	kubeClient       *k8sfake.Clientset
+	createErr        error
+	createErrs       map[string]error
+	createMu         sync.Mutex
+	createdVM        *virtv1.VirtualMachine
+	createdVMs       []*virtv1.VirtualMachine
+	createOptions    metav1.CreateOptions
+	createdPool      *poolv1beta1.VirtualMachinePool
+	createdRS        *virtv1.VirtualMachineInstanceReplicaSet
+	patchErr         error
+	patchType        types.PatchType
+	patchOptions     metav1.PatchOptions
+	appliedVM        *virtv1.VirtualMachine
+	listErr          error
+	listAllDenied    bool
+	listedVMs        []virtv1.VirtualMachine
+	impersonatedUser user.Info
+	vm               *virtv1.VirtualMachine
+	updateErr        error
+	updatedVM        *virtv1.VirtualMachine
+	useDenied        bool
+	accessReviews    []*authorizationv1.SubjectAccessReview
+}
+
+func (f *fakeKubevirtClient) clientForUser(u user.Info) (kubecli.KubevirtClient, error) {
+	f.impersonatedUser = u
+	return f, nil
+}
+
+func (f *fakeKubevirtClient) AuthorizationV1() authorizationv1client.AuthorizationV1Interface {
+	c := k8sfake.NewSimpleClientset()
+	c.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		sar := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		f.accessReviews = append(f.accessReviews, sar)
+		sar.Status.Allowed = !f.useDenied
+		return true, sar, nil
+	})
+	return c.AuthorizationV1()
 }
 
-func (f *fakeKubevirtClient) VirtualMachine(_ string) kubecli.VirtualMachineInterface {
+func (f *fakeKubevirtClient) VirtualMachine(namespace string) kubecli.VirtualMachineInterface {
 	return &fakeVirtualMachineInterface{
-		createErr: f.createErr,
-		createdVM: &f.createdVM,
+		namespace:  namespace,
+		createErr:  f.createErr,
+		createErrs: f.createErrs,
+		createMu:   &f.createMu,
+		createdVM:  &f.createdVM,
+		createdVMs: &f.createdVMs,
+		createOpt:  &f.createOptions,
+		patchErr:   f.patchErr,
+		patchType:  &f.patchType,
+		patchOpt:   &f.patchOptions,
+		appliedVM:  &f.appliedVM,
+		listErr:    f.listErr,
+		listDenied: f.listAllDenied && namespace == metav1.NamespaceAll,
+		listedVMs:  f.listedVMs,
+		vm:         f.vm,
+		updateErr:  f.updateErr,
+		updatedVM:  &f.updatedVM,
 	}
 }
 
+func (f *fakeKubevirtClient) VirtualMachinePool(_ string) kvpoolv1beta1.VirtualMachinePoolInterface {
+	return &fakeVirtualMachinePoolInterface{created: &f.createdPool}
+}
+
+func (f *fakeKubevirtClient) ReplicaSet(_ string) kubecli.ReplicaSetInterface {
+	return &fakeReplicaSetInterface{created: &f.createdRS}
+}
+
+func (f *fakeKubevirtClient) AppsV1() appsv1client.AppsV1Interface {
+	return f.kubeClient.AppsV1()
+}
+
 type fakeVirtualMachineInterface struct {
 	kvcorev1.VirtualMachineInterface
-	createErr error
-	createdVM **virtv1.VirtualMachine
+	namespace  string
+	createErr  error
+	createErrs map[string]error
+	createMu   *sync.Mutex
+	createdVM  **virtv1.VirtualMachine
+	createdVMs *[]*virtv1.VirtualMachine
+	createOpt  *metav1.CreateOptions
+	patchErr   error
+	patchType  *types.PatchType
+	patchOpt   *metav1.PatchOptions
+	appliedVM  **virtv1.VirtualMachine
+	listErr    error
+	listDenied bool
+	listedVMs  []virtv1.VirtualMachine
+	vm         *virtv1.VirtualMachine
+	updateErr  error
+	updatedVM  **virtv1.VirtualMachine
+}
+
+func (f *fakeVirtualMachineInterface) Get(_ context.Context, name string, _ metav1.GetOptions) (*virtv1.VirtualMachine, error) {
+	if f.vm == nil || f.vm.Name != name {
+		return nil, apierrors.NewNotFound(virtv1.SchemeGroupVersion.WithResource("virtualmachines").GroupResource(), name)
+	}
+	return f.vm.DeepCopy(), nil
+}
+
+func (f *fakeVirtualMachineInterface) Update(
+	_ context.Context, vm *virtv1.VirtualMachine, _ metav1.UpdateOptions,
+) (*virtv1.VirtualMachine, error) {
+	if f.updateErr != nil {
+		return nil, f.updateErr
+	}
+	*f.updatedVM = vm
+	return vm, nil
+}
+
+func (f *fakeVirtualMachineInterface) List(_ context.Context, _ metav1.ListOptions) (*virtv1.VirtualMachineList, error) {
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	if f.listDenied {
+		return nil, apierrors.NewForbidden(virtv1.SchemeGroupVersion.WithResource("virtualmachines").GroupResource(), "",
+			errors.New("cannot list VirtualMachines at the cluster scope"))
+	}
+	vms := &virtv1.VirtualMachineList{}
+	for _, vm := range f.listedVMs {
+		if f.namespace == metav1.NamespaceAll || vm.Namespace == f.namespace {
+			vms.Items = append(vms.Items, vm)
+		}
+	}
+	return vms, nil
 }
 
 func (f *fakeVirtualMachineInterface) Create(
-	_ context.Context, vm *virtv1.VirtualMachine, _ metav1.CreateOptions,
+	_ context.Context, vm *virtv1.VirtualMachine, opts metav1.CreateOptions,
 ) (*virtv1.VirtualMachine, error) {
 	if f.createErr != nil {
 		return nil, f.createErr
 	}
+	if err := f.createErrs[vm.Name]; err != nil {
+		return nil, err
+	}
+	f.createMu.Lock()
+	defer f.createMu.Unlock()
 	*f.createdVM = vm
+	*f.createdVMs = append(*f.createdVMs, vm)
+	*f.createOpt = opts
 	return vm, nil
 }
 
+func (f *fakeVirtualMachineInterface) Patch(
+	_ context.Context, _ string, pt types.PatchType, data []byte, opts metav1.PatchOptions, _ ...string,
+) (*virtv1.VirtualMachine, error) {
+	if f.patchErr != nil {
+		return nil, f.patchErr
+	}
+	vm := &virtv1.VirtualMachine{}
+	if err := json.Unmarshal(data, vm); err != nil {
+		return nil, err
+	}
+	*f.patchType = pt
+	*f.patchOpt = opts
+	*f.appliedVM = vm
+	return vm, nil
+}
+
+type fakeVirtualMachinePoolInterface struct {
+	kvpoolv1beta1.VirtualMachinePoolInterface
+	created **poolv1beta1.VirtualMachinePool
+}
+
+func (f *fakeVirtualMachinePoolInterface) Create(
+	_ context.Context, pool *poolv1beta1.VirtualMachinePool, _ metav1.CreateOptions,
+) (*poolv1beta1.VirtualMachinePool, error) {
+	*f.created = pool
+	return pool, nil
+}
+
+type fakeReplicaSetInterface struct {
+	kubecli.ReplicaSetInterface
+	created **virtv1.VirtualMachineInstanceReplicaSet
+}
+
+func (f *fakeReplicaSetInterface) Create(
+	_ context.Context, rs *virtv1.VirtualMachineInstanceReplicaSet, _ metav1.CreateOptions,
+) (*virtv1.VirtualMachineInstanceReplicaSet, error) {
+	*f.created = rs
+	return rs, nil
+}
+
 func newVirtualMachineTemplate() *v1beta1.VirtualMachineTemplate {
 	return &v1beta1.VirtualMachineTemplate{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      testTemplateName,
 			Namespace: testNamespace,
+			UID:       testTemplateUID,
 		},
 		Spec: v1beta1.VirtualMachineTemplateSpec{
 			VirtualMachine: &runtime.RawExtension{Raw: []byte(vmJSON)},
@@ -114,7 +291,53 @@ func newVirtualMachineTemplate() *v1beta1.VirtualMachineTemplate {
 	}
 }
 
-func invokeHandler(handler http.Handler, opts *subresourcesv1beta1.ProcessOptions) {
+func newClusterVirtualMachineTemplate() *v1beta1.ClusterVirtualMachineTemplate {
+	tpl := newVirtualMachineTemplate()
+	return &v1beta1.ClusterVirtualMachineTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: tpl.Name,
+			UID:  tpl.UID,
+		},
+		Spec: tpl.Spec,
+	}
+}
+
+func newVirtualMachineTemplateGrant(
+	from v1beta1.VirtualMachineTemplateGrantFrom, to ...v1beta1.VirtualMachineTemplateGrantTo,
+) *v1beta1.VirtualMachineTemplateGrant {
+	return &v1beta1.VirtualMachineTemplateGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-grant",
+			Namespace: testNamespace,
+		},
+		Spec: v1beta1.VirtualMachineTemplateGrantSpec{
+			From: []v1beta1.VirtualMachineTemplateGrantFrom{from},
+			To:   to,
+		},
+	}
+}
+
+// grantedTo returns a VirtualMachineTemplateGrantFrom allowing the test user to process templates
+// into the namespace.
+func grantedTo(ns string) v1beta1.VirtualMachineTemplateGrantFrom {
+	return v1beta1.VirtualMachineTemplateGrantFrom{
+		Namespace: ns,
+		Subjects: []v1beta1.VirtualMachineTemplateGrantSubject{{
+			Kind: v1beta1.GrantSubjectUser, Name: testUser,
+		}},
+	}
+}
+
+func newTemplateRevision(revision int64, vmName string) *appsv1.ControllerRevision {
+	tpl := newVirtualMachineTemplate()
+	tpl.Generation = revision
+	tpl.Spec.Parameters[0].Value = vmName
+	rev, err := apimachinery.NewTemplateRevision(tpl, revision)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	return rev
+}
+
+func invokeHandler(handler http.Handler, opts runtime.Object) {
 	if opts == nil {
 		opts = &subresourcesv1beta1.ProcessOptions{}
 	}
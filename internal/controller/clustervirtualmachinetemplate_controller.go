@@ -0,0 +1,113 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	templateapi "kubevirt.io/virt-template-api/core"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+)
+
+// ClusterVirtualMachineTemplateReconciler reconciles a ClusterVirtualMachineTemplate object
+type ClusterVirtualMachineTemplateReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// KubeVirtAvailable enables tracking the VirtualMachines processed from templates.
+	// It must only be set if the kubevirt.io/v1 API is available.
+	KubeVirtAvailable bool
+}
+
+// +kubebuilder:rbac:groups=template.kubevirt.io,resources=clustervirtualmachinetemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=template.kubevirt.io,resources=clustervirtualmachinetemplates/status,verbs=get;patch
+// +kubebuilder:rbac:groups=template.kubevirt.io,resources=clustervirtualmachinetemplates/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kubevirt.io,resources=virtualmachines,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.21.0/pkg/reconcile
+func (r *ClusterVirtualMachineTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	clusterTpl := &v1beta1.ClusterVirtualMachineTemplate{}
+	if err := r.Get(ctx, req.NamespacedName, clusterTpl); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	helper, err := patch.NewHelper(clusterTpl, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if r.KubeVirtAvailable {
+		if err := syncTemplateInstances(ctx, r.Client, clusterTpl.Namespace, clusterTpl.UID, clusterTpl.Generation, &clusterTpl.Status); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	meta.SetStatusCondition(&clusterTpl.Status.Conditions, metav1.Condition{
+		Type:               v1beta1.ConditionReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: clusterTpl.Generation,
+		Reason:             v1beta1.ReasonReconciled,
+		Message:            "ClusterVirtualMachineTemplate is ready to be processed",
+	})
+
+	return ctrl.Result{}, helper.Patch(ctx, clusterTpl)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterVirtualMachineTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta1.ClusterVirtualMachineTemplate{}).
+		Named(templateapi.SingularClusterResourceName)
+	if r.KubeVirtAvailable {
+		b = b.Watches(&virtv1.VirtualMachine{}, handler.EnqueueRequestsFromMapFunc(EnqueueClusterTemplateByAnnotations))
+	}
+	return b.Complete(r)
+}
+
+// EnqueueClusterTemplateByAnnotations enqueues the ClusterVirtualMachineTemplate a VirtualMachine was processed from.
+func EnqueueClusterTemplateByAnnotations(_ context.Context, obj client.Object) []reconcile.Request {
+	if !apimachinery.IsClusterTemplateInstance(obj) {
+		return nil
+	}
+
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{
+			Name: obj.GetAnnotations()[v1beta1.AnnotationTemplateName],
+		},
+	}}
+}
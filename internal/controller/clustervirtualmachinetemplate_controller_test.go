@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controller_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	virtv1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/controller"
+)
+
+var _ = Describe("ClusterVirtualMachineTemplate Controller", func() {
+	var (
+		reconciler *controller.ClusterVirtualMachineTemplateReconciler
+		clusterTpl *v1beta1.ClusterVirtualMachineTemplate
+	)
+
+	BeforeEach(func() {
+		reconciler = &controller.ClusterVirtualMachineTemplateReconciler{
+			Client:            k8sClient,
+			Scheme:            k8sClient.Scheme(),
+			KubeVirtAvailable: true,
+		}
+	})
+
+	AfterEach(func() {
+		if clusterTpl != nil {
+			Expect(k8sClient.Delete(context.Background(), clusterTpl)).To(Or(Succeed(), MatchError(k8serrors.IsNotFound, "k8serrors.IsNotFound")))
+		}
+	})
+
+	It("should set the Ready condition", func() {
+		By("Creating a new ClusterVirtualMachineTemplate")
+		clusterTpl = &v1beta1.ClusterVirtualMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-cluster-template",
+			},
+			Spec: v1beta1.VirtualMachineTemplateSpec{
+				VirtualMachine: &runtime.RawExtension{
+					Object: &virtv1.VirtualMachine{},
+				},
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), clusterTpl)).To(Succeed())
+
+		By("Reconciling the created ClusterVirtualMachineTemplate")
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(clusterTpl),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(clusterTpl), clusterTpl)).To(Succeed())
+		Expect(clusterTpl.Status.Conditions).To(HaveLen(1))
+		Expect(clusterTpl.Status.Conditions[0].Type).To(Equal(v1beta1.ConditionReady))
+		Expect(clusterTpl.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+		Expect(clusterTpl.Status.Conditions[0].Reason).To(Equal(v1beta1.ReasonReconciled))
+		Expect(clusterTpl.Status.Conditions[0].Message).To(Equal("ClusterVirtualMachineTemplate is ready to be processed"))
+		Expect(clusterTpl.Status.Instances).To(BeZero())
+	})
+
+	It("should only enqueue cluster templates for VirtualMachines processed from them", func() {
+		vm := &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vm",
+				Namespace: metav1.NamespaceDefault,
+				Annotations: map[string]string{
+					v1beta1.AnnotationTemplateName: "test-cluster-template",
+				},
+			},
+		}
+		Expect(controller.EnqueueClusterTemplateByAnnotations(context.Background(), vm)).To(ConsistOf(
+			reconcile.Request{NamespacedName: client.ObjectKey{Name: "test-cluster-template"}},
+		))
+
+		vm.Annotations[v1beta1.AnnotationTemplateNamespace] = metav1.NamespaceDefault
+		Expect(controller.EnqueueClusterTemplateByAnnotations(context.Background(), vm)).To(BeEmpty())
+	})
+})
@@ -20,28 +20,54 @@
 package controller
 
 import (
+	"cmp"
 	"context"
+	"slices"
 
+	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api/util/patch"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	virtv1 "kubevirt.io/api/core/v1"
 
 	templateapi "kubevirt.io/virt-template-api/core"
 	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+	"kubevirt.io/virt-template/internal/logs"
+)
+
+const (
+	// maxOutdatedInstances limits the number of outdated instances listed in the template status.
+	maxOutdatedInstances = 100
+	// defaultRevisionHistoryLimit is the number of revisions retained if a template does not specify a limit.
+	defaultRevisionHistoryLimit = 10
 )
 
 // VirtualMachineTemplateReconciler reconciles a VirtualMachineTemplate object
 type VirtualMachineTemplateReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// KubeVirtAvailable enables tracking the VirtualMachines processed from templates.
+	// It must only be set if the kubevirt.io/v1 API is available.
+	KubeVirtAvailable bool
 }
 
 // +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplates,verbs=get;list;watch
 // +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplates/status,verbs=get;patch
 // +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplates/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kubevirt.io,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=controllerrevisions,verbs=get;list;watch;create;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -59,6 +85,18 @@ func (r *VirtualMachineTemplateReconciler) Reconcile(ctx context.Context, req ct
 		return ctrl.Result{}, err
 	}
 
+	if tpl.DeletionTimestamp.IsZero() {
+		if err := r.syncRevisions(ctx, tpl); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if r.KubeVirtAvailable {
+		if err := syncTemplateInstances(ctx, r.Client, tpl.Namespace, tpl.UID, tpl.Generation, &tpl.Status); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	meta.SetStatusCondition(&tpl.Status.Conditions, metav1.Condition{
 		Type:               v1beta1.ConditionReady,
 		Status:             metav1.ConditionTrue,
@@ -70,10 +108,127 @@ func (r *VirtualMachineTemplateReconciler) Reconcile(ctx context.Context, req ct
 	return ctrl.Result{}, helper.Patch(ctx, tpl)
 }
 
+func (r *VirtualMachineTemplateReconciler) syncRevisions(ctx context.Context, tpl *v1beta1.VirtualMachineTemplate) error {
+	log := logf.FromContext(ctx)
+
+	revs := &appsv1.ControllerRevisionList{}
+	if err := r.List(ctx, revs,
+		client.InNamespace(tpl.Namespace),
+		client.MatchingLabels{v1beta1.LabelTemplateUID: string(tpl.UID)},
+	); err != nil {
+		return err
+	}
+
+	slices.SortFunc(revs.Items, func(a, b appsv1.ControllerRevision) int {
+		return cmp.Compare(a.Revision, b.Revision)
+	})
+
+	matches := false
+	if len(revs.Items) > 0 {
+		var err error
+		if matches, err = apimachinery.TemplateRevisionMatches(&revs.Items[len(revs.Items)-1], tpl); err != nil {
+			return err
+		}
+	}
+	if !matches {
+		next := int64(1)
+		if len(revs.Items) > 0 {
+			next = revs.Items[len(revs.Items)-1].Revision + 1
+		}
+		rev, err := apimachinery.NewTemplateRevision(tpl, next)
+		if err != nil {
+			return err
+		}
+		if err := r.Create(ctx, rev); err != nil {
+			return err
+		}
+		log.V(logs.DebugLevel).Info("Created revision of VirtualMachineTemplate", "revision", rev.Revision)
+		revs.Items = append(revs.Items, *rev)
+	}
+	tpl.Status.Revision = revs.Items[len(revs.Items)-1].Revision
+
+	// The current revision is always retained
+	limit := max(int(ptr.Deref(tpl.Spec.RevisionHistoryLimit, defaultRevisionHistoryLimit)), 1)
+	for i := 0; i < len(revs.Items)-limit; i++ {
+		if err := r.Delete(ctx, &revs.Items[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		log.V(logs.DebugLevel).Info("Deleted revision of VirtualMachineTemplate", "revision", revs.Items[i].Revision)
+	}
+
+	return nil
+}
+
+// syncTemplateInstances lists the VirtualMachines processed from the template with the given UID
+// and records them in the status of the template. Only VirtualMachines in the given namespace of
+// the template are referenced, so the status does not reveal VirtualMachines of other namespaces.
+func syncTemplateInstances(
+	ctx context.Context, c client.Reader, namespace string, uid types.UID, generation int64,
+	status *v1beta1.VirtualMachineTemplateStatus,
+) error {
+	vms := &virtv1.VirtualMachineList{}
+	if err := c.List(ctx, vms, client.MatchingLabels{v1beta1.LabelTemplateUID: string(uid)}); err != nil {
+		return err
+	}
+
+	slices.SortFunc(vms.Items, func(a, b virtv1.VirtualMachine) int {
+		return cmp.Or(
+			a.CreationTimestamp.Compare(b.CreationTimestamp.Time),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	status.Instances = int32(len(vms.Items)) //nolint:gosec
+	status.LatestInstance = nil
+	status.OutdatedInstances = nil
+	for i := range vms.Items {
+		vm := &vms.Items[i]
+		if vm.Namespace != namespace {
+			continue
+		}
+		if apimachinery.IsOutdatedInstance(vm, generation) && len(status.OutdatedInstances) < maxOutdatedInstances {
+			status.OutdatedInstances = append(status.OutdatedInstances, v1beta1.VirtualMachineReference{
+				Namespace: vm.Namespace,
+				Name:      vm.Name,
+			})
+		}
+		status.LatestInstance = &v1beta1.VirtualMachineReference{
+			Namespace: vm.Namespace,
+			Name:      vm.Name,
+		}
+	}
+
+	logf.FromContext(ctx).V(logs.DebugLevel).Info("Synced instances of template",
+		"instances", status.Instances, "outdated", len(status.OutdatedInstances))
+
+	return nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *VirtualMachineTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1beta1.VirtualMachineTemplate{}).
 		Named(templateapi.SingularResourceName).
-		Complete(r)
+		Owns(&appsv1.ControllerRevision{})
+	if r.KubeVirtAvailable {
+		b = b.Watches(&virtv1.VirtualMachine{}, handler.EnqueueRequestsFromMapFunc(EnqueueTemplateByAnnotations))
+	}
+	return b.Complete(r)
+}
+
+// EnqueueTemplateByAnnotations enqueues the VirtualMachineTemplate a VirtualMachine was processed from.
+func EnqueueTemplateByAnnotations(_ context.Context, obj client.Object) []reconcile.Request {
+	namespace := obj.GetAnnotations()[v1beta1.AnnotationTemplateNamespace]
+	name := obj.GetAnnotations()[v1beta1.AnnotationTemplateName]
+	if namespace == "" || name == "" {
+		return nil
+	}
+
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}}
 }
@@ -21,19 +21,24 @@ package controller_test
 
 import (
 	"context"
+	"strconv"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	appsv1 "k8s.io/api/apps/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	virtv1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"kubevirt.io/virt-template-api/core/v1beta1"
 
+	"kubevirt.io/virt-template/internal/apimachinery"
 	"kubevirt.io/virt-template/internal/controller"
 )
 
@@ -45,8 +50,9 @@ var _ = Describe("VirtualMachineTemplate Controller", func() {
 
 	BeforeEach(func() {
 		reconciler = &controller.VirtualMachineTemplateReconciler{
-			Client: k8sClient,
-			Scheme: k8sClient.Scheme(),
+			Client:            k8sClient,
+			Scheme:            k8sClient.Scheme(),
+			KubeVirtAvailable: true,
 		}
 	})
 
@@ -88,5 +94,175 @@ var _ = Describe("VirtualMachineTemplate Controller", func() {
 		Expect(tpl.Status.Conditions[0].Reason).To(Equal(v1beta1.ReasonReconciled))
 		Expect(tpl.Status.Conditions[0].Message).To(Equal("VirtualMachineTemplate is ready to be processed"))
 		Expect(tpl.Status.Conditions[0].ObservedGeneration).To(Equal(tpl.Generation))
+		Expect(tpl.Status.Instances).To(BeZero())
+		Expect(tpl.Status.LatestInstance).To(BeNil())
+		Expect(tpl.Status.OutdatedInstances).To(BeEmpty())
+	})
+
+	Context("revisions", func() {
+		reconcileTemplate := func() {
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(tpl),
+			})
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			ExpectWithOffset(1, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tpl), tpl)).To(Succeed())
+		}
+
+		listRevisions := func() []int64 {
+			revs := &appsv1.ControllerRevisionList{}
+			ExpectWithOffset(1, k8sClient.List(context.Background(), revs,
+				client.InNamespace(testNamespace),
+				client.MatchingLabels{v1beta1.LabelTemplateUID: string(tpl.UID)},
+			)).To(Succeed())
+
+			var revisions []int64
+			for _, rev := range revs.Items {
+				ExpectWithOffset(1, metav1.IsControlledBy(&rev, tpl)).To(BeTrue())
+				revisions = append(revisions, rev.Revision)
+			}
+			return revisions
+		}
+
+		updateMessage := func(message string) {
+			tpl.Spec.Message = message
+			ExpectWithOffset(1, k8sClient.Update(context.Background(), tpl)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			tpl = &v1beta1.VirtualMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-template",
+					Namespace: testNamespace,
+				},
+				Spec: v1beta1.VirtualMachineTemplateSpec{
+					VirtualMachine: &runtime.RawExtension{
+						Object: &virtv1.VirtualMachine{},
+					},
+					RevisionHistoryLimit: ptr.To[int32](2),
+				},
+			}
+			Expect(k8sClient.Create(context.Background(), tpl)).To(Succeed())
+		})
+
+		It("should record revisions of the template", func() {
+			reconcileTemplate()
+			Expect(tpl.Status.Revision).To(Equal(int64(1)))
+			Expect(listRevisions()).To(ConsistOf(int64(1)))
+
+			By("Not creating a new revision if the template is unchanged")
+			reconcileTemplate()
+			Expect(tpl.Status.Revision).To(Equal(int64(1)))
+			Expect(listRevisions()).To(ConsistOf(int64(1)))
+
+			By("Creating a new revision if the template changed")
+			updateMessage("changed")
+			reconcileTemplate()
+			Expect(tpl.Status.Revision).To(Equal(int64(2)))
+			Expect(listRevisions()).To(ConsistOf(int64(1), int64(2)))
+		})
+
+		It("should prune revisions exceeding the history limit", func() {
+			reconcileTemplate()
+			updateMessage("first")
+			reconcileTemplate()
+			updateMessage("second")
+			reconcileTemplate()
+
+			Expect(tpl.Status.Revision).To(Equal(int64(3)))
+			Expect(listRevisions()).To(ConsistOf(int64(2), int64(3)))
+		})
+
+		It("should always retain the current revision", func() {
+			tpl.Spec.RevisionHistoryLimit = ptr.To[int32](0)
+			Expect(k8sClient.Update(context.Background(), tpl)).To(Succeed())
+
+			reconcileTemplate()
+			updateMessage("changed")
+			reconcileTemplate()
+
+			Expect(tpl.Status.Revision).To(Equal(int64(2)))
+			Expect(listRevisions()).To(ConsistOf(int64(2)))
+		})
+	})
+
+	Context("instances", func() {
+		createVM := func(namespace, name string, generation int64) {
+			vm := &virtv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: virtv1.VirtualMachineSpec{
+					Template: &virtv1.VirtualMachineInstanceTemplateSpec{},
+				},
+			}
+			apimachinery.SetTemplateProvenance(vm, tpl)
+			vm.Annotations[v1beta1.AnnotationTemplateGeneration] = strconv.FormatInt(generation, 10)
+			Expect(k8sClient.Create(context.Background(), vm)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			tpl = &v1beta1.VirtualMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-template",
+					Namespace: testNamespace,
+				},
+				Spec: v1beta1.VirtualMachineTemplateSpec{
+					VirtualMachine: &runtime.RawExtension{
+						Object: &virtv1.VirtualMachine{},
+					},
+				},
+			}
+			Expect(k8sClient.Create(context.Background(), tpl)).To(Succeed())
+		})
+
+		It("should track instances of the template", func() {
+			createVM(testNamespace, "vm-a", tpl.Generation-1)
+			createVM(testNamespace, "vm-b", tpl.Generation)
+			createVM(testVMNamespace, "vm-c", tpl.Generation-1)
+
+			By("Creating a VirtualMachine of another template")
+			otherVM := &virtv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "vm-other",
+					Namespace: testNamespace,
+					Labels: map[string]string{
+						v1beta1.LabelTemplateUID: "other-uid",
+					},
+				},
+				Spec: virtv1.VirtualMachineSpec{
+					Template: &virtv1.VirtualMachineInstanceTemplateSpec{},
+				},
+			}
+			Expect(k8sClient.Create(context.Background(), otherVM)).To(Succeed())
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(tpl),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tpl), tpl)).To(Succeed())
+			Expect(tpl.Status.Instances).To(Equal(int32(3)))
+			Expect(tpl.Status.LatestInstance).To(Equal(&v1beta1.VirtualMachineReference{
+				Namespace: testNamespace,
+				Name:      "vm-b",
+			}))
+			Expect(tpl.Status.OutdatedInstances).To(ConsistOf(v1beta1.VirtualMachineReference{
+				Namespace: testNamespace,
+				Name:      "vm-a",
+			}))
+		})
+
+		It("should enqueue the template of a VirtualMachine", func() {
+			vm := &virtv1.VirtualMachine{}
+			apimachinery.SetTemplateProvenance(vm, tpl)
+			Expect(controller.EnqueueTemplateByAnnotations(ctx, vm)).To(ConsistOf(reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(tpl),
+			}))
+		})
+
+		It("should not enqueue anything for a VirtualMachine without provenance", func() {
+			Expect(controller.EnqueueTemplateByAnnotations(ctx, &virtv1.VirtualMachine{})).To(BeEmpty())
+		})
 	})
 })
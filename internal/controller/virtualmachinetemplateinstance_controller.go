@@ -0,0 +1,484 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	templateapi "kubevirt.io/virt-template-api/core"
+	"kubevirt.io/virt-template-api/core/v1beta1"
+	"kubevirt.io/virt-template-engine/template"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+	"kubevirt.io/virt-template/internal/logs"
+)
+
+const templateRefField = "spec.templateRef.name"
+
+// errInvalidInstance marks errors caused by the configuration of an instance or its template,
+// which cannot be resolved by retrying.
+var errInvalidInstance = errors.New("invalid configuration")
+
+// VirtualMachineTemplateInstanceReconciler reconciles a VirtualMachineTemplateInstance object
+type VirtualMachineTemplateInstanceReconciler struct {
+	client.Client
+	// APIReader reads VirtualMachines which are not cached because they lack the template UID label
+	APIReader client.Reader
+	Scheme    *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplateinstances,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplateinstances/status,verbs=get;patch
+// +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplateinstances/finalizers,verbs=update
+// +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kubevirt.io,resources=virtualmachines,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=apps,resources=controllerrevisions,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.21.0/pkg/reconcile
+func (r *VirtualMachineTemplateInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, retErr error) {
+	log := logf.FromContext(ctx)
+
+	inst := &v1beta1.VirtualMachineTemplateInstance{}
+	if err := r.Get(ctx, req.NamespacedName, inst); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.Error(err, "Unable to fetch VirtualMachineTemplateInstance")
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !inst.DeletionTimestamp.IsZero() {
+		log.Info("Handling deletion of VirtualMachineTemplateInstance")
+		return ctrl.Result{}, r.handleDeletion(ctx, inst)
+	}
+	if err := r.addFinalizer(ctx, inst); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	helper, err := patch.NewHelper(inst, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		switch {
+		case errors.Is(retErr, errInvalidInstance):
+			log.Error(retErr, "Invalid VirtualMachineTemplateInstance")
+			setInstanceCondition(inst, v1beta1.ConditionReady, metav1.ConditionFalse, v1beta1.ReasonInvalidConfiguration, retErr.Error())
+			retErr = nil
+		case retErr != nil:
+			setInstanceCondition(inst, v1beta1.ConditionReady, metav1.ConditionFalse, v1beta1.ReasonFailed, retErr.Error())
+		}
+		retErr = errors.Join(retErr, helper.Patch(ctx, inst))
+	}()
+
+	tpl, err := r.getInstanceTemplate(ctx, inst)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if tpl == nil {
+		setInstanceCondition(inst, v1beta1.ConditionReady, metav1.ConditionFalse, v1beta1.ReasonWaiting,
+			fmt.Sprintf("VirtualMachineTemplate %s does not exist", inst.Spec.TemplateRef.Name))
+		return ctrl.Result{}, nil
+	}
+
+	vm, rendered, msg, err := r.renderVirtualMachine(ctx, inst, tpl)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if vm == nil {
+		if err := r.createVirtualMachine(ctx, inst, rendered); err != nil {
+			return ctrl.Result{}, err
+		}
+		vm = rendered
+	} else if err := r.syncVirtualMachine(ctx, inst, vm, rendered); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	inst.Status.VirtualMachineRef = &v1beta1.VirtualMachineReference{
+		Namespace: vm.Namespace,
+		Name:      vm.Name,
+	}
+	inst.Status.TemplateGeneration = apimachinery.GetTemplateGeneration(vm)
+	inst.Status.Revision = apimachinery.GetTemplateRevision(vm)
+	inst.Status.Message = msg
+	setInstanceCondition(inst, v1beta1.ConditionReady, metav1.ConditionTrue, v1beta1.ReasonReconciled,
+		fmt.Sprintf("VirtualMachine %s exists", vm.Name))
+
+	return ctrl.Result{}, nil
+}
+
+func (r *VirtualMachineTemplateInstanceReconciler) addFinalizer(
+	ctx context.Context, inst *v1beta1.VirtualMachineTemplateInstance,
+) error {
+	if !controllerutil.ContainsFinalizer(inst, v1beta1.FinalizerVirtualMachineCleanup) {
+		logf.FromContext(ctx).V(logs.TraceLevel).Info("Adding finalizer to VirtualMachineTemplateInstance")
+		instCopy := inst.DeepCopy()
+		controllerutil.AddFinalizer(inst, v1beta1.FinalizerVirtualMachineCleanup)
+		if err := r.Patch(ctx, inst, client.MergeFrom(instCopy)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleDeletion applies the deletion policy of an instance. VirtualMachines of instances
+// with the Delete policy are removed by the garbage collector through their owner reference.
+func (r *VirtualMachineTemplateInstanceReconciler) handleDeletion(
+	ctx context.Context, inst *v1beta1.VirtualMachineTemplateInstance,
+) error {
+	if controllerutil.ContainsFinalizer(inst, v1beta1.FinalizerVirtualMachineCleanup) {
+		logf.FromContext(ctx).V(logs.TraceLevel).Info("Finalizing VirtualMachineTemplateInstance")
+		if inst.Spec.DeletionPolicy == v1beta1.InstanceDeletionPolicyOrphan {
+			if err := r.orphanVirtualMachine(ctx, inst); err != nil {
+				return err
+			}
+		}
+		instCopy := inst.DeepCopy()
+		controllerutil.RemoveFinalizer(inst, v1beta1.FinalizerVirtualMachineCleanup)
+		if err := r.Patch(ctx, inst, client.MergeFrom(instCopy)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *VirtualMachineTemplateInstanceReconciler) orphanVirtualMachine(
+	ctx context.Context, inst *v1beta1.VirtualMachineTemplateInstance,
+) error {
+	if inst.Status.VirtualMachineRef == nil {
+		return nil
+	}
+
+	vm := &virtv1.VirtualMachine{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Namespace: inst.Status.VirtualMachineRef.Namespace,
+		Name:      inst.Status.VirtualMachineRef.Name,
+	}, vm); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !metav1.IsControlledBy(vm, inst) {
+		return nil
+	}
+
+	vmCopy := vm.DeepCopy()
+	if err := controllerutil.RemoveControllerReference(inst, vm, r.Scheme); err != nil {
+		return err
+	}
+	logf.FromContext(ctx).V(logs.DebugLevel).Info("Orphaning VirtualMachine", logNS, vm.Namespace, logName, vm.Name)
+
+	return r.Patch(ctx, vm, client.MergeFrom(vmCopy))
+}
+
+// getInstanceTemplate returns the template of an instance as of the selected revision.
+// It returns nil if the template does not exist.
+func (r *VirtualMachineTemplateInstanceReconciler) getInstanceTemplate(
+	ctx context.Context, inst *v1beta1.VirtualMachineTemplateInstance,
+) (*v1beta1.VirtualMachineTemplate, error) {
+	tpl := &v1beta1.VirtualMachineTemplate{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: inst.Namespace, Name: inst.Spec.TemplateRef.Name}, tpl); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	if inst.Spec.Revision == 0 || inst.Spec.Revision == tpl.Status.Revision {
+		return tpl, nil
+	}
+
+	rev := &appsv1.ControllerRevision{}
+	err := r.Get(ctx, types.NamespacedName{
+		Namespace: tpl.Namespace,
+		Name:      apimachinery.TemplateRevisionName(tpl, inst.Spec.Revision),
+	}, rev)
+	if k8serrors.IsNotFound(err) || (err == nil && rev.Labels[v1beta1.LabelTemplateUID] != string(tpl.UID)) {
+		return nil, fmt.Errorf("%w: revision %d of VirtualMachineTemplate %s does not exist",
+			errInvalidInstance, inst.Spec.Revision, tpl.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := apimachinery.ApplyTemplateRevision(tpl, rev); err != nil {
+		return nil, err
+	}
+
+	return tpl, nil
+}
+
+// renderVirtualMachine renders the template of an instance and returns it together with the
+// existing VirtualMachine of the instance. The returned VirtualMachine is nil if it does not exist yet.
+func (r *VirtualMachineTemplateInstanceReconciler) renderVirtualMachine(
+	ctx context.Context, inst *v1beta1.VirtualMachineTemplateInstance, tpl *v1beta1.VirtualMachineTemplate,
+) (vm, rendered *virtv1.VirtualMachine, msg string, err error) {
+	if vm, err = r.getInstanceVirtualMachine(ctx, inst, tpl); err != nil {
+		return nil, nil, "", err
+	}
+	if rendered, msg, err = renderInstance(inst, tpl, vm); err != nil || vm != nil {
+		return vm, rendered, msg, err
+	}
+
+	// The VirtualMachine was not recorded yet, look it up by its rendered name
+	if vm, err = r.getOwnedVirtualMachine(ctx, inst, rendered.Name); err != nil || vm == nil {
+		return nil, rendered, msg, err
+	}
+	rendered, msg, err = renderInstance(inst, tpl, vm)
+
+	return vm, rendered, msg, err
+}
+
+// getInstanceVirtualMachine returns the VirtualMachine recorded in the status of an instance.
+// It returns nil if no VirtualMachine is recorded or if it no longer exists.
+func (r *VirtualMachineTemplateInstanceReconciler) getInstanceVirtualMachine(
+	ctx context.Context, inst *v1beta1.VirtualMachineTemplateInstance, tpl *v1beta1.VirtualMachineTemplate,
+) (*virtv1.VirtualMachine, error) {
+	if inst.Status.VirtualMachineRef == nil {
+		return nil, nil
+	}
+
+	vm, err := r.getOwnedVirtualMachine(ctx, inst, inst.Status.VirtualMachineRef.Name)
+	if err != nil || vm == nil {
+		return nil, err
+	}
+	if vm.Labels[v1beta1.LabelTemplateUID] != string(tpl.UID) {
+		return nil, fmt.Errorf("%w: VirtualMachine %s was not created from VirtualMachineTemplate %s",
+			errInvalidInstance, vm.Name, tpl.Name)
+	}
+
+	return vm, nil
+}
+
+// getOwnedVirtualMachine returns the named VirtualMachine in the namespace of an instance.
+// It returns nil if the VirtualMachine does not exist and fails if it is not controlled by the instance.
+// The cache only holds VirtualMachines created from templates, so the API server is read directly
+// to find unrelated VirtualMachines of the same name.
+func (r *VirtualMachineTemplateInstanceReconciler) getOwnedVirtualMachine(
+	ctx context.Context, inst *v1beta1.VirtualMachineTemplateInstance, name string,
+) (*virtv1.VirtualMachine, error) {
+	vm := &virtv1.VirtualMachine{}
+	if err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: inst.Namespace, Name: name}, vm); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !metav1.IsControlledBy(vm, inst) {
+		return nil, fmt.Errorf("%w: existing VirtualMachine %s is not managed by this instance", errInvalidInstance, name)
+	}
+
+	return vm, nil
+}
+
+func (r *VirtualMachineTemplateInstanceReconciler) createVirtualMachine(
+	ctx context.Context, inst *v1beta1.VirtualMachineTemplateInstance, vm *virtv1.VirtualMachine,
+) error {
+	if err := controllerutil.SetControllerReference(inst, vm, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, vm); err != nil {
+		return err
+	}
+	logf.FromContext(ctx).V(logs.DebugLevel).Info("Created VirtualMachine", logNS, vm.Namespace, logName, vm.Name)
+	setInstanceCondition(inst, v1beta1.ConditionUpToDate, metav1.ConditionTrue, v1beta1.ReasonReconciled, "")
+
+	return nil
+}
+
+// syncVirtualMachine updates an existing VirtualMachine with the rendered VirtualMachine if its
+// recorded provenance differs and the update policy of the instance allows it. The run strategy
+// of the VirtualMachine is controlled by the user and never updated.
+func (r *VirtualMachineTemplateInstanceReconciler) syncVirtualMachine(
+	ctx context.Context, inst *v1beta1.VirtualMachineTemplateInstance, vm, rendered *virtv1.VirtualMachine,
+) error {
+	provenance := []string{
+		v1beta1.AnnotationTemplateGeneration,
+		v1beta1.AnnotationTemplateRevision,
+		v1beta1.AnnotationTemplateParameters,
+	}
+
+	upToDate := true
+	for _, key := range provenance {
+		if vm.Annotations[key] != rendered.Annotations[key] {
+			upToDate = false
+		}
+	}
+	if upToDate {
+		setInstanceCondition(inst, v1beta1.ConditionUpToDate, metav1.ConditionTrue, v1beta1.ReasonReconciled, "")
+		return nil
+	}
+	if inst.Spec.UpdatePolicy == v1beta1.InstanceUpdatePolicyManual {
+		setInstanceCondition(inst, v1beta1.ConditionUpToDate, metav1.ConditionFalse, v1beta1.ReasonOutdated,
+			"VirtualMachine is out of date and the update policy is Manual")
+		return nil
+	}
+
+	rendered.Spec.RunStrategy = vm.Spec.RunStrategy
+	rendered.Spec.Running = vm.Spec.Running //nolint:staticcheck
+	vm.Spec = rendered.Spec
+	for _, key := range provenance {
+		if value, ok := rendered.Annotations[key]; ok {
+			metav1.SetMetaDataAnnotation(&vm.ObjectMeta, key, value)
+		} else {
+			delete(vm.Annotations, key)
+		}
+	}
+	if err := r.Update(ctx, vm); err != nil {
+		return err
+	}
+	logf.FromContext(ctx).V(logs.DebugLevel).Info("Updated VirtualMachine", logNS, vm.Namespace, logName, vm.Name)
+	setInstanceCondition(inst, v1beta1.ConditionUpToDate, metav1.ConditionTrue, v1beta1.ReasonReconciled, "")
+
+	return nil
+}
+
+// renderInstance processes the template of an instance into a VirtualMachine in the namespace
+// of the instance. Generated parameter values recorded on an existing VirtualMachine are reused
+// unless the instance overrides them, so they stay stable across renders.
+func renderInstance(
+	inst *v1beta1.VirtualMachineTemplateInstance, tpl *v1beta1.VirtualMachineTemplate, vm *virtv1.VirtualMachine,
+) (*virtv1.VirtualMachine, string, error) {
+	tpl = tpl.DeepCopy()
+
+	params := map[string]string{}
+	if vm != nil {
+		recorded, err := apimachinery.GetTemplateParameters(vm)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %w", errInvalidInstance, err)
+		}
+		for _, param := range tpl.Spec.Parameters {
+			if value, ok := recorded[param.Name]; ok && param.Generate != "" {
+				params[param.Name] = value
+			}
+		}
+	}
+	maps.Copy(params, inst.Spec.Parameters)
+
+	var err error
+	tpl.Spec.Parameters, err = template.MergeParameters(tpl.Spec.Parameters, params)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", errInvalidInstance, err)
+	}
+	if _, errs := template.ValidateParameterReferences(tpl); len(errs) > 0 {
+		return nil, "", fmt.Errorf("%w: %w", errInvalidInstance, errs.ToAggregate())
+	}
+
+	processor := template.GetDefaultProcessor()
+	if gErr := processor.GenerateParameterValues(tpl); gErr != nil {
+		return nil, "", fmt.Errorf("%w: %w", errInvalidInstance, gErr)
+	}
+	rendered, msg, pErr := processor.Process(tpl)
+	if pErr != nil {
+		return nil, "", fmt.Errorf("%w: %w", errInvalidInstance, pErr)
+	}
+	apimachinery.SetTemplateProvenance(rendered, tpl)
+
+	if rendered.Namespace != "" && rendered.Namespace != inst.Namespace {
+		return nil, "", fmt.Errorf("%w: VirtualMachine must be created in namespace %s, got %s",
+			errInvalidInstance, inst.Namespace, rendered.Namespace)
+	}
+	rendered.Namespace = inst.Namespace
+	if rendered.Name == "" {
+		rendered.Name = inst.Name
+	}
+	if inst.Status.VirtualMachineRef != nil && inst.Status.VirtualMachineRef.Name != rendered.Name {
+		return nil, "", fmt.Errorf("%w: name of VirtualMachine cannot change from %s to %s",
+			errInvalidInstance, inst.Status.VirtualMachineRef.Name, rendered.Name)
+	}
+
+	return rendered, msg, nil
+}
+
+func setInstanceCondition(
+	inst *v1beta1.VirtualMachineTemplateInstance, conditionType string, status metav1.ConditionStatus, reason, message string,
+) {
+	meta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: inst.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *VirtualMachineTemplateInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Add indexer required for EnqueueInstancesByTemplate
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(), &v1beta1.VirtualMachineTemplateInstance{}, templateRefField,
+		func(obj client.Object) []string {
+			inst, ok := obj.(*v1beta1.VirtualMachineTemplateInstance)
+			if !ok {
+				return nil
+			}
+			return []string{inst.Spec.TemplateRef.Name}
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta1.VirtualMachineTemplateInstance{}).
+		Named(templateapi.SingularInstanceResourceName).
+		Owns(&virtv1.VirtualMachine{}).
+		Watches(&v1beta1.VirtualMachineTemplate{}, handler.EnqueueRequestsFromMapFunc(r.EnqueueInstancesByTemplate)).
+		Complete(r)
+}
+
+// EnqueueInstancesByTemplate enqueues all VirtualMachineTemplateInstances referencing a VirtualMachineTemplate.
+func (r *VirtualMachineTemplateInstanceReconciler) EnqueueInstancesByTemplate(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &v1beta1.VirtualMachineTemplateInstanceList{}
+	if err := r.List(ctx, list, client.InNamespace(obj.GetNamespace()), client.MatchingFields{templateRefField: obj.GetName()}); err != nil {
+		logf.FromContext(ctx).Error(err, "Unable to list VirtualMachineTemplateInstances")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for i := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: list.Items[i].Namespace,
+				Name:      list.Items[i].Name,
+			},
+		})
+	}
+	return requests
+}
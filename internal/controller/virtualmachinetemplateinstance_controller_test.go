@@ -0,0 +1,259 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controller_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	virtv1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+	"kubevirt.io/virt-template/internal/controller"
+)
+
+var _ = Describe("VirtualMachineTemplateInstance Controller", func() {
+	var (
+		reconciler *controller.VirtualMachineTemplateInstanceReconciler
+		tpl        *v1beta1.VirtualMachineTemplate
+		inst       *v1beta1.VirtualMachineTemplateInstance
+	)
+
+	reconcileInstance := func() {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(inst),
+		})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(inst), inst)).To(Succeed())
+	}
+
+	getVM := func() *virtv1.VirtualMachine {
+		vm := &virtv1.VirtualMachine{}
+		ExpectWithOffset(1, k8sClient.Get(context.Background(), types.NamespacedName{
+			Namespace: testNamespace,
+			Name:      "test-vm",
+		}, vm)).To(Succeed())
+		return vm
+	}
+
+	BeforeEach(func() {
+		reconciler = &controller.VirtualMachineTemplateInstanceReconciler{
+			Client:    k8sClient,
+			APIReader: k8sClient,
+			Scheme:    k8sClient.Scheme(),
+		}
+
+		tpl = &v1beta1.VirtualMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-template",
+				Namespace: testNamespace,
+			},
+			Spec: v1beta1.VirtualMachineTemplateSpec{
+				VirtualMachine: &runtime.RawExtension{
+					Object: &virtv1.VirtualMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "${NAME}",
+						},
+						Spec: virtv1.VirtualMachineSpec{
+							RunStrategy: ptr.To(virtv1.RunStrategyAlways),
+							Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+								ObjectMeta: metav1.ObjectMeta{
+									Labels: map[string]string{
+										"password": "${PASSWORD}",
+										"size":     "${SIZE}",
+									},
+								},
+							},
+						},
+					},
+				},
+				Parameters: []v1beta1.Parameter{
+					{Name: "NAME", Required: true},
+					{Name: "PASSWORD", Generate: "expression", From: "[a-z]{8}"},
+					{Name: "SIZE", Value: "small"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), tpl)).To(Succeed())
+
+		inst = &v1beta1.VirtualMachineTemplateInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-instance",
+				Namespace: testNamespace,
+			},
+			Spec: v1beta1.VirtualMachineTemplateInstanceSpec{
+				TemplateRef: corev1.LocalObjectReference{Name: tpl.Name},
+				Parameters: map[string]string{
+					"NAME": "test-vm",
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(context.Background(), tpl)).To(Or(Succeed(), MatchError(k8serrors.IsNotFound, "k8serrors.IsNotFound")))
+	})
+
+	It("should create the VirtualMachine of the instance", func() {
+		Expect(k8sClient.Create(context.Background(), inst)).To(Succeed())
+		reconcileInstance()
+
+		vm := getVM()
+		Expect(metav1.IsControlledBy(vm, inst)).To(BeTrue())
+		Expect(vm.Labels).To(HaveKeyWithValue(v1beta1.LabelTemplateUID, string(tpl.UID)))
+		Expect(vm.Spec.Template.ObjectMeta.Labels).To(HaveKeyWithValue("size", "small"))
+		Expect(vm.Spec.Template.ObjectMeta.Labels).To(HaveKey("password"))
+
+		Expect(inst.Finalizers).To(ContainElement(v1beta1.FinalizerVirtualMachineCleanup))
+		Expect(inst.Status.VirtualMachineRef).To(Equal(&v1beta1.VirtualMachineReference{
+			Namespace: testNamespace,
+			Name:      "test-vm",
+		}))
+		Expect(inst.Status.TemplateGeneration).To(Equal(tpl.Generation))
+		Expect(meta.IsStatusConditionTrue(inst.Status.Conditions, v1beta1.ConditionReady)).To(BeTrue())
+		Expect(meta.IsStatusConditionTrue(inst.Status.Conditions, v1beta1.ConditionUpToDate)).To(BeTrue())
+	})
+
+	It("should wait for the template to exist", func() {
+		inst.Spec.TemplateRef.Name = "missing"
+		Expect(k8sClient.Create(context.Background(), inst)).To(Succeed())
+		reconcileInstance()
+
+		cond := meta.FindStatusCondition(inst.Status.Conditions, v1beta1.ConditionReady)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(v1beta1.ReasonWaiting))
+	})
+
+	It("should report a revision that does not exist", func() {
+		inst.Spec.Revision = 5
+		Expect(k8sClient.Create(context.Background(), inst)).To(Succeed())
+		reconcileInstance()
+
+		cond := meta.FindStatusCondition(inst.Status.Conditions, v1beta1.ConditionReady)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(v1beta1.ReasonInvalidConfiguration))
+		Expect(cond.Message).To(ContainSubstring("revision 5 of VirtualMachineTemplate test-template does not exist"))
+	})
+
+	It("should update the VirtualMachine if the parameters change", func() {
+		Expect(k8sClient.Create(context.Background(), inst)).To(Succeed())
+		reconcileInstance()
+		password := getVM().Spec.Template.ObjectMeta.Labels["password"]
+
+		By("Halting the VirtualMachine")
+		vm := getVM()
+		vm.Spec.RunStrategy = ptr.To(virtv1.RunStrategyHalted)
+		Expect(k8sClient.Update(context.Background(), vm)).To(Succeed())
+
+		By("Changing the parameters of the instance")
+		inst.Spec.Parameters["SIZE"] = "large"
+		Expect(k8sClient.Update(context.Background(), inst)).To(Succeed())
+		reconcileInstance()
+
+		vm = getVM()
+		Expect(vm.Spec.Template.ObjectMeta.Labels).To(HaveKeyWithValue("size", "large"))
+		Expect(vm.Spec.Template.ObjectMeta.Labels).To(HaveKeyWithValue("password", password))
+		Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyHalted)))
+		Expect(meta.IsStatusConditionTrue(inst.Status.Conditions, v1beta1.ConditionUpToDate)).To(BeTrue())
+	})
+
+	It("should not update the VirtualMachine with the Manual update policy", func() {
+		inst.Spec.UpdatePolicy = v1beta1.InstanceUpdatePolicyManual
+		Expect(k8sClient.Create(context.Background(), inst)).To(Succeed())
+		reconcileInstance()
+
+		inst.Spec.Parameters["SIZE"] = "large"
+		Expect(k8sClient.Update(context.Background(), inst)).To(Succeed())
+		reconcileInstance()
+
+		Expect(getVM().Spec.Template.ObjectMeta.Labels).To(HaveKeyWithValue("size", "small"))
+		cond := meta.FindStatusCondition(inst.Status.Conditions, v1beta1.ConditionUpToDate)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(v1beta1.ReasonOutdated))
+	})
+
+	It("should update the VirtualMachine if the template changes", func() {
+		Expect(k8sClient.Create(context.Background(), inst)).To(Succeed())
+		reconcileInstance()
+
+		tpl.Spec.Parameters[2].Value = "medium"
+		Expect(k8sClient.Update(context.Background(), tpl)).To(Succeed())
+		reconcileInstance()
+
+		vm := getVM()
+		Expect(vm.Spec.Template.ObjectMeta.Labels).To(HaveKeyWithValue("size", "medium"))
+		Expect(apimachinery.GetTemplateGeneration(vm)).To(Equal(tpl.Generation))
+		Expect(inst.Status.TemplateGeneration).To(Equal(tpl.Generation))
+	})
+
+	It("should not take over an existing VirtualMachine", func() {
+		vm := &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vm",
+				Namespace: testNamespace,
+			},
+			Spec: virtv1.VirtualMachineSpec{
+				Template: &virtv1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), vm)).To(Succeed())
+
+		Expect(k8sClient.Create(context.Background(), inst)).To(Succeed())
+		reconcileInstance()
+
+		cond := meta.FindStatusCondition(inst.Status.Conditions, v1beta1.ConditionReady)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(v1beta1.ReasonInvalidConfiguration))
+		Expect(metav1.IsControlledBy(getVM(), inst)).To(BeFalse())
+	})
+
+	It("should orphan the VirtualMachine with the Orphan deletion policy", func() {
+		inst.Spec.DeletionPolicy = v1beta1.InstanceDeletionPolicyOrphan
+		Expect(k8sClient.Create(context.Background(), inst)).To(Succeed())
+		reconcileInstance()
+
+		Expect(k8sClient.Delete(context.Background(), inst)).To(Succeed())
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(inst),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(inst), inst)).
+			To(MatchError(k8serrors.IsNotFound, "k8serrors.IsNotFound"))
+		Expect(getVM().OwnerReferences).To(BeEmpty())
+	})
+})
@@ -21,18 +21,26 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"path"
+	"reflect"
 	"slices"
 	"strings"
 	"time"
 
+	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"kubevirt.io/client-go/kubecli"
 	"sigs.k8s.io/cluster-api/util/patch"
@@ -44,6 +52,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
@@ -56,12 +66,22 @@ import (
 
 const (
 	uidField                            = "metadata.uid"
+	snapshotField                       = "spec.virtualMachineSnapshotName"
 	requeueAfterSnapshotContentNotReady = 10 * time.Second
 
 	paramNameName   = "NAME"
 	paramName       = "${" + paramNameName + "}"
 	paramNameSuffix = "-" + paramName
 
+	paramInstancetypeName = "INSTANCETYPE"
+	paramPreferenceName   = "PREFERENCE"
+
+	paramMemoryName            = "MEMORY"
+	paramRunStrategyName       = "RUN_STRATEGY"
+	paramCloudInitUserDataName = "CLOUD_INIT_USER_DATA"
+	paramSSHKeysSecretName     = "SSH_KEYS_SECRET"
+	paramNetworkPrefix         = "NETWORK_"
+
 	logNS              = "ns"
 	logName            = "name"
 	logSnapNS          = "snapNS"
@@ -70,9 +90,15 @@ const (
 	logSnapContentName = "snapContentName"
 	logDVNS            = "dvNS"
 	logDVName          = "dvName"
+	logDSNS            = "dsNS"
+	logDSName          = "dsName"
+	logVSNS            = "vsNS"
+	logVSName          = "vsName"
 	logTplNS           = "tplNS"
 	logTplName         = "tplName"
 	logDVTName         = "dvtName"
+	logJobNS           = "jobNS"
+	logJobName         = "jobName"
 	logVolName         = "volName"
 	logStatus          = "status"
 	logReason          = "reason"
@@ -81,6 +107,18 @@ const (
 	logCount           = "count"
 
 	annImmediateBinding = "cdi.kubevirt.io/storage.bind.immediate.requested"
+
+	generalizationContainerName = "generalize"
+	generalizationDisksPath     = "/disks"
+	generalizationDevicesPath   = "/dev/disks"
+	envGeneralizationDisks      = "DISKS"
+	// generalizationUser is the qemu user owning the disk images imported and cloned by CDI
+	generalizationUser = 107
+
+	// labelBackendStorage is set by KubeVirt on the backend storage PVC of a VM to the name of the VM.
+	// KubeVirt adopts an existing PVC with this label instead of creating a new backend storage PVC.
+	labelBackendStorage  = "persistent-state-for"
+	backendStoragePrefix = labelBackendStorage + "-"
 )
 
 var snapshotErrorReasons = []string{
@@ -91,21 +129,31 @@ var snapshotErrorReasons = []string{
 // VirtualMachineTemplateRequestReconciler reconciles a VirtualMachineTemplateRequest object
 type VirtualMachineTemplateRequestReconciler struct {
 	client.Client
+	// APIReader reads objects that are not labeled to be cached yet
+	APIReader  client.Reader
 	VirtClient kubecli.KubevirtClient
 	Scheme     *runtime.Scheme
+
+	// DefaultSanitizationProfile is the sanitization profile applied to requests that do not select
+	// a profile. If unset, the Minimal profile is applied.
+	DefaultSanitizationProfile v1beta1.SanitizationProfile
 }
 
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=create
 // +kubebuilder:rbac:groups="",resources=pods,verbs=create
 // +kubebuilder:rbac:groups=cdi.kubevirt.io,resources=datavolumes,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups=cdi.kubevirt.io,resources=datavolumes/source,verbs=create
+// +kubebuilder:rbac:groups=cdi.kubevirt.io,resources=datasources,verbs=get;list;watch;create;patch
 // +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplates,verbs=get;list;watch;create
 // +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplaterequests,verbs=get;list;watch;patch;delete
 // +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplaterequests/status,verbs=get;patch
 // +kubebuilder:rbac:groups=template.kubevirt.io,resources=virtualmachinetemplaterequests/finalizers,verbs=update
-// +kubebuilder:rbac:groups=snapshot.kubevirt.io,resources=virtualmachinesnapshots,verbs=get;list;watch;create;delete
-// +kubebuilder:rbac:groups=snapshot.kubevirt.io,resources=virtualmachinesnapshotcontents,verbs=get;list;watch
+// +kubebuilder:rbac:groups=snapshot.kubevirt.io,resources=virtualmachinesnapshots,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=snapshot.kubevirt.io,resources=virtualmachinesnapshotcontents,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create
 // +kubebuilder:rbac:groups=subresources.kubevirt.io,resources=expand-vm-spec,verbs=update
+// +kubebuilder:rbac:groups=instancetype.kubevirt.io,resources=virtualmachineinstancetypes;virtualmachinepreferences,verbs=get;create
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -173,6 +221,14 @@ func (r *VirtualMachineTemplateRequestReconciler) Reconcile(ctx context.Context,
 		log.Error(err, "Error setting owner references")
 		return ctrl.Result{}, err
 	}
+	if err := r.setDataSourceOwnerReferences(ctx, tplReq, tpl); err != nil {
+		log.Error(err, "Error setting owner references")
+		return ctrl.Result{}, err
+	}
+	if err := r.setVolumeSnapshotOwnerReferences(ctx, tplReq, tpl); err != nil {
+		log.Error(err, "Error setting owner references")
+		return ctrl.Result{}, err
+	}
 
 	if err := r.deleteSnapshot(ctx, tplReq); err != nil {
 		return ctrl.Result{}, err
@@ -268,20 +324,13 @@ func (r *VirtualMachineTemplateRequestReconciler) processRequest(
 
 	log.V(logs.DebugLevel).Info("Processing VirtualMachineTemplateRequest")
 
-	snap := emptySnapshot(tplReq)
-	if err := r.Get(ctx, client.ObjectKeyFromObject(snap), snap); k8serrors.IsNotFound(err) {
-		log.Info("Creating VirtualMachineSnapshot", logSnapNS, snap.Namespace, logSnapName, snap.Name)
-		if snap, err = r.createSnapshot(ctx, tplReq); err != nil {
-			return nil, nil, err
-		}
-	} else if err != nil {
-		log.Error(err, "Unable to fetch VirtualMachineSnapshot")
-		return nil, nil, err
+	if tplReq.Spec.VirtualMachineTemplateRef != nil {
+		return r.processTemplateSource(ctx, tplReq)
 	}
 
-	if snap.Labels[v1beta1.LabelRequestUID] != string(tplReq.UID) {
-		setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
-		return nil, nil, fmt.Errorf("virtualMachineSnapshot %s/%s does not belong to this request", snap.Namespace, snap.Name)
+	snap, err := r.getOrCreateSnapshot(ctx, tplReq)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	if isTrue, _ := isSnapshotStatusConditionTrue(snap, snapshotv1beta1.ConditionReady); !isTrue {
@@ -319,6 +368,11 @@ func (r *VirtualMachineTemplateRequestReconciler) processRequest(
 		return nil, &ctrl.Result{}, readyErr
 	}
 
+	if done, generalizeErr := r.generalize(ctx, tplReq, snapContent); !done {
+		log.V(logs.DebugLevel).Info("Generalization of the cloned disks is not done yet")
+		return nil, &ctrl.Result{}, generalizeErr
+	}
+
 	tpl, err := r.createTemplate(ctx, tplReq, snapContent)
 	if err != nil {
 		log.Error(err, "Failed to create VirtualMachineTemplate")
@@ -328,6 +382,277 @@ func (r *VirtualMachineTemplateRequestReconciler) processRequest(
 	return tpl, nil, nil
 }
 
+// processTemplateSource copies the source VirtualMachineTemplate of the request into the namespace of the
+// request. The PVCs and VolumeSnapshots referenced by the DataVolumeTemplates of the source are cloned into
+// DataVolumes, which the DataVolumeTemplates of the copy refer to instead.
+func (r *VirtualMachineTemplateRequestReconciler) processTemplateSource(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
+) (*v1beta1.VirtualMachineTemplate, *ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	source, err := r.getSourceTemplate(ctx, tplReq)
+	if err != nil {
+		log.Error(err, "Unable to fetch source VirtualMachineTemplate")
+		return nil, nil, err
+	}
+
+	// The VirtualMachine of a template may contain parameters, so it is not decoded into its type
+	vm := map[string]any{}
+	if source.Spec.VirtualMachine != nil {
+		if err := json.Unmarshal(source.Spec.VirtualMachine.Raw, &vm); err != nil {
+			setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
+			return nil, nil, fmt.Errorf("failed to decode VirtualMachine of VirtualMachineTemplate %s/%s: %w",
+				source.Namespace, source.Name, err)
+		}
+	}
+
+	disks, err := templateDisks(vm, source.Namespace)
+	if err != nil {
+		setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
+		return nil, nil, err
+	}
+
+	for _, disk := range disks {
+		dv := newDv(tplReq.Namespace, getDvName(tplReq, disk.dvtName), string(tplReq.UID), &disk.source,
+			getStorageSpec(tplReq, disk.volName))
+		if err := r.ensureDataVolume(ctx, tplReq, dv); err != nil {
+			log.Error(err, "Unable to clone disk of source VirtualMachineTemplate", logDVTName, disk.dvtName)
+			return nil, nil, err
+		}
+	}
+
+	for _, disk := range disks {
+		if ready, readyErr := r.isDataVolumeReady(ctx, tplReq, getDvName(tplReq, disk.dvtName)); !ready {
+			log.V(logs.DebugLevel).Info("Disk clone is not ready yet", logDVTName, disk.dvtName)
+			return nil, &ctrl.Result{}, readyErr
+		}
+	}
+
+	for _, disk := range disks {
+		if err := r.rewriteDVTSource(ctx, tplReq, disk); err != nil {
+			log.Error(err, "Unable to rewrite DataVolumeTemplate", logDVTName, disk.dvtName)
+			return nil, nil, err
+		}
+	}
+
+	raw, err := json.Marshal(vm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tpl := emptyTemplate(tplReq)
+	tpl.Labels = templateLabels(tplReq, source.Labels, tplReq.Spec.TemplateLabels)
+	tpl.Spec = *source.Spec.DeepCopy()
+	tpl.Spec.VirtualMachine = &runtime.RawExtension{Raw: raw}
+
+	tpl, err = r.submitTemplate(ctx, tplReq, tpl)
+	if err != nil {
+		log.Error(err, "Failed to create VirtualMachineTemplate")
+		return nil, nil, err
+	}
+
+	return tpl, nil, nil
+}
+
+func (r *VirtualMachineTemplateRequestReconciler) getSourceTemplate(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
+) (*v1beta1.VirtualMachineTemplate, error) {
+	ref := tplReq.Spec.VirtualMachineTemplateRef
+	source := &v1beta1.VirtualMachineTemplate{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, source)
+	return source, err
+}
+
+// templateDisk is a DataVolumeTemplate of a source VirtualMachineTemplate whose disk is cloned.
+type templateDisk struct {
+	dvtName string
+	volName string
+	source  cdiv1beta1.DataVolumeSource
+	// spec is the unstructured spec of the DataVolumeTemplate, it is rewritten in place
+	spec map[string]any
+}
+
+// templateDisks returns the DataVolumeTemplates of the VirtualMachine which clone a PVC or VolumeSnapshot
+// of a fixed name. Other DataVolumeTemplates, e.g. ones referring to a DataSource or using
+// parameters in their source, are copied unchanged. The controller clones the disks with its own
+// permissions, so only disks in the namespace of the template, which the requester was authorized to use,
+// are accepted.
+func templateDisks(vm map[string]any, namespace string) ([]templateDisk, error) {
+	var disks []templateDisk
+	for _, dvt := range nestedMaps(vm, "spec", "dataVolumeTemplates") {
+		dvtName, _, _ := unstructured.NestedString(dvt, "metadata", "name")
+		specObj, _, _ := unstructured.NestedFieldNoCopy(dvt, "spec")
+		spec, _ := specObj.(map[string]any)
+		sourceObj, _ := spec["source"].(map[string]any)
+		if dvtName == "" || sourceObj == nil {
+			continue
+		}
+
+		var source cdiv1beta1.DataVolumeSource
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(sourceObj, &source); err != nil {
+			return nil, fmt.Errorf("failed to decode source of DataVolumeTemplate %s: %w", dvtName, err)
+		}
+
+		// A source without a namespace refers to the namespace of the VirtualMachine, which is the
+		// namespace of the template
+		var sourceNamespace, sourceName string
+		switch {
+		case source.PVC != nil:
+			if source.PVC.Namespace == "" {
+				source.PVC.Namespace = namespace
+			}
+			sourceNamespace, sourceName = source.PVC.Namespace, source.PVC.Name
+		case source.Snapshot != nil:
+			if source.Snapshot.Namespace == "" {
+				source.Snapshot.Namespace = namespace
+			}
+			sourceNamespace, sourceName = source.Snapshot.Namespace, source.Snapshot.Name
+		default:
+			continue
+		}
+		if sourceName == "" || strings.Contains(sourceNamespace+sourceName, "${") {
+			continue
+		}
+		if sourceNamespace != namespace {
+			return nil, fmt.Errorf("source %s/%s of DataVolumeTemplate %s is not in the namespace %s of the VirtualMachineTemplate",
+				sourceNamespace, sourceName, dvtName, namespace)
+		}
+
+		disks = append(disks, templateDisk{
+			dvtName: dvtName,
+			volName: dvtVolumeName(vm, dvtName),
+			source:  source,
+			spec:    spec,
+		})
+	}
+
+	return disks, nil
+}
+
+// dvtVolumeName returns the name of the volume of the VirtualMachine using the DataVolumeTemplate, which
+// storage overrides of the request refer to. It falls back to the name of the DataVolumeTemplate.
+func dvtVolumeName(vm map[string]any, dvtName string) string {
+	for _, vol := range nestedMaps(vm, "spec", "template", "spec", "volumes") {
+		if dvName, _, _ := unstructured.NestedString(vol, "dataVolume", "name"); dvName == dvtName {
+			if volName, _, _ := unstructured.NestedString(vol, "name"); volName != "" {
+				return volName
+			}
+		}
+	}
+
+	return dvtName
+}
+
+// rewriteDVTSource points the DataVolumeTemplate of the disk at the clone of its source.
+func (r *VirtualMachineTemplateRequestReconciler) rewriteDVTSource(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest, disk templateDisk,
+) error {
+	name := getDvName(tplReq, disk.dvtName)
+	dvtSpec := cdiv1beta1.DataVolumeSpec{}
+	if err := r.setDVTSource(ctx, tplReq, name, &dvtSpec, cdiv1beta1.DataVolumeSource{
+		PVC: &cdiv1beta1.DataVolumeSourcePVC{
+			Namespace: tplReq.Namespace,
+			Name:      name,
+		},
+	}); err != nil {
+		return err
+	}
+
+	delete(disk.spec, "source")
+	delete(disk.spec, "sourceRef")
+	if tplReq.Spec.Storage != nil {
+		dvtSpec.Storage = getStorageSpec(tplReq, disk.volName)
+		delete(disk.spec, "pvc")
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&dvtSpec)
+	if err != nil {
+		return err
+	}
+	maps.Copy(disk.spec, obj)
+
+	return nil
+}
+
+// getOrCreateSnapshot returns the VirtualMachineSnapshot the template is created from. Unless the request
+// refers to an existing snapshot of the VirtualMachine, the snapshot is created for the request.
+func (r *VirtualMachineTemplateRequestReconciler) getOrCreateSnapshot(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
+) (*snapshotv1beta1.VirtualMachineSnapshot, error) {
+	log := logf.FromContext(ctx)
+
+	snap := emptySnapshot(tplReq)
+	err := r.getCachedOrLive(ctx, snap)
+	if tplReq.Spec.VirtualMachineSnapshotName != "" {
+		if err != nil {
+			log.Error(err, "Unable to fetch VirtualMachineSnapshot")
+			return nil, err
+		}
+		if !isSnapshotOfVM(snap, tplReq.Spec.VirtualMachineRef.Name) {
+			setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
+			return nil, fmt.Errorf("virtualMachineSnapshot %s/%s is not a snapshot of VirtualMachine %s",
+				snap.Namespace, snap.Name, tplReq.Spec.VirtualMachineRef.Name)
+		}
+		// Changes of the existing snapshot trigger a reconcile once it is labeled
+		if err := r.labelSnapshotSource(ctx, snap); err != nil {
+			return nil, err
+		}
+		return snap, nil
+	}
+
+	if k8serrors.IsNotFound(err) {
+		log.Info("Creating VirtualMachineSnapshot", logSnapNS, snap.Namespace, logSnapName, snap.Name)
+		if snap, err = r.createSnapshot(ctx, tplReq); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		log.Error(err, "Unable to fetch VirtualMachineSnapshot")
+		return nil, err
+	}
+
+	if snap.Labels[v1beta1.LabelRequestUID] != string(tplReq.UID) {
+		setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
+		return nil, fmt.Errorf("virtualMachineSnapshot %s/%s does not belong to this request", snap.Namespace, snap.Name)
+	}
+	if err := r.labelSnapshotSource(ctx, snap); err != nil {
+		return nil, err
+	}
+
+	return snap, nil
+}
+
+// getCachedOrLive reads an object from the cache and falls back to the API server
+// for objects that are not labeled to be cached yet.
+func (r *VirtualMachineTemplateRequestReconciler) getCachedOrLive(ctx context.Context, obj client.Object) error {
+	err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+	if !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return r.APIReader.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+}
+
+// labelSnapshotSource labels an object a template is created from, so that it is cached
+// and its changes trigger a reconcile.
+func (r *VirtualMachineTemplateRequestReconciler) labelSnapshotSource(ctx context.Context, obj client.Object) error {
+	if _, ok := obj.GetLabels()[v1beta1.LabelSnapshotSource]; ok {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[v1beta1.LabelSnapshotSource] = ""
+	obj.SetLabels(labels)
+
+	return r.Patch(ctx, obj, patch)
+}
+
+func isSnapshotOfVM(snap *snapshotv1beta1.VirtualMachineSnapshot, vmName string) bool {
+	return snap.Spec.Source.Kind == virtv1.VirtualMachineGroupVersionKind.Kind && snap.Spec.Source.Name == vmName
+}
+
 func (r *VirtualMachineTemplateRequestReconciler) createSnapshot(
 	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
 ) (*snapshotv1beta1.VirtualMachineSnapshot, error) {
@@ -354,7 +679,10 @@ func (r *VirtualMachineTemplateRequestReconciler) getSnapshotContent(
 			Name:      *snap.Status.VirtualMachineSnapshotContentName,
 		},
 	}
-	if err := r.Get(ctx, client.ObjectKeyFromObject(snapContent), snapContent); err != nil {
+	if err := r.getCachedOrLive(ctx, snapContent); err != nil {
+		return nil, err
+	}
+	if err := r.labelSnapshotSource(ctx, snapContent); err != nil {
 		return nil, err
 	}
 
@@ -365,32 +693,26 @@ func (r *VirtualMachineTemplateRequestReconciler) cloneSnapshotContent(
 	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
 	snapContent *snapshotv1beta1.VirtualMachineSnapshotContent,
 ) error {
+	if tplReq.Spec.DiskStrategy == v1beta1.DiskStrategySnapshot {
+		return nil
+	}
+
 	backendStoragePVCName := r.getBackendStoragePVCName(snapContent)
 	for _, vol := range snapContent.Spec.VolumeBackups {
-		if vol.VolumeName == backendStoragePVCName {
+		if skipVolumeBackup(tplReq, backendStoragePVCName, vol.VolumeName) {
 			logf.FromContext(ctx).V(logs.DebugLevel).Info("Skipping clone of backend storage PVC",
 				logVolName, vol.VolumeName)
 			continue
 		}
-		dv := emptyDv(tplReq.Namespace, getDvName(tplReq, vol.VolumeName))
-		if err := r.Get(ctx, client.ObjectKeyFromObject(dv), dv); err != nil {
-			if !k8serrors.IsNotFound(err) {
-				return err
-			}
-		} else {
-			if dv.Labels[v1beta1.LabelRequestUID] != string(tplReq.UID) {
-				setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
-				return fmt.Errorf("dataVolume %s/%s does not belong to this request", dv.Namespace, dv.Name)
-			}
-			continue
-		}
-
-		dv = newDv(dv.Namespace, dv.Name, string(tplReq.UID), snapContent.Namespace, *vol.VolumeSnapshotName)
-		logf.FromContext(ctx).Info("Creating DataVolume", logDVNS, dv.Namespace, logDVName, dv.Name)
-		if err := ctrl.SetControllerReference(tplReq, dv, r.Scheme); err != nil {
-			return err
+		source := &cdiv1beta1.DataVolumeSource{
+			Snapshot: &cdiv1beta1.DataVolumeSourceSnapshot{
+				Namespace: snapContent.Namespace,
+				Name:      *vol.VolumeSnapshotName,
+			},
 		}
-		if err := r.Create(ctx, dv); err != nil {
+		dv := newDv(tplReq.Namespace, getDvName(tplReq, vol.VolumeName), string(tplReq.UID), source,
+			getStorageSpec(tplReq, vol.VolumeName))
+		if err := r.ensureDataVolume(ctx, tplReq, dv); err != nil {
 			return err
 		}
 	}
@@ -398,32 +720,141 @@ func (r *VirtualMachineTemplateRequestReconciler) cloneSnapshotContent(
 	return nil
 }
 
+// ensureDataVolume creates the DataVolume unless it already exists. An existing DataVolume must belong to the request.
+func (r *VirtualMachineTemplateRequestReconciler) ensureDataVolume(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest, dv *cdiv1beta1.DataVolume,
+) error {
+	existing := emptyDv(dv.Namespace, dv.Name)
+	if err := r.Get(ctx, client.ObjectKeyFromObject(existing), existing); err == nil {
+		if existing.Labels[v1beta1.LabelRequestUID] != string(tplReq.UID) {
+			setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
+			return fmt.Errorf("dataVolume %s/%s does not belong to this request", existing.Namespace, existing.Name)
+		}
+		return nil
+	} else if !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	logf.FromContext(ctx).Info("Creating DataVolume", logDVNS, dv.Namespace, logDVName, dv.Name)
+	if err := ctrl.SetControllerReference(tplReq, dv, r.Scheme); err != nil {
+		return err
+	}
+
+	return r.Create(ctx, dv)
+}
+
 func (r *VirtualMachineTemplateRequestReconciler) isSnapshotContentCloneReady(
 	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
 	snapContent *snapshotv1beta1.VirtualMachineSnapshotContent,
 ) (bool, error) {
+	if tplReq.Spec.DiskStrategy == v1beta1.DiskStrategySnapshot {
+		return true, nil
+	}
+
 	backendStoragePVCName := r.getBackendStoragePVCName(snapContent)
 	for _, vol := range snapContent.Spec.VolumeBackups {
-		if vol.VolumeName == backendStoragePVCName {
+		if skipVolumeBackup(tplReq, backendStoragePVCName, vol.VolumeName) {
 			continue
 		}
-		dv := emptyDv(tplReq.Namespace, getDvName(tplReq, vol.VolumeName))
-		if err := r.Get(ctx, client.ObjectKeyFromObject(dv), dv); err != nil {
-			return false, client.IgnoreNotFound(err)
+		if ready, err := r.isDataVolumeReady(ctx, tplReq, getDvName(tplReq, vol.VolumeName)); !ready {
+			return false, err
 		}
+	}
 
-		if isTrue, _ := isDataVolumeStatusConditionTrue(dv, cdiv1beta1.DataVolumeReady); !isTrue {
-			syncDataVolumeStatusConditions(ctx, tplReq, dv)
-			return false, nil
-		}
+	return true, nil
+}
+
+func (r *VirtualMachineTemplateRequestReconciler) isDataVolumeReady(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest, name string,
+) (bool, error) {
+	dv := emptyDv(tplReq.Namespace, name)
+	if err := r.Get(ctx, client.ObjectKeyFromObject(dv), dv); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	if isTrue, _ := isDataVolumeStatusConditionTrue(dv, cdiv1beta1.DataVolumeReady); !isTrue {
+		syncDataVolumeStatusConditions(ctx, tplReq, dv)
+		return false, nil
 	}
 
 	return true, nil
 }
 
+// generalize runs the generalization Job of the request against the cloned disks. It returns true
+// if the request does not ask for generalization or once the Job completed.
+func (r *VirtualMachineTemplateRequestReconciler) generalize(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
+	snapContent *snapshotv1beta1.VirtualMachineSnapshotContent,
+) (bool, error) {
+	if tplReq.Spec.Generalization == nil {
+		return true, nil
+	}
+
+	job := emptyGeneralizationJob(tplReq)
+	if err := r.Get(ctx, client.ObjectKeyFromObject(job), job); k8serrors.IsNotFound(err) {
+		job = newGeneralizationJob(tplReq, r.getDiskVolumes(snapContent))
+		if err := ctrl.SetControllerReference(tplReq, job, r.Scheme); err != nil {
+			return false, err
+		}
+		logf.FromContext(ctx).Info("Creating generalization Job", logJobNS, job.Namespace, logJobName, job.Name)
+		if err := r.Create(ctx, job); err != nil {
+			return false, err
+		}
+	} else if err != nil {
+		return false, err
+	} else if job.Labels[v1beta1.LabelRequestUID] != string(tplReq.UID) {
+		setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
+		return false, fmt.Errorf("job %s/%s does not belong to this request", job.Namespace, job.Name)
+	}
+
+	if cond := findJobCondition(job, batchv1.JobComplete); cond != nil && cond.Status == corev1.ConditionTrue {
+		return true, nil
+	}
+	if cond := findJobCondition(job, batchv1.JobFailed); cond != nil && cond.Status == corev1.ConditionTrue {
+		setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
+		return false, fmt.Errorf("generalization Job %s/%s failed: %s", job.Namespace, job.Name, cond.Message)
+	}
+
+	setReadyCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonWaiting,
+		"Waiting for generalization Job %s/%s to complete", job.Namespace, job.Name)
+	setProgressingCondition(ctx, tplReq, metav1.ConditionTrue, v1beta1.ReasonWaiting)
+	return false, nil
+}
+
+// getDiskVolumes returns the volume backups of the snapshot content holding disks of the VM.
+func (r *VirtualMachineTemplateRequestReconciler) getDiskVolumes(
+	snapContent *snapshotv1beta1.VirtualMachineSnapshotContent,
+) []snapshotv1beta1.VolumeBackup {
+	backendStoragePVCName := r.getBackendStoragePVCName(snapContent)
+
+	var volumes []snapshotv1beta1.VolumeBackup
+	for _, vol := range snapContent.Spec.VolumeBackups {
+		// The backend storage holds no disk and is never generalized
+		if vol.VolumeName != backendStoragePVCName {
+			volumes = append(volumes, vol)
+		}
+	}
+	return volumes
+}
+
+func findJobCondition(job *batchv1.Job, condType batchv1.JobConditionType) *batchv1.JobCondition {
+	for i := range job.Status.Conditions {
+		if job.Status.Conditions[i].Type == condType {
+			return &job.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
 func (r *VirtualMachineTemplateRequestReconciler) deleteSnapshot(
 	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
 ) error {
+	// Existing snapshots referenced by the request are kept, requests for templates take no snapshot
+	if tplReq.Spec.VirtualMachineSnapshotName != "" || tplReq.Spec.VirtualMachineTemplateRef != nil {
+		return nil
+	}
+
 	snap := emptySnapshot(tplReq)
 	logf.FromContext(ctx).V(logs.DebugLevel).Info("Deleting VirtualMachineSnapshot", logSnapNS, snap.Namespace, logSnapName, snap.Name)
 	return client.IgnoreNotFound(r.Delete(ctx, snap))
@@ -433,83 +864,519 @@ func (r *VirtualMachineTemplateRequestReconciler) createTemplate(
 	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
 	snapContent *snapshotv1beta1.VirtualMachineSnapshotContent,
 ) (*v1beta1.VirtualMachineTemplate, error) {
-	vm, err := r.getExpandedVM(ctx, tplReq, snapContent)
+	vm, err := r.getSourceVM(ctx, tplReq, snapContent)
+	if err != nil {
+		return nil, err
+	}
+
+	if vm.Spec.Template == nil {
+		setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
+		return nil, fmt.Errorf("source VirtualMachine %s/%s has no template spec", vm.Namespace, vm.Name)
+	}
+
+	tplReq.Status.SanitizedFields = sanitize(r.sanitizationRules(tplReq), vm.Spec.Template)
+
+	instancetypeParams, err := r.captureInstancetypes(ctx, tplReq, &vm.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.addDataVolumeTemplates(ctx, tplReq, snapContent, &vm.Spec); err != nil {
+		return nil, err
+	}
+
+	tpl := newTemplate(tplReq, &vm.Spec)
+	tpl.Spec.Parameters = append(tpl.Spec.Parameters, instancetypeParams...)
+	if err := parameterizeTemplate(tplReq.Spec.Parameterize, tpl); err != nil {
+		return nil, err
+	}
+
+	return r.submitTemplate(ctx, tplReq, tpl)
+}
+
+func (r *VirtualMachineTemplateRequestReconciler) submitTemplate(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest, tpl *v1beta1.VirtualMachineTemplate,
+) (*v1beta1.VirtualMachineTemplate, error) {
+	logf.FromContext(ctx).Info("Creating VirtualMachineTemplate", logTplNS, tpl.Namespace, logTplName, tpl.Name)
+	if err := r.Client.Create(ctx, tpl); err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			setReadyCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed, "%s", err.Error())
+			setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
+		}
+		return nil, err
+	}
+
+	setTemplateRef(tplReq, tpl)
+
+	return tpl, nil
+}
+
+// addDataVolumeTemplates points the volumes of the VirtualMachine at DataVolumeTemplates cloning
+// the captured volumes.
+func (r *VirtualMachineTemplateRequestReconciler) addDataVolumeTemplates(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
+	snapContent *snapshotv1beta1.VirtualMachineSnapshotContent, vmSpec *virtv1.VirtualMachineSpec,
+) error {
+	backendStoragePVCName := r.getBackendStoragePVCName(snapContent)
+	for _, volBackup := range snapContent.Spec.VolumeBackups {
+		// Check if this is a backend storage PVC (VM state PVC)
+		if skipVolumeBackup(tplReq, backendStoragePVCName, volBackup.VolumeName) {
+			logf.FromContext(ctx).V(logs.DebugLevel).Info("Skipping backend storage PVC",
+				logVolName, volBackup.VolumeName, "pvcName", volBackup.PersistentVolumeClaim.Name)
+			continue
+		}
+
+		dvtSpec, err := r.getDVTSpec(ctx, tplReq, snapContent.Namespace, &volBackup)
+		if err != nil {
+			return err
+		}
+		if volBackup.VolumeName == backendStoragePVCName {
+			addBackendStorageDVT(ctx, &vmSpec.DataVolumeTemplates, dvtSpec)
+			continue
+		}
+
+		dvName := transformVolume(ctx, &vmSpec.Template.Spec.Volumes, volBackup.VolumeName)
+		if dvName == "" {
+			dvName = volBackup.VolumeName
+		}
+		transformOrAddDVT(ctx, &vmSpec.DataVolumeTemplates, dvName, dvtSpec)
+	}
+
+	return nil
+}
+
+// getDVTSpec returns the spec of the DataVolumeTemplate cloning a captured volume. It refers either
+// to the cloned PVC, or with the Snapshot disk strategy to the kept VolumeSnapshot, or to a DataSource
+// pointing at either of them, which is created if missing.
+func (r *VirtualMachineTemplateRequestReconciler) getDVTSpec(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest, snapNamespace string,
+	volBackup *snapshotv1beta1.VolumeBackup,
+) (cdiv1beta1.DataVolumeSpec, error) {
+	dvtSpec := cdiv1beta1.DataVolumeSpec{
+		Storage: getStorageSpec(tplReq, volBackup.VolumeName),
+	}
+	name := getDvName(tplReq, volBackup.VolumeName)
+	source := cdiv1beta1.DataVolumeSource{
+		PVC: &cdiv1beta1.DataVolumeSourcePVC{
+			Namespace: tplReq.Namespace,
+			Name:      name,
+		},
+	}
+	if tplReq.Spec.DiskStrategy == v1beta1.DiskStrategySnapshot {
+		if err := r.adoptVolumeSnapshot(ctx, tplReq, snapNamespace, *volBackup.VolumeSnapshotName); err != nil {
+			return dvtSpec, err
+		}
+		source = cdiv1beta1.DataVolumeSource{
+			Snapshot: &cdiv1beta1.DataVolumeSourceSnapshot{
+				Namespace: snapNamespace,
+				Name:      *volBackup.VolumeSnapshotName,
+			},
+		}
+	}
+	err := r.setDVTSource(ctx, tplReq, name, &dvtSpec, source)
+	return dvtSpec, err
+}
+
+// setDVTSource points the spec of a DataVolumeTemplate at the source, either directly or through a
+// DataSource of the given name, depending on the DataVolumeTemplate source of the request.
+func (r *VirtualMachineTemplateRequestReconciler) setDVTSource(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest, name string,
+	dvtSpec *cdiv1beta1.DataVolumeSpec, source cdiv1beta1.DataVolumeSource,
+) error {
+	if tplReq.Spec.DataVolumeTemplateSource != v1beta1.DataVolumeTemplateSourceDataSource {
+		dvtSpec.Source = &source
+		return nil
+	}
+
+	dsSource := cdiv1beta1.DataSourceSource{
+		PVC:      source.PVC,
+		Snapshot: source.Snapshot,
+	}
+	if err := r.createDataSource(ctx, tplReq, name, dsSource); err != nil {
+		return err
+	}
+	dvtSpec.SourceRef = &cdiv1beta1.DataVolumeSourceRef{
+		Kind:      cdiv1beta1.DataVolumeDataSource,
+		Namespace: ptr.To(tplReq.Namespace),
+		Name:      name,
+	}
+
+	return nil
+}
+
+// createDataSource creates a DataSource pointing at the captured volume. The DataSource is owned by
+// the request until the template is created, like the cloned DataVolume.
+func (r *VirtualMachineTemplateRequestReconciler) createDataSource(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest, name string, source cdiv1beta1.DataSourceSource,
+) error {
+	ds := emptyDataSource(tplReq.Namespace, name)
+	if err := r.Get(ctx, client.ObjectKeyFromObject(ds), ds); err == nil {
+		if ds.Labels[v1beta1.LabelRequestUID] != string(tplReq.UID) {
+			setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
+			return fmt.Errorf("dataSource %s/%s does not belong to this request", ds.Namespace, ds.Name)
+		}
+		return nil
+	} else if !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	ds = newDataSource(ds.Namespace, ds.Name, string(tplReq.UID), source)
+	logf.FromContext(ctx).Info("Creating DataSource", logDSNS, ds.Namespace, logDSName, ds.Name)
+	if err := ctrl.SetControllerReference(tplReq, ds, r.Scheme); err != nil {
+		return err
+	}
+
+	return r.Create(ctx, ds)
+}
+
+// adoptVolumeSnapshot makes the request the controller of a VolumeSnapshot of the transient
+// VirtualMachineSnapshot, so that the VolumeSnapshot is kept when the VirtualMachineSnapshot is deleted.
+func (r *VirtualMachineTemplateRequestReconciler) adoptVolumeSnapshot(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest, namespace, name string,
+) error {
+	vs := &volumesnapshotv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+	// VolumeSnapshots are only cached once they are labeled with the UID of the request
+	if err := r.getCachedOrLive(ctx, vs); err != nil {
+		return err
+	}
+	if metav1.IsControlledBy(vs, tplReq) {
+		return nil
+	}
+
+	logf.FromContext(ctx).Info("Adopting VolumeSnapshot", logVSNS, vs.Namespace, logVSName, vs.Name)
+	vsCopy := vs.DeepCopy()
+	if vs.Labels == nil {
+		vs.Labels = map[string]string{}
+	}
+	vs.Labels[v1beta1.LabelRequestUID] = string(tplReq.UID)
+	vs.OwnerReferences = slices.DeleteFunc(vs.OwnerReferences, func(ref metav1.OwnerReference) bool {
+		return ptr.Deref(ref.Controller, false)
+	})
+	if err := controllerutil.SetControllerReference(tplReq, vs, r.Scheme); err != nil {
+		return err
+	}
+
+	return r.Patch(ctx, vs, client.MergeFrom(vsCopy))
+}
+
+func (r *VirtualMachineTemplateRequestReconciler) getSourceVM(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
+	snapContent *snapshotv1beta1.VirtualMachineSnapshotContent,
+) (*virtv1.VirtualMachine, error) {
+	if snapContent.Spec.Source.VirtualMachine == nil {
+		setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
+		return nil, fmt.Errorf("virtualMachineSnapshotContent %s/%s has no source VirtualMachine",
+			snapContent.Namespace, snapContent.Name)
+	}
+
+	vm := &virtv1.VirtualMachine{
+		ObjectMeta: snapContent.Spec.Source.VirtualMachine.ObjectMeta,
+		Spec:       snapContent.Spec.Source.VirtualMachine.Spec,
+		Status:     snapContent.Spec.Source.VirtualMachine.Status,
+	}
+
+	// The revisions of the instance types and preferences belong to the source VM and are never kept.
+	// VMs created from the template store their own revisions of the referenced objects.
+	if keepInstancetypes(tplReq) {
+		if vm.Spec.Instancetype != nil {
+			vm.Spec.Instancetype.RevisionName = ""
+		}
+		if vm.Spec.Preference != nil {
+			vm.Spec.Preference.RevisionName = ""
+		}
+		return vm, nil
+	}
+
+	// By expanding the VM the instance types and preferences and their revisions are removed
+	// from the VM's definition.
+	vm, err := r.VirtClient.ExpandSpec(snapContent.Namespace).ForVirtualMachine(vm)
 	if err != nil {
 		return nil, err
 	}
 
-	if vm.Spec.Template == nil {
-		setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
-		return nil, fmt.Errorf("source VirtualMachine %s/%s has no template spec", vm.Namespace, vm.Name)
+	return vm, nil
+}
+
+// captureInstancetypes copies the namespaced instance type and preference referenced by the VM into the
+// namespace of the template and replaces their names with parameters, if requested.
+func (r *VirtualMachineTemplateRequestReconciler) captureInstancetypes(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest, vmSpec *virtv1.VirtualMachineSpec,
+) ([]v1beta1.Parameter, error) {
+	if !keepInstancetypes(tplReq) {
+		return nil, nil
+	}
+
+	capture := tplReq.Spec.Instancetypes
+	if capture.Copy && tplReq.Spec.VirtualMachineRef.Namespace != tplReq.Namespace {
+		if err := r.copyInstancetype(ctx, tplReq, vmSpec.Instancetype); err != nil {
+			return nil, err
+		}
+		if err := r.copyPreference(ctx, tplReq, vmSpec.Preference); err != nil {
+			return nil, err
+		}
+	}
+	if !capture.Parameterize {
+		return nil, nil
+	}
+
+	var params []v1beta1.Parameter
+	if vmSpec.Instancetype != nil && vmSpec.Instancetype.Name != "" {
+		params = append(params, v1beta1.Parameter{
+			Name:        paramInstancetypeName,
+			Description: "Name of the instance type of the VirtualMachine",
+			Value:       vmSpec.Instancetype.Name,
+		})
+		vmSpec.Instancetype.Name = "${" + paramInstancetypeName + "}"
+	}
+	if vmSpec.Preference != nil && vmSpec.Preference.Name != "" {
+		params = append(params, v1beta1.Parameter{
+			Name:        paramPreferenceName,
+			Description: "Name of the preference of the VirtualMachine",
+			Value:       vmSpec.Preference.Name,
+		})
+		vmSpec.Preference.Name = "${" + paramPreferenceName + "}"
+	}
+
+	return params, nil
+}
+
+func (r *VirtualMachineTemplateRequestReconciler) copyInstancetype(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest, matcher *virtv1.InstancetypeMatcher,
+) error {
+	if matcher == nil || matcher.Name == "" || !isNamespacedKind(matcher.Kind, instancetypeapi.SingularResourceName) {
+		return nil
+	}
+
+	source, err := r.VirtClient.VirtualMachineInstancetype(tplReq.Spec.VirtualMachineRef.Namespace).
+		Get(ctx, matcher.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get VirtualMachineInstancetype %s: %w", matcher.Name, err)
+	}
+
+	instancetype := &instancetypev1beta1.VirtualMachineInstancetype{
+		ObjectMeta: copiedObjectMeta(tplReq, &source.ObjectMeta),
+		Spec:       source.Spec,
+	}
+	logf.FromContext(ctx).Info("Copying VirtualMachineInstancetype", logNS, instancetype.Namespace, logName, instancetype.Name)
+	_, err = r.VirtClient.VirtualMachineInstancetype(tplReq.Namespace).Create(ctx, instancetype, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to copy VirtualMachineInstancetype %s: %w", matcher.Name, err)
+	}
+
+	return nil
+}
+
+func (r *VirtualMachineTemplateRequestReconciler) copyPreference(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest, matcher *virtv1.PreferenceMatcher,
+) error {
+	if matcher == nil || matcher.Name == "" || !isNamespacedKind(matcher.Kind, instancetypeapi.SingularPreferenceResourceName) {
+		return nil
+	}
+
+	source, err := r.VirtClient.VirtualMachinePreference(tplReq.Spec.VirtualMachineRef.Namespace).
+		Get(ctx, matcher.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get VirtualMachinePreference %s: %w", matcher.Name, err)
+	}
+
+	preference := &instancetypev1beta1.VirtualMachinePreference{
+		ObjectMeta: copiedObjectMeta(tplReq, &source.ObjectMeta),
+		Spec:       source.Spec,
+	}
+	logf.FromContext(ctx).Info("Copying VirtualMachinePreference", logNS, preference.Namespace, logName, preference.Name)
+	_, err = r.VirtClient.VirtualMachinePreference(tplReq.Namespace).Create(ctx, preference, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to copy VirtualMachinePreference %s: %w", matcher.Name, err)
+	}
+
+	return nil
+}
+
+func keepInstancetypes(tplReq *v1beta1.VirtualMachineTemplateRequest) bool {
+	return tplReq.Spec.Instancetypes != nil &&
+		tplReq.Spec.Instancetypes.Policy == v1beta1.InstancetypeCapturePolicyKeep
+}
+
+// parameterizeTemplate replaces the values of the settings selected by the request with parameters
+// defaulting to the captured values. The VirtualMachine is edited as unstructured object, because
+// some settings, like the number of CPU cores, cannot hold placeholders in the typed object.
+func parameterizeTemplate(parameterize *v1beta1.TemplateParameterization, tpl *v1beta1.VirtualMachineTemplate) error {
+	if parameterize == nil {
+		return nil
+	}
+
+	vm, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tpl.Spec.VirtualMachine.Object)
+	if err != nil {
+		return err
+	}
+
+	p := &parameterizer{}
+	p.parameterizeDomain(parameterize, vm)
+	if parameterize.RunStrategy {
+		p.replace(vm, paramRunStrategyName, "Run strategy of the VirtualMachine", true, "spec", "runStrategy")
+	}
+	if parameterize.CloudInitUserData {
+		for _, vol := range nestedMaps(vm, "spec", "template", "spec", "volumes") {
+			for _, source := range []string{"cloudInitNoCloud", "cloudInitConfigDrive"} {
+				p.replace(vol, p.indexedName(paramCloudInitUserDataName), "Cloud-init user data of the VirtualMachine", true,
+					source, "userData")
+			}
+		}
+	}
+	if parameterize.SSHKeys {
+		for _, cred := range nestedMaps(vm, "spec", "template", "spec", "accessCredentials") {
+			p.replace(cred, p.indexedName(paramSSHKeysSecretName), "Name of the secret holding SSH public keys", true,
+				"sshPublicKey", "source", "secret", "secretName")
+		}
+	}
+	if parameterize.NetworkNames {
+		for _, network := range nestedMaps(vm, "spec", "template", "spec", "networks") {
+			name, _, _ := unstructured.NestedString(network, "name")
+			p.replace(network, networkParamName(name), "Name of the Multus network of the network "+name, true,
+				"multus", "networkName")
+		}
+	}
+
+	data, err := json.Marshal(vm)
+	if err != nil {
+		return err
 	}
+	tpl.Spec.VirtualMachine = &runtime.RawExtension{Raw: data}
+	tpl.Spec.Parameters = append(tpl.Spec.Parameters, p.params...)
 
-	stripUniqueIdentifiers(&vm.Spec)
+	return nil
+}
 
-	backendStoragePVCName := r.getBackendStoragePVCName(snapContent)
-	for _, volBackup := range snapContent.Spec.VolumeBackups {
-		// Check if this is a backend storage PVC (VM state PVC)
-		if volBackup.VolumeName == backendStoragePVCName {
-			logf.FromContext(ctx).V(logs.DebugLevel).Info("Skipping backend storage PVC",
-				logVolName, volBackup.VolumeName, "pvcName", volBackup.PersistentVolumeClaim.Name)
-			continue
-		}
+// parameterizer collects the parameters replacing the values of an unstructured object.
+type parameterizer struct {
+	params []v1beta1.Parameter
+}
 
-		dvName := transformVolume(ctx, &vm.Spec.Template.Spec.Volumes, volBackup.VolumeName)
-		if dvName == "" {
-			dvName = volBackup.VolumeName
+func (p *parameterizer) parameterizeDomain(parameterize *v1beta1.TemplateParameterization, vm map[string]any) {
+	if parameterize.CPU {
+		for _, topology := range []string{"sockets", "cores", "threads"} {
+			p.replace(vm, "CPU_"+strings.ToUpper(topology), "Number of CPU "+topology+" of the VirtualMachine", false,
+				"spec", "template", "spec", "domain", "cpu", topology)
 		}
-		transformOrAddDVT(ctx, &vm.Spec.DataVolumeTemplates, dvName, tplReq.Namespace, getDvName(tplReq, volBackup.VolumeName))
 	}
+	if parameterize.Memory &&
+		!p.replace(vm, paramMemoryName, "Guest memory of the VirtualMachine", true,
+			"spec", "template", "spec", "domain", "memory", "guest") {
+		p.replace(vm, paramMemoryName, "Requested memory of the VirtualMachine", true,
+			"spec", "template", "spec", "domain", "resources", "requests", "memory")
+	}
+}
 
-	tpl := newTemplate(tplReq, &vm.Spec)
-	logf.FromContext(ctx).Info("Creating VirtualMachineTemplate", logTplNS, tpl.Namespace, logTplName, tpl.Name)
-	if err := r.Client.Create(ctx, tpl); err != nil {
-		if k8serrors.IsAlreadyExists(err) {
-			setReadyCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed, "%s", err.Error())
-			setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
-		}
-		return nil, err
+// replace replaces the value of a field with a placeholder of a new parameter whose value defaults to
+// the replaced value. Non-string placeholders are used for values that are no strings. It returns true
+// if the field was set and replaced.
+func (p *parameterizer) replace(obj map[string]any, name, description string, asString bool, fields ...string) bool {
+	value, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !found || value == nil || value == "" {
+		return false
 	}
 
-	setTemplateRef(tplReq, tpl)
+	placeholder := "${" + name + "}"
+	if !asString {
+		placeholder = "${{" + name + "}}"
+	}
+	if err := unstructured.SetNestedField(obj, placeholder, fields...); err != nil {
+		return false
+	}
+	p.params = append(p.params, v1beta1.Parameter{
+		Name:        name,
+		Description: description,
+		Value:       fmt.Sprint(value),
+	})
 
-	return tpl, nil
+	return true
 }
 
-func (r *VirtualMachineTemplateRequestReconciler) getExpandedVM(
-	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
-	snapContent *snapshotv1beta1.VirtualMachineSnapshotContent,
-) (*virtv1.VirtualMachine, error) {
-	if snapContent.Spec.Source.VirtualMachine == nil {
-		setProgressingCondition(ctx, tplReq, metav1.ConditionFalse, v1beta1.ReasonFailed)
-		return nil, fmt.Errorf("virtualMachineSnapshotContent %s/%s has no source VirtualMachine",
-			snapContent.Namespace, snapContent.Name)
+// indexedName returns the name of the next parameter with the given name. The first parameter has no
+// suffix, further parameters use the suffixes _2, _3 and so on.
+func (p *parameterizer) indexedName(name string) string {
+	count := 0
+	for _, param := range p.params {
+		if param.Name == name || strings.HasPrefix(param.Name, name+"_") {
+			count++
+		}
+	}
+	if count == 0 {
+		return name
 	}
+	return fmt.Sprintf("%s_%d", name, count+1)
+}
 
-	vm := &virtv1.VirtualMachine{
-		ObjectMeta: snapContent.Spec.Source.VirtualMachine.ObjectMeta,
-		Spec:       snapContent.Spec.Source.VirtualMachine.Spec,
-		Status:     snapContent.Spec.Source.VirtualMachine.Status,
+// nestedMaps returns the objects of a list in an unstructured object. Changes to the returned
+// objects are reflected in the unstructured object.
+func nestedMaps(obj map[string]any, fields ...string) []map[string]any {
+	value, _, _ := unstructured.NestedFieldNoCopy(obj, fields...)
+	items, _ := value.([]any)
+
+	var objs []map[string]any
+	for _, item := range items {
+		if m, ok := item.(map[string]any); ok {
+			objs = append(objs, m)
+		}
 	}
+	return objs
+}
 
-	// By expanding the VM the instance types and preferences and their revisions are removed
-	// from the VM's definition. This makes handling a lot easier since no ControllerRevisions need to be copied.
-	// TODO: Add support for ControllerRevisions so instance types and preferences can be kept
-	vm, err := r.VirtClient.ExpandSpec(snapContent.Namespace).ForVirtualMachine(vm)
-	if err != nil {
-		return nil, err
+// networkParamName returns the name of the parameter for the network with the given name, e.g.
+// NETWORK_MY_NET for the network my-net.
+func networkParamName(name string) string {
+	return paramNetworkPrefix + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+// isNamespacedKind returns true if the kind of an instance type or preference matcher refers to
+// the namespaced resource with the given singular name. Matchers refer to the cluster-wide
+// resources by default.
+func isNamespacedKind(kind, singularName string) bool {
+	kind = strings.ToLower(kind)
+	return kind == singularName || kind == singularName+"s"
+}
+
+func copiedObjectMeta(tplReq *v1beta1.VirtualMachineTemplateRequest, source *metav1.ObjectMeta) metav1.ObjectMeta {
+	labels := maps.Clone(source.Labels)
+	if labels == nil {
+		labels = map[string]string{}
 	}
+	labels[v1beta1.LabelRequestUID] = string(tplReq.UID)
 
-	return vm, nil
+	return metav1.ObjectMeta{
+		Name:        source.Name,
+		Namespace:   tplReq.Namespace,
+		Labels:      labels,
+		Annotations: source.Annotations,
+	}
 }
 
+// getBackendStoragePVCName returns the name of the volume backup of the backend storage PVC
+// (EFI/TPM/CBT persistent state) of the source VM. Only one backend volume is expected in a snapshot.
 func (r *VirtualMachineTemplateRequestReconciler) getBackendStoragePVCName(
 	snapContent *snapshotv1beta1.VirtualMachineSnapshotContent,
 ) string {
+	if snapContent.Spec.Source.VirtualMachine == nil {
+		return ""
+	}
+
+	vmName := snapContent.Spec.Source.VirtualMachine.Name
 	for _, vol := range snapContent.Spec.VolumeBackups {
-		// Backend storage volumes (EFI/TPM/CBT persistent state) follow this naming pattern
-		// Only one backend volume is expected in snapshot
-		if strings.Contains(vol.VolumeName, "persistent-state-for") {
+		if isBackendStorageVolume(&vol, vmName) {
 			return vol.VolumeName
 		}
 	}
@@ -517,6 +1384,23 @@ func (r *VirtualMachineTemplateRequestReconciler) getBackendStoragePVCName(
 	return ""
 }
 
+// isBackendStorageVolume identifies the backend storage PVC of a VM by its label. PVCs without
+// labels, e.g. of older snapshots, are identified by the naming pattern of backend storage PVCs.
+func isBackendStorageVolume(vol *snapshotv1beta1.VolumeBackup, vmName string) bool {
+	if value, ok := vol.PersistentVolumeClaim.Labels[labelBackendStorage]; ok {
+		return value == vmName
+	}
+
+	prefix := backendStoragePrefix + vmName
+	return strings.HasPrefix(vol.PersistentVolumeClaim.Name, prefix) || strings.HasPrefix(vol.VolumeName, prefix)
+}
+
+// skipVolumeBackup returns true if the volume backup is the backend storage of the source VM
+// and it is not captured by the request.
+func skipVolumeBackup(tplReq *v1beta1.VirtualMachineTemplateRequest, backendStoragePVCName, volumeName string) bool {
+	return volumeName == backendStoragePVCName && !tplReq.Spec.CaptureBackendStorage
+}
+
 func (r *VirtualMachineTemplateRequestReconciler) setDataVolumeOwnerReferences(
 	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
 	tpl *v1beta1.VirtualMachineTemplate,
@@ -532,14 +1416,62 @@ func (r *VirtualMachineTemplateRequestReconciler) setDataVolumeOwnerReferences(
 		}
 
 		logf.FromContext(ctx).V(logs.DebugLevel).Info("Setting owner references of DataVolume", logDVNS, dv.Namespace, logDVName, dv.Name)
-		dvCopy := dv.DeepCopy()
-		if err := controllerutil.RemoveControllerReference(tplReq, &dv, r.Scheme); err != nil {
+		if err := r.moveControllerReference(ctx, tplReq, tpl, &dv); err != nil {
 			return err
 		}
-		if err := controllerutil.SetControllerReference(tpl, &dv, r.Scheme); err != nil {
+	}
+
+	return nil
+}
+
+func (r *VirtualMachineTemplateRequestReconciler) setDataSourceOwnerReferences(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
+	tpl *v1beta1.VirtualMachineTemplate,
+) error {
+	if tplReq.Spec.DataVolumeTemplateSource != v1beta1.DataVolumeTemplateSourceDataSource {
+		return nil
+	}
+
+	dataSources := cdiv1beta1.DataSourceList{}
+	if err := r.List(ctx, &dataSources, client.MatchingLabels{v1beta1.LabelRequestUID: string(tplReq.UID)}); err != nil {
+		return err
+	}
+
+	for _, ds := range dataSources.Items {
+		if metav1.IsControlledBy(&ds, tpl) {
+			continue
+		}
+
+		logf.FromContext(ctx).V(logs.DebugLevel).Info("Setting owner references of DataSource", logDSNS, ds.Namespace, logDSName, ds.Name)
+		if err := r.moveControllerReference(ctx, tplReq, tpl, &ds); err != nil {
 			return err
 		}
-		if err := r.Patch(ctx, &dv, client.MergeFrom(dvCopy)); err != nil {
+	}
+
+	return nil
+}
+
+func (r *VirtualMachineTemplateRequestReconciler) setVolumeSnapshotOwnerReferences(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
+	tpl *v1beta1.VirtualMachineTemplate,
+) error {
+	if tplReq.Spec.DiskStrategy != v1beta1.DiskStrategySnapshot {
+		return nil
+	}
+
+	volumeSnapshots := volumesnapshotv1.VolumeSnapshotList{}
+	if err := r.List(ctx, &volumeSnapshots, client.InNamespace(tplReq.Namespace),
+		client.MatchingLabels{v1beta1.LabelRequestUID: string(tplReq.UID)}); err != nil {
+		return err
+	}
+
+	for _, vs := range volumeSnapshots.Items {
+		if metav1.IsControlledBy(&vs, tpl) {
+			continue
+		}
+
+		logf.FromContext(ctx).V(logs.DebugLevel).Info("Setting owner references of VolumeSnapshot", logVSNS, vs.Namespace, logVSName, vs.Name)
+		if err := r.moveControllerReference(ctx, tplReq, tpl, &vs); err != nil {
 			return err
 		}
 	}
@@ -547,6 +1479,25 @@ func (r *VirtualMachineTemplateRequestReconciler) setDataVolumeOwnerReferences(
 	return nil
 }
 
+// moveControllerReference replaces the request with the template as controller of the object.
+func (r *VirtualMachineTemplateRequestReconciler) moveControllerReference(
+	ctx context.Context, tplReq *v1beta1.VirtualMachineTemplateRequest,
+	tpl *v1beta1.VirtualMachineTemplate, obj client.Object,
+) error {
+	objCopy, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("unexpected type %T", obj)
+	}
+	if err := controllerutil.RemoveControllerReference(tplReq, obj, r.Scheme); err != nil {
+		return err
+	}
+	if err := controllerutil.SetControllerReference(tpl, obj, r.Scheme); err != nil {
+		return err
+	}
+
+	return r.Patch(ctx, obj, client.MergeFrom(objCopy))
+}
+
 func shouldReconcile(tplReq *v1beta1.VirtualMachineTemplateRequest) bool {
 	progressing := meta.FindStatusCondition(tplReq.Status.Conditions, v1beta1.ConditionProgressing)
 
@@ -556,12 +1507,25 @@ func shouldReconcile(tplReq *v1beta1.VirtualMachineTemplateRequest) bool {
 }
 
 func validateRequest(tplReq *v1beta1.VirtualMachineTemplateRequest) error {
+	if ref := tplReq.Spec.VirtualMachineTemplateRef; ref != nil {
+		if ref.Namespace == "" {
+			return errors.New("virtualMachineTemplateRef.namespace cannot be empty")
+		}
+		if ref.Name == "" {
+			return errors.New("virtualMachineTemplateRef.name cannot be empty")
+		}
+		return nil
+	}
 	if tplReq.Spec.VirtualMachineRef.Namespace == "" {
 		return errors.New("virtualMachineRef.namespace cannot be empty")
 	}
 	if tplReq.Spec.VirtualMachineRef.Name == "" {
 		return errors.New("virtualMachineRef.name cannot be empty")
 	}
+	// Owner references cannot cross namespaces, so the template can only own VolumeSnapshots in its namespace
+	if tplReq.Spec.DiskStrategy == v1beta1.DiskStrategySnapshot && tplReq.Spec.VirtualMachineRef.Namespace != tplReq.Namespace {
+		return errors.New("diskStrategy Snapshot requires the VirtualMachine to be in the namespace of the request")
+	}
 
 	return nil
 }
@@ -762,7 +1726,8 @@ func isSnapshotContentReady(snapContent *snapshotv1beta1.VirtualMachineSnapshotC
 func newSnapshot(tplReq *v1beta1.VirtualMachineTemplateRequest) *snapshotv1beta1.VirtualMachineSnapshot {
 	snap := emptySnapshot(tplReq)
 	snap.ObjectMeta.Labels = map[string]string{
-		v1beta1.LabelRequestUID: string(tplReq.UID),
+		v1beta1.LabelRequestUID:     string(tplReq.UID),
+		v1beta1.LabelSnapshotSource: "",
 	}
 	snap.Spec = snapshotv1beta1.VirtualMachineSnapshotSpec{
 		Source: corev1.TypedLocalObjectReference{
@@ -776,15 +1741,21 @@ func newSnapshot(tplReq *v1beta1.VirtualMachineTemplateRequest) *snapshotv1beta1
 }
 
 func emptySnapshot(tplReq *v1beta1.VirtualMachineTemplateRequest) *snapshotv1beta1.VirtualMachineSnapshot {
+	name := tplReq.Spec.VirtualMachineSnapshotName
+	if name == "" {
+		name = apimachinery.GetStableName(tplReq.Name, string(tplReq.UID))
+	}
 	return &snapshotv1beta1.VirtualMachineSnapshot{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: tplReq.Spec.VirtualMachineRef.Namespace,
-			Name:      apimachinery.GetStableName(tplReq.Name, string(tplReq.UID)),
+			Name:      name,
 		},
 	}
 }
 
-func newDv(dvNamespace, dvName, tplReqUID, snapNamespace, snapName string) *cdiv1beta1.DataVolume {
+func newDv(
+	dvNamespace, dvName, tplReqUID string, source *cdiv1beta1.DataVolumeSource, storage *cdiv1beta1.StorageSpec,
+) *cdiv1beta1.DataVolume {
 	dv := emptyDv(dvNamespace, dvName)
 	dv.Annotations = map[string]string{
 		annImmediateBinding: "",
@@ -793,16 +1764,161 @@ func newDv(dvNamespace, dvName, tplReqUID, snapNamespace, snapName string) *cdiv
 		v1beta1.LabelRequestUID: tplReqUID,
 	}
 	dv.Spec = cdiv1beta1.DataVolumeSpec{
-		Source: &cdiv1beta1.DataVolumeSource{
-			Snapshot: &cdiv1beta1.DataVolumeSourceSnapshot{
-				Namespace: snapNamespace,
-				Name:      snapName,
+		Source:  source,
+		Storage: storage,
+	}
+
+	return dv
+}
+
+// getStorageSpec returns the storage of the DataVolume or DataVolumeTemplate cloned from a volume of
+// the VM, with the storage overrides of the request applied.
+func getStorageSpec(tplReq *v1beta1.VirtualMachineTemplateRequest, volName string) *cdiv1beta1.StorageSpec {
+	storage := &cdiv1beta1.StorageSpec{}
+	overrides := tplReq.Spec.Storage
+	if overrides == nil {
+		return storage
+	}
+
+	applyStorageOverride(storage, &overrides.StorageOverride)
+	for i := range overrides.Volumes {
+		if overrides.Volumes[i].Name == volName {
+			applyStorageOverride(storage, &overrides.Volumes[i].StorageOverride)
+		}
+	}
+
+	return storage
+}
+
+func applyStorageOverride(storage *cdiv1beta1.StorageSpec, override *v1beta1.StorageOverride) {
+	if override.StorageClassName != nil {
+		storage.StorageClassName = ptr.To(*override.StorageClassName)
+	}
+	if len(override.AccessModes) > 0 {
+		storage.AccessModes = slices.Clone(override.AccessModes)
+	}
+	if override.VolumeMode != nil {
+		storage.VolumeMode = ptr.To(*override.VolumeMode)
+	}
+	if override.Size != nil {
+		storage.Resources.Requests = corev1.ResourceList{
+			corev1.ResourceStorage: override.Size.DeepCopy(),
+		}
+	}
+}
+
+func newGeneralizationJob(tplReq *v1beta1.VirtualMachineTemplateRequest, volumes []snapshotv1beta1.VolumeBackup) *batchv1.Job {
+	generalization := tplReq.Spec.Generalization
+	container := corev1.Container{
+		Name:    generalizationContainerName,
+		Image:   generalization.Image,
+		Command: generalization.Command,
+		Args:    generalization.Args,
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
 			},
 		},
-		Storage: &cdiv1beta1.StorageSpec{},
 	}
 
-	return dv
+	var podVolumes []corev1.Volume
+	var disks []string
+	for i, vol := range volumes {
+		podVolume := corev1.Volume{
+			Name: fmt.Sprintf("disk-%d", i),
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: getDvName(tplReq, vol.VolumeName),
+				},
+			},
+		}
+		podVolumes = append(podVolumes, podVolume)
+
+		volumeMode := getStorageSpec(tplReq, vol.VolumeName).VolumeMode
+		if volumeMode == nil {
+			volumeMode = vol.PersistentVolumeClaim.Spec.VolumeMode
+		}
+		if ptr.Deref(volumeMode, corev1.PersistentVolumeFilesystem) == corev1.PersistentVolumeBlock {
+			devicePath := path.Join(generalizationDevicesPath, vol.VolumeName)
+			container.VolumeDevices = append(container.VolumeDevices, corev1.VolumeDevice{
+				Name:       podVolume.Name,
+				DevicePath: devicePath,
+			})
+			disks = append(disks, devicePath)
+		} else {
+			mountPath := path.Join(generalizationDisksPath, vol.VolumeName)
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      podVolume.Name,
+				MountPath: mountPath,
+			})
+			disks = append(disks, path.Join(mountPath, "disk.img"))
+		}
+	}
+	container.Env = []corev1.EnvVar{{Name: envGeneralizationDisks, Value: strings.Join(disks, " ")}}
+
+	job := emptyGeneralizationJob(tplReq)
+	job.Labels = map[string]string{
+		v1beta1.LabelRequestUID: string(tplReq.UID),
+	}
+	job.Spec = batchv1.JobSpec{
+		ActiveDeadlineSeconds: generalization.ActiveDeadlineSeconds,
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					v1beta1.LabelRequestUID: string(tplReq.UID),
+				},
+			},
+			Spec: corev1.PodSpec{
+				RestartPolicy: corev1.RestartPolicyNever,
+				// The image is chosen by the requester, so it gets no API access and no privileges
+				AutomountServiceAccountToken: ptr.To(false),
+				SecurityContext: &corev1.PodSecurityContext{
+					RunAsNonRoot: ptr.To(true),
+					RunAsUser:    ptr.To[int64](generalizationUser),
+					RunAsGroup:   ptr.To[int64](generalizationUser),
+					FSGroup:      ptr.To[int64](generalizationUser),
+					SeccompProfile: &corev1.SeccompProfile{
+						Type: corev1.SeccompProfileTypeRuntimeDefault,
+					},
+				},
+				Containers: []corev1.Container{container},
+				Volumes:    podVolumes,
+			},
+		},
+	}
+
+	return job
+}
+
+func newDataSource(namespace, name, tplReqUID string, source cdiv1beta1.DataSourceSource) *cdiv1beta1.DataSource {
+	ds := emptyDataSource(namespace, name)
+	ds.Labels = map[string]string{
+		v1beta1.LabelRequestUID: tplReqUID,
+	}
+	ds.Spec = cdiv1beta1.DataSourceSpec{
+		Source: source,
+	}
+
+	return ds
+}
+
+func emptyDataSource(namespace, name string) *cdiv1beta1.DataSource {
+	return &cdiv1beta1.DataSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+}
+
+func emptyGeneralizationJob(tplReq *v1beta1.VirtualMachineTemplateRequest) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      apimachinery.GetStableName(tplReq.Name, string(tplReq.UID), generalizationContainerName),
+			Namespace: tplReq.Namespace,
+		},
+	}
 }
 
 func emptyDv(namespace, name string) *cdiv1beta1.DataVolume {
@@ -816,15 +1932,7 @@ func emptyDv(namespace, name string) *cdiv1beta1.DataVolume {
 
 func newTemplate(tplReq *v1beta1.VirtualMachineTemplateRequest, vmSpec *virtv1.VirtualMachineSpec) *v1beta1.VirtualMachineTemplate {
 	tpl := emptyTemplate(tplReq)
-	tpl.Labels = make(map[string]string)
-	// Copy user-provided labels, filtering out reserved system labels
-	for k, v := range tplReq.Spec.TemplateLabels {
-		if !strings.HasPrefix(k, templateapi.GroupName+"/") {
-			tpl.Labels[k] = v
-		}
-	}
-	// Set system labels
-	tpl.Labels[v1beta1.LabelRequestUID] = string(tplReq.UID)
+	tpl.Labels = templateLabels(tplReq, tplReq.Spec.TemplateLabels)
 	tpl.Spec = v1beta1.VirtualMachineTemplateSpec{
 		VirtualMachine: &runtime.RawExtension{
 			Object: &virtv1.VirtualMachine{
@@ -847,6 +1955,23 @@ func newTemplate(tplReq *v1beta1.VirtualMachineTemplateRequest, vmSpec *virtv1.V
 	return tpl
 }
 
+// templateLabels returns the labels of the template created by the request. Reserved system labels are
+// filtered out of the given labels.
+func templateLabels(tplReq *v1beta1.VirtualMachineTemplateRequest, labelSets ...map[string]string) map[string]string {
+	labels := make(map[string]string)
+	for _, labelSet := range labelSets {
+		for k, v := range labelSet {
+			if !strings.HasPrefix(k, templateapi.GroupName+"/") {
+				labels[k] = v
+			}
+		}
+	}
+	// Set system labels
+	labels[v1beta1.LabelRequestUID] = string(tplReq.UID)
+
+	return labels
+}
+
 func emptyTemplate(tplReq *v1beta1.VirtualMachineTemplateRequest) *v1beta1.VirtualMachineTemplate {
 	return &v1beta1.VirtualMachineTemplate{
 		ObjectMeta: metav1.ObjectMeta{
@@ -886,22 +2011,16 @@ func transformVolume(ctx context.Context, volumes *[]virtv1.Volume, volName stri
 	return ""
 }
 
-func transformOrAddDVT(ctx context.Context, dvts *[]virtv1.DataVolumeTemplateSpec, volName, pvcNamespace, pvcName string) {
+func transformOrAddDVT(
+	ctx context.Context, dvts *[]virtv1.DataVolumeTemplateSpec, volName string, spec cdiv1beta1.DataVolumeSpec,
+) {
 	log := logf.FromContext(ctx)
 
 	dvtSpec := virtv1.DataVolumeTemplateSpec{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: volName + paramNameSuffix,
 		},
-		Spec: cdiv1beta1.DataVolumeSpec{
-			Source: &cdiv1beta1.DataVolumeSource{
-				PVC: &cdiv1beta1.DataVolumeSourcePVC{
-					Namespace: pvcNamespace,
-					Name:      pvcName,
-				},
-			},
-			Storage: &cdiv1beta1.StorageSpec{},
-		},
+		Spec: spec,
 	}
 
 	for i := range *dvts {
@@ -917,6 +2036,21 @@ func transformOrAddDVT(ctx context.Context, dvts *[]virtv1.DataVolumeTemplateSpe
 	*dvts = append(*dvts, dvtSpec)
 }
 
+// addBackendStorageDVT adds a DataVolumeTemplate cloning the captured backend storage PVC. The PVC
+// created from it is labeled like a backend storage PVC, so that KubeVirt adopts it for the VM.
+func addBackendStorageDVT(ctx context.Context, dvts *[]virtv1.DataVolumeTemplateSpec, spec cdiv1beta1.DataVolumeSpec) {
+	logf.FromContext(ctx).V(logs.TraceLevel).Info("Adding backend storage DataVolumeTemplate", logDVTName, backendStoragePrefix+paramName)
+	*dvts = append(*dvts, virtv1.DataVolumeTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: backendStoragePrefix + paramName,
+			Labels: map[string]string{
+				labelBackendStorage: paramName,
+			},
+		},
+		Spec: spec,
+	})
+}
+
 func getTemplateName(tplReq *v1beta1.VirtualMachineTemplateRequest) string {
 	name := tplReq.Name
 	if tplReq.Spec.TemplateName != "" {
@@ -929,19 +2063,160 @@ func getDvName(tplReq *v1beta1.VirtualMachineTemplateRequest, volumeName string)
 	return apimachinery.GetStableName(getTemplateName(tplReq), string(tplReq.UID), volumeName)
 }
 
-func stripUniqueIdentifiers(vmSpec *virtv1.VirtualMachineSpec) {
-	if vmSpec.Template == nil {
-		return
+// sanitizationRules returns the sanitization rules to apply for the request. The rules of the
+// profile of the request, or of the default profile of the cluster, are extended with the rules
+// to strip and reduced by the rules to keep.
+func (r *VirtualMachineTemplateRequestReconciler) sanitizationRules(
+	tplReq *v1beta1.VirtualMachineTemplateRequest,
+) []v1beta1.SanitizationRule {
+	profile := r.DefaultSanitizationProfile
+	if profile == "" {
+		profile = v1beta1.SanitizationProfileMinimal
+	}
+
+	sanitization := tplReq.Spec.Sanitization
+	if sanitization == nil {
+		return sanitizationProfiles[profile]
+	}
+	if sanitization.Profile != "" {
+		profile = sanitization.Profile
+	}
+
+	var rules []v1beta1.SanitizationRule
+	for _, rule := range sanitizationRuleOrder {
+		if (slices.Contains(sanitizationProfiles[profile], rule) || slices.Contains(sanitization.Strip, rule)) &&
+			!slices.Contains(sanitization.Keep, rule) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// sanitize applies the sanitization rules to the VirtualMachineInstance template of a VM and
+// returns the paths of the removed fields.
+func sanitize(rules []v1beta1.SanitizationRule, tpl *virtv1.VirtualMachineInstanceTemplateSpec) []string {
+	fldPath := field.NewPath("spec", "template")
+
+	var removed []string
+	for _, rule := range rules {
+		for _, path := range sanitizers[rule](tpl, fldPath) {
+			removed = append(removed, path.String())
+		}
+	}
+	return removed
+}
+
+var sanitizationRuleOrder = []v1beta1.SanitizationRule{
+	v1beta1.SanitizationRuleMACAddresses,
+	v1beta1.SanitizationRuleFirmwareIdentifiers,
+	v1beta1.SanitizationRuleHostname,
+	v1beta1.SanitizationRuleLabels,
+	v1beta1.SanitizationRuleAnnotations,
+	v1beta1.SanitizationRuleNodeAffinity,
+	v1beta1.SanitizationRuleAccessCredentials,
+	v1beta1.SanitizationRuleCloudInit,
+}
+
+var sanitizationProfiles = map[v1beta1.SanitizationProfile][]v1beta1.SanitizationRule{
+	v1beta1.SanitizationProfileMinimal: {
+		v1beta1.SanitizationRuleMACAddresses,
+		v1beta1.SanitizationRuleFirmwareIdentifiers,
+	},
+	v1beta1.SanitizationProfileDefault: {
+		v1beta1.SanitizationRuleMACAddresses,
+		v1beta1.SanitizationRuleFirmwareIdentifiers,
+		v1beta1.SanitizationRuleHostname,
+		v1beta1.SanitizationRuleLabels,
+		v1beta1.SanitizationRuleAnnotations,
+		v1beta1.SanitizationRuleNodeAffinity,
+	},
+	v1beta1.SanitizationProfileAggressive: sanitizationRuleOrder,
+}
+
+// sanitizers remove the fields selected by a rule and return the paths of the removed fields.
+var sanitizers = map[v1beta1.SanitizationRule]func(*virtv1.VirtualMachineInstanceTemplateSpec, *field.Path) []*field.Path{
+	v1beta1.SanitizationRuleMACAddresses:        sanitizeMACAddresses,
+	v1beta1.SanitizationRuleFirmwareIdentifiers: sanitizeFirmwareIdentifiers,
+	v1beta1.SanitizationRuleHostname:            sanitizeHostname,
+	v1beta1.SanitizationRuleLabels: func(tpl *virtv1.VirtualMachineInstanceTemplateSpec, fldPath *field.Path) []*field.Path {
+		return clearField(&tpl.ObjectMeta.Labels, fldPath.Child("metadata", "labels"))
+	},
+	v1beta1.SanitizationRuleAnnotations: func(tpl *virtv1.VirtualMachineInstanceTemplateSpec, fldPath *field.Path) []*field.Path {
+		return clearField(&tpl.ObjectMeta.Annotations, fldPath.Child("metadata", "annotations"))
+	},
+	v1beta1.SanitizationRuleNodeAffinity: sanitizeNodeAffinity,
+	v1beta1.SanitizationRuleAccessCredentials: func(tpl *virtv1.VirtualMachineInstanceTemplateSpec, fldPath *field.Path) []*field.Path {
+		return clearField(&tpl.Spec.AccessCredentials, fldPath.Child("spec", "accessCredentials"))
+	},
+	v1beta1.SanitizationRuleCloudInit: sanitizeCloudInit,
+}
+
+func sanitizeMACAddresses(tpl *virtv1.VirtualMachineInstanceTemplateSpec, fldPath *field.Path) []*field.Path {
+	var removed []*field.Path
+	for i := range tpl.Spec.Domain.Devices.Interfaces {
+		removed = append(removed, clearField(&tpl.Spec.Domain.Devices.Interfaces[i].MacAddress,
+			fldPath.Child("spec", "domain", "devices", "interfaces").Index(i).Child("macAddress"))...)
 	}
+	return removed
+}
+
+func sanitizeFirmwareIdentifiers(tpl *virtv1.VirtualMachineInstanceTemplateSpec, fldPath *field.Path) []*field.Path {
+	firmware := tpl.Spec.Domain.Firmware
+	if firmware == nil {
+		return nil
+	}
+
+	fldPath = fldPath.Child("spec", "domain", "firmware")
+	return append(clearField(&firmware.Serial, fldPath.Child("serial")),
+		clearField(&firmware.UUID, fldPath.Child("uuid"))...)
+}
+
+func sanitizeHostname(tpl *virtv1.VirtualMachineInstanceTemplateSpec, fldPath *field.Path) []*field.Path {
+	return append(clearField(&tpl.Spec.Hostname, fldPath.Child("spec", "hostname")),
+		clearField(&tpl.Spec.Subdomain, fldPath.Child("spec", "subdomain"))...)
+}
 
-	for i := range vmSpec.Template.Spec.Domain.Devices.Interfaces {
-		vmSpec.Template.Spec.Domain.Devices.Interfaces[i].MacAddress = ""
+func sanitizeNodeAffinity(tpl *virtv1.VirtualMachineInstanceTemplateSpec, fldPath *field.Path) []*field.Path {
+	removed := clearField(&tpl.Spec.NodeSelector, fldPath.Child("spec", "nodeSelector"))
+	if tpl.Spec.Affinity == nil {
+		return removed
 	}
 
-	if vmSpec.Template.Spec.Domain.Firmware != nil {
-		vmSpec.Template.Spec.Domain.Firmware.Serial = ""
-		vmSpec.Template.Spec.Domain.Firmware.UUID = ""
+	removed = append(removed, clearField(&tpl.Spec.Affinity.NodeAffinity, fldPath.Child("spec", "affinity", "nodeAffinity"))...)
+	if reflect.ValueOf(*tpl.Spec.Affinity).IsZero() {
+		tpl.Spec.Affinity = nil
+	}
+	return removed
+}
+
+// sanitizeCloudInit removes the cloud-init volumes and the disks using them.
+func sanitizeCloudInit(tpl *virtv1.VirtualMachineInstanceTemplateSpec, fldPath *field.Path) []*field.Path {
+	var removed []*field.Path
+	tpl.Spec.Volumes = slices.DeleteFunc(tpl.Spec.Volumes, func(vol virtv1.Volume) bool {
+		if vol.CloudInitNoCloud == nil && vol.CloudInitConfigDrive == nil {
+			return false
+		}
+		tpl.Spec.Domain.Devices.Disks = slices.DeleteFunc(tpl.Spec.Domain.Devices.Disks, func(disk virtv1.Disk) bool {
+			return disk.Name == vol.Name
+		})
+		removed = append(removed,
+			fldPath.Child("spec", "volumes").Key(vol.Name),
+			fldPath.Child("spec", "domain", "devices", "disks").Key(vol.Name),
+		)
+		return true
+	})
+	return removed
+}
+
+// clearField sets a field to its zero value and returns its path if it was set before.
+func clearField[T any](value *T, fldPath *field.Path) []*field.Path {
+	if reflect.ValueOf(value).Elem().IsZero() {
+		return nil
 	}
+
+	var zero T
+	*value = zero
+	return []*field.Path{fldPath}
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -956,16 +2231,53 @@ func (r *VirtualMachineTemplateRequestReconciler) SetupWithManager(mgr ctrl.Mana
 	if err != nil {
 		return err
 	}
+	// Add indexer required for EnqueueRequestsBySnapshot
+	err = mgr.GetFieldIndexer().IndexField(
+		context.Background(), &v1beta1.VirtualMachineTemplateRequest{}, snapshotField,
+		func(obj client.Object) []string {
+			tplReq, ok := obj.(*v1beta1.VirtualMachineTemplateRequest)
+			if !ok || tplReq.Spec.VirtualMachineSnapshotName == "" {
+				return nil
+			}
+			return []string{tplReq.Spec.VirtualMachineRef.Namespace + "/" + tplReq.Spec.VirtualMachineSnapshotName}
+		},
+	)
+	if err != nil {
+		return err
+	}
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1beta1.VirtualMachineTemplateRequest{}).
 		Named(templateapi.SingularRequestResourceName).
 		Watches(&v1beta1.VirtualMachineTemplate{}, handler.EnqueueRequestsFromMapFunc(r.EnqueueRequestByUID)).
-		Watches(&snapshotv1beta1.VirtualMachineSnapshot{}, handler.EnqueueRequestsFromMapFunc(r.EnqueueRequestByUID)).
+		Watches(&snapshotv1beta1.VirtualMachineSnapshot{}, handler.EnqueueRequestsFromMapFunc(r.EnqueueRequestsBySnapshot)).
 		Owns(&cdiv1beta1.DataVolume{}).
+		Owns(&batchv1.Job{}).
 		Complete(r)
 }
 
+// EnqueueRequestsBySnapshot enqueues the VirtualMachineTemplateRequest that created a VirtualMachineSnapshot
+// and all VirtualMachineTemplateRequests referencing it as an existing snapshot.
+func (r *VirtualMachineTemplateRequestReconciler) EnqueueRequestsBySnapshot(ctx context.Context, obj client.Object) []reconcile.Request {
+	requests := r.EnqueueRequestByUID(ctx, obj)
+
+	list := &v1beta1.VirtualMachineTemplateRequestList{}
+	if err := r.List(ctx, list, client.MatchingFields{snapshotField: obj.GetNamespace() + "/" + obj.GetName()}); err != nil {
+		logf.FromContext(ctx).Error(err, "Unable to list VirtualMachineTemplateRequests")
+		return requests
+	}
+	for i := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: list.Items[i].Namespace,
+				Name:      list.Items[i].Name,
+			},
+		})
+	}
+
+	return requests
+}
+
 func (r *VirtualMachineTemplateRequestReconciler) EnqueueRequestByUID(ctx context.Context, obj client.Object) []reconcile.Request {
 	log := logf.FromContext(ctx)
 
@@ -25,10 +25,14 @@ import (
 	"strings"
 	"time"
 
+	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/discovery"
+	virtv1 "kubevirt.io/api/core/v1"
 	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
@@ -45,6 +49,7 @@ const (
 
 	cdiGroupVersion      = "cdi.kubevirt.io/v1beta1"
 	snapshotGroupVersion = "snapshot.kubevirt.io/v1beta1"
+	kubevirtGroupVersion = "kubevirt.io/v1"
 )
 
 var requiredGroups = []string{
@@ -61,6 +66,9 @@ type VMTRAvailabilityController struct {
 	VirtClient      kubecli.KubevirtClient
 	DiscoveryClient discovery.DiscoveryInterface
 
+	// DefaultSanitizationProfile is passed to the VirtualMachineTemplateRequest controller.
+	DefaultSanitizationProfile v1beta1.SanitizationProfile
+
 	pollInterval time.Duration
 }
 
@@ -105,9 +113,11 @@ func (c *VMTRAvailabilityController) Start(ctx context.Context) error {
 func (c *VMTRAvailabilityController) startController(ctx context.Context) error {
 	logf.FromContext(ctx).Info("All required CRDs are available, starting controller")
 	return (&VirtualMachineTemplateRequestReconciler{
-		Client:     c.Manager.GetClient(),
-		VirtClient: c.VirtClient,
-		Scheme:     c.Manager.GetScheme(),
+		Client:                     c.Manager.GetClient(),
+		APIReader:                  c.Manager.GetAPIReader(),
+		VirtClient:                 c.VirtClient,
+		Scheme:                     c.Manager.GetScheme(),
+		DefaultSanitizationProfile: c.DefaultSanitizationProfile,
 	}).SetupWithManager(c.Manager)
 }
 
@@ -155,20 +165,37 @@ func isAPIGroupAvailable(dc discovery.DiscoveryInterface, groupVersion string) b
 	return err == nil
 }
 
-func ExternalCRDCacheConfig(dc discovery.DiscoveryInterface) (map[client.Object]cache.ByObject, []client.Object) {
+// IsKubeVirtAvailable returns true if the kubevirt.io/v1 API serving VirtualMachines is available.
+func IsKubeVirtAvailable(dc discovery.DiscoveryInterface) bool {
+	return isAPIGroupAvailable(dc, kubevirtGroupVersion)
+}
+
+func ExternalCRDCacheConfig(dc discovery.DiscoveryInterface) map[client.Object]cache.ByObject {
 	uidReq, _ := labels.NewRequirement(v1beta1.LabelRequestUID, selection.Exists, nil)
 	uidSelector := labels.NewSelector().Add(*uidReq)
+	tplUIDReq, _ := labels.NewRequirement(v1beta1.LabelTemplateUID, selection.Exists, nil)
+	tplUIDSelector := labels.NewSelector().Add(*tplUIDReq)
+	snapshotSourceReq, _ := labels.NewRequirement(v1beta1.LabelSnapshotSource, selection.Exists, nil)
+	snapshotSourceSelector := labels.NewSelector().Add(*snapshotSourceReq)
+
+	cacheByObject := map[client.Object]cache.ByObject{
+		&appsv1.ControllerRevision{}: {Label: tplUIDSelector},
+		&batchv1.Job{}:               {Label: uidSelector},
+	}
 
-	cacheByObject := map[client.Object]cache.ByObject{}
-	var clientDisableFor []client.Object
-
+	if IsKubeVirtAvailable(dc) {
+		cacheByObject[&virtv1.VirtualMachine{}] = cache.ByObject{Label: tplUIDSelector}
+	}
 	if isAPIGroupAvailable(dc, cdiGroupVersion) {
 		cacheByObject[&cdiv1beta1.DataVolume{}] = cache.ByObject{Label: uidSelector}
+		cacheByObject[&cdiv1beta1.DataSource{}] = cache.ByObject{Label: uidSelector}
 	}
 	if isAPIGroupAvailable(dc, snapshotGroupVersion) {
-		cacheByObject[&snapshotv1beta1.VirtualMachineSnapshot{}] = cache.ByObject{Label: uidSelector}
-		clientDisableFor = append(clientDisableFor, &snapshotv1beta1.VirtualMachineSnapshotContent{})
+		// Snapshots are labeled by the requests creating templates from them
+		cacheByObject[&snapshotv1beta1.VirtualMachineSnapshot{}] = cache.ByObject{Label: snapshotSourceSelector}
+		cacheByObject[&snapshotv1beta1.VirtualMachineSnapshotContent{}] = cache.ByObject{Label: snapshotSourceSelector}
+		cacheByObject[&volumesnapshotv1.VolumeSnapshot{}] = cache.ByObject{Label: uidSelector}
 	}
 
-	return cacheByObject, clientDisableFor
+	return cacheByObject
 }
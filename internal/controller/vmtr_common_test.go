@@ -26,6 +26,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -33,8 +34,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
+	kvinstancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/virt-template-api/core/v1beta1"
@@ -60,7 +63,9 @@ const (
 
 type fakeKubevirtClient struct {
 	kubecli.KubevirtClient
-	err error
+	err           error
+	instancetypes map[string]*instancetypev1beta1.VirtualMachineInstancetype
+	preferences   map[string]*instancetypev1beta1.VirtualMachinePreference
 }
 
 type fakeExpandSpecInterface struct {
@@ -79,7 +84,84 @@ func (f *fakeExpandSpecInterface) ForVirtualMachine(vm *virtv1.VirtualMachine) (
 	return vm, nil
 }
 
-func createRequest(cli client.Client, testNamespace, testVMNamespace string) *v1beta1.VirtualMachineTemplateRequest {
+func (f *fakeKubevirtClient) VirtualMachineInstancetype(namespace string) kvinstancetypev1beta1.VirtualMachineInstancetypeInterface {
+	if f.instancetypes == nil {
+		f.instancetypes = map[string]*instancetypev1beta1.VirtualMachineInstancetype{}
+	}
+	return &fakeInstancetypeInterface{namespace: namespace, instancetypes: f.instancetypes}
+}
+
+func (f *fakeKubevirtClient) VirtualMachinePreference(namespace string) kvinstancetypev1beta1.VirtualMachinePreferenceInterface {
+	if f.preferences == nil {
+		f.preferences = map[string]*instancetypev1beta1.VirtualMachinePreference{}
+	}
+	return &fakePreferenceInterface{namespace: namespace, preferences: f.preferences}
+}
+
+type fakeInstancetypeInterface struct {
+	kvinstancetypev1beta1.VirtualMachineInstancetypeInterface
+	namespace     string
+	instancetypes map[string]*instancetypev1beta1.VirtualMachineInstancetype
+}
+
+func (f *fakeInstancetypeInterface) Get(
+	_ context.Context, name string, _ metav1.GetOptions,
+) (*instancetypev1beta1.VirtualMachineInstancetype, error) {
+	instancetype, ok := f.instancetypes[f.namespace+"/"+name]
+	if !ok {
+		return nil, k8serrors.NewNotFound(instancetypev1beta1.SchemeGroupVersion.WithResource("virtualmachineinstancetypes").GroupResource(), name)
+	}
+	return instancetype.DeepCopy(), nil
+}
+
+func (f *fakeInstancetypeInterface) Create(
+	_ context.Context, instancetype *instancetypev1beta1.VirtualMachineInstancetype, _ metav1.CreateOptions,
+) (*instancetypev1beta1.VirtualMachineInstancetype, error) {
+	key := instancetype.Namespace + "/" + instancetype.Name
+	if _, ok := f.instancetypes[key]; ok {
+		return nil, k8serrors.NewAlreadyExists(
+			instancetypev1beta1.SchemeGroupVersion.WithResource("virtualmachineinstancetypes").GroupResource(), instancetype.Name,
+		)
+	}
+	f.instancetypes[key] = instancetype
+	return instancetype, nil
+}
+
+type fakePreferenceInterface struct {
+	kvinstancetypev1beta1.VirtualMachinePreferenceInterface
+	namespace   string
+	preferences map[string]*instancetypev1beta1.VirtualMachinePreference
+}
+
+func (f *fakePreferenceInterface) Get(
+	_ context.Context, name string, _ metav1.GetOptions,
+) (*instancetypev1beta1.VirtualMachinePreference, error) {
+	preference, ok := f.preferences[f.namespace+"/"+name]
+	if !ok {
+		return nil, k8serrors.NewNotFound(instancetypev1beta1.SchemeGroupVersion.WithResource("virtualmachinepreferences").GroupResource(), name)
+	}
+	return preference.DeepCopy(), nil
+}
+
+func (f *fakePreferenceInterface) Create(
+	_ context.Context, preference *instancetypev1beta1.VirtualMachinePreference, _ metav1.CreateOptions,
+) (*instancetypev1beta1.VirtualMachinePreference, error) {
+	key := preference.Namespace + "/" + preference.Name
+	if _, ok := f.preferences[key]; ok {
+		return nil, k8serrors.NewAlreadyExists(
+			instancetypev1beta1.SchemeGroupVersion.WithResource("virtualmachinepreferences").GroupResource(), preference.Name,
+		)
+	}
+	f.preferences[key] = preference
+	return preference, nil
+}
+
+// createRequest creates a request for the test VM. The spec of a request is immutable, so optional
+// settings are applied by specFns before the request is created.
+func createRequest(
+	cli client.Client, testNamespace, testVMNamespace string,
+	specFns ...func(*v1beta1.VirtualMachineTemplateRequestSpec),
+) *v1beta1.VirtualMachineTemplateRequest {
 	tplReq := &v1beta1.VirtualMachineTemplateRequest{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: testRequestPrefix,
@@ -92,6 +174,9 @@ func createRequest(cli client.Client, testNamespace, testVMNamespace string) *v1
 			},
 		},
 	}
+	for _, specFn := range specFns {
+		specFn(&tplReq.Spec)
+	}
 	ExpectWithOffset(1, cli.Create(context.Background(), tplReq)).To(Succeed())
 	return tplReq
 }
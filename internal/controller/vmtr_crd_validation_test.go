@@ -99,6 +99,68 @@ var _ = Describe("VirtualMachineTemplateRequest controller CRD validation", func
 			Expect(err).To(MatchError(ContainSubstring("reserved for system use")))
 		})
 
+		It("should reject copying instance types without the policy Keep", func() {
+			tplReq := &v1beta1.VirtualMachineTemplateRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-expand-copy",
+					Namespace: testNamespace,
+				},
+				Spec: v1beta1.VirtualMachineTemplateRequestSpec{
+					VirtualMachineRef: v1beta1.VirtualMachineReference{
+						Namespace: testVMNamespace,
+						Name:      testVMName,
+					},
+					Instancetypes: &v1beta1.InstancetypeCapture{
+						Policy: v1beta1.InstancetypeCapturePolicyExpand,
+						Copy:   true,
+					},
+				},
+			}
+			err := k8sClient.Create(context.Background(), tplReq)
+			Expect(err).To(MatchError(ContainSubstring("copy and parameterize require the policy Keep")))
+		})
+
+		It("should reject both a VirtualMachine and a VirtualMachineTemplate source", func() {
+			tplReq := &v1beta1.VirtualMachineTemplateRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-both-sources",
+					Namespace: testNamespace,
+				},
+				Spec: v1beta1.VirtualMachineTemplateRequestSpec{
+					VirtualMachineRef: v1beta1.VirtualMachineReference{
+						Namespace: testVMNamespace,
+						Name:      testVMName,
+					},
+					VirtualMachineTemplateRef: &v1beta1.VirtualMachineTemplateReference{
+						Namespace: testVMNamespace,
+						Name:      "source",
+					},
+				},
+			}
+			err := k8sClient.Create(context.Background(), tplReq)
+			Expect(err).To(MatchError(ContainSubstring("exactly one of virtualMachineRef and virtualMachineTemplateRef")))
+		})
+
+		It("should reject generalization with a VirtualMachineTemplate source", func() {
+			tplReq := &v1beta1.VirtualMachineTemplateRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-template-source-generalization",
+					Namespace: testNamespace,
+				},
+				Spec: v1beta1.VirtualMachineTemplateRequestSpec{
+					VirtualMachineTemplateRef: &v1beta1.VirtualMachineTemplateReference{
+						Namespace: testVMNamespace,
+						Name:      "source",
+					},
+					Generalization: &v1beta1.Generalization{
+						Image: "quay.io/example/virt-sysprep:latest",
+					},
+				},
+			}
+			err := k8sClient.Create(context.Background(), tplReq)
+			Expect(err).To(MatchError(ContainSubstring("virtualMachineTemplateRef only supports")))
+		})
+
 		It("should accept templateLabels without reserved prefix", func() {
 			tplReq := &v1beta1.VirtualMachineTemplateRequest{
 				ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,113 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controller_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/controller"
+)
+
+var _ = Describe("VirtualMachineTemplateRequest Controller DataSource handling", func() {
+	var (
+		reconciler *controller.VirtualMachineTemplateRequestReconciler
+		tplReq     *v1beta1.VirtualMachineTemplateRequest
+		dv         *cdiv1beta1.DataVolume
+	)
+
+	BeforeEach(func() {
+		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
+			Client:     k8sClient,
+			APIReader:  k8sClient,
+			VirtClient: &fakeKubevirtClient{},
+			Scheme:     k8sClient.Scheme(),
+		}
+
+		tplReq = createRequest(k8sClient, testNamespace, testVMNamespace, func(spec *v1beta1.VirtualMachineTemplateRequestSpec) {
+			spec.DataVolumeTemplateSource = v1beta1.DataVolumeTemplateSourceDataSource
+		})
+		snap := createSnapshot(k8sClient, tplReq)
+		snap = setSnapshotStatus(k8sClient, snap, withPhase(snapshotv1beta1.Succeeded), withReady())
+		snapContent := createSnapshotContent(k8sClient, snap)
+		setSnapshotContentStatus(k8sClient, snapContent, true)
+		dv = createDataVolume(k8sClient, tplReq)
+		setDataVolumeStatus(k8sClient, dv, cdiv1beta1.Succeeded, true, false)
+	})
+
+	reconcileRequest := func() error {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(tplReq),
+		})
+		ExpectWithOffset(1, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tplReq)).To(Succeed())
+		return err
+	}
+
+	It("should refer to DataSources pointing at the cloned PVCs", func() {
+		Expect(reconcileRequest()).To(Succeed())
+
+		tpl := &v1beta1.VirtualMachineTemplate{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tpl)).To(Succeed())
+
+		ds := &cdiv1beta1.DataSource{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(dv), ds)).To(Succeed())
+		Expect(ds.Labels).To(HaveKeyWithValue(v1beta1.LabelRequestUID, string(tplReq.UID)))
+		Expect(ds.Spec.Source.PVC).To(Equal(&cdiv1beta1.DataVolumeSourcePVC{Namespace: dv.Namespace, Name: dv.Name}))
+		Expect(metav1.IsControlledBy(ds, tpl)).To(BeTrue())
+
+		vm := decodeVM(tpl.Spec.VirtualMachine.Raw)
+		Expect(vm.Spec.DataVolumeTemplates).To(ConsistOf(And(
+			HaveField("Spec.Source", BeNil()),
+			HaveField("Spec.SourceRef", Equal(&cdiv1beta1.DataVolumeSourceRef{
+				Kind:      cdiv1beta1.DataVolumeDataSource,
+				Namespace: ptr.To(dv.Namespace),
+				Name:      dv.Name,
+			})),
+		)))
+	})
+
+	It("should fail when DataSource exists with wrong UID", func() {
+		ds := &cdiv1beta1.DataSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: dv.Namespace,
+				Name:      dv.Name,
+				Labels:    map[string]string{v1beta1.LabelRequestUID: wrongUID},
+			},
+		}
+		Expect(k8sClient.Create(context.Background(), ds)).To(Succeed())
+
+		matcher := MatchRegexp("dataSource .* does not belong to this request")
+		Expect(reconcileRequest()).To(MatchError(matcher))
+
+		expectCondition(tplReq, v1beta1.ConditionReady, metav1.ConditionFalse, v1beta1.ReasonFailed, matcher)
+		expectCondition(tplReq, v1beta1.ConditionProgressing, metav1.ConditionFalse, v1beta1.ReasonFailed)
+	})
+})
@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,6 +52,7 @@ var _ = Describe("VirtualMachineTemplateRequest controller DataVolume handling",
 	BeforeEach(func() {
 		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
 			Client:     k8sClient,
+			APIReader:  k8sClient,
 			VirtClient: &fakeKubevirtClient{},
 			Scheme:     k8sClient.Scheme(),
 		}
@@ -137,6 +139,62 @@ var _ = Describe("VirtualMachineTemplateRequest controller DataVolume handling",
 		verifyDV(tplReq, dv2, secondSnapshotName)
 	})
 
+	It("should apply storage overrides to DataVolumes and DataVolumeTemplates", func() {
+		tplReq = createRequest(k8sClient, testNamespace, testVMNamespace, func(spec *v1beta1.VirtualMachineTemplateRequestSpec) {
+			spec.Storage = &v1beta1.StorageOverrides{
+				StorageOverride: v1beta1.StorageOverride{
+					StorageClassName: ptr.To("replicated"),
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+					VolumeMode:       ptr.To(corev1.PersistentVolumeFilesystem),
+				},
+				Volumes: []v1beta1.VolumeStorageOverride{{
+					Name: testVolumeName,
+					StorageOverride: v1beta1.StorageOverride{
+						VolumeMode: ptr.To(corev1.PersistentVolumeBlock),
+						Size:       ptr.To(resource.MustParse("10Gi")),
+					},
+				}},
+			}
+		})
+		snap := createSnapshot(k8sClient, tplReq)
+		snap = setSnapshotStatus(k8sClient, snap, withPhase(snapshotv1beta1.Succeeded), withReady())
+		setSnapshotContentStatus(k8sClient, createSnapshotContent(k8sClient, snap), true)
+
+		expectedStorage := cdiv1beta1.StorageSpec{
+			StorageClassName: ptr.To("replicated"),
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+			VolumeMode:       ptr.To(corev1.PersistentVolumeBlock),
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		}
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(tplReq),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		dv := &cdiv1beta1.DataVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      apimachinery.GetStableName(tplReq.Name, string(tplReq.UID), testVolumeName),
+				Namespace: testNamespace,
+			},
+		}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(dv), dv)).To(Succeed())
+		Expect(*dv.Spec.Storage).To(Equal(expectedStorage))
+		setDataVolumeStatus(k8sClient, dv, cdiv1beta1.Succeeded, true, false)
+
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(tplReq),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		tpl := &v1beta1.VirtualMachineTemplate{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tpl)).To(Succeed())
+		vm := decodeVM(tpl.Spec.VirtualMachine.Raw)
+		Expect(vm.Spec.DataVolumeTemplates).To(ConsistOf(HaveField("Spec.Storage", HaveValue(Equal(expectedStorage)))))
+	})
+
 	It("should not recreate DataVolume if it already exists with correct UID", func() {
 		dv := createDataVolume(k8sClient, tplReq)
 
@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controller_test
+
+import (
+	"context"
+
+	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/controller"
+)
+
+var _ = Describe("VirtualMachineTemplateRequest Controller disk strategy", func() {
+	var reconciler *controller.VirtualMachineTemplateRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
+			Client:     k8sClient,
+			APIReader:  k8sClient,
+			VirtClient: &fakeKubevirtClient{},
+			Scheme:     k8sClient.Scheme(),
+		}
+	})
+
+	createRequestWithStrategy := func(namespace string) *v1beta1.VirtualMachineTemplateRequest {
+		return createRequest(k8sClient, namespace, testVMNamespace, func(spec *v1beta1.VirtualMachineTemplateRequestSpec) {
+			spec.DiskStrategy = v1beta1.DiskStrategySnapshot
+		})
+	}
+
+	reconcileRequest := func(tplReq *v1beta1.VirtualMachineTemplateRequest) error {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(tplReq),
+		})
+		ExpectWithOffset(1, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tplReq)).To(Succeed())
+		return err
+	}
+
+	Context("with the VirtualMachine in the namespace of the request", func() {
+		var (
+			tplReq *v1beta1.VirtualMachineTemplateRequest
+			snap   *snapshotv1beta1.VirtualMachineSnapshot
+			vs     *volumesnapshotv1.VolumeSnapshot
+		)
+
+		BeforeEach(func() {
+			tplReq = createRequestWithStrategy(testVMNamespace)
+			snap = createSnapshot(k8sClient, tplReq)
+			snap = setSnapshotStatus(k8sClient, snap, withPhase(snapshotv1beta1.Succeeded), withReady())
+			snapContent := createSnapshotContent(k8sClient, snap)
+			setSnapshotContentStatus(k8sClient, snapContent, true)
+
+			vs = &volumesnapshotv1.VolumeSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testSnapshotName,
+					Namespace: testVMNamespace,
+				},
+			}
+			Expect(controllerutil.SetControllerReference(snapContent, vs, k8sClient.Scheme())).To(Succeed())
+			Expect(k8sClient.Create(context.Background(), vs)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(context.Background(), vs))).To(Succeed())
+			})
+		})
+
+		It("should keep the VolumeSnapshots instead of cloning them", func() {
+			Expect(reconcileRequest(tplReq)).To(Succeed())
+
+			dvs := &cdiv1beta1.DataVolumeList{}
+			Expect(k8sClient.List(context.Background(), dvs,
+				client.MatchingLabels{v1beta1.LabelRequestUID: string(tplReq.UID)},
+			)).To(Succeed())
+			Expect(dvs.Items).To(BeEmpty())
+
+			tpl := &v1beta1.VirtualMachineTemplate{}
+			Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tpl)).To(Succeed())
+			vm := decodeVM(tpl.Spec.VirtualMachine.Raw)
+			Expect(vm.Spec.DataVolumeTemplates).To(ConsistOf(
+				HaveField("Spec.Source.Snapshot", Equal(&cdiv1beta1.DataVolumeSourceSnapshot{
+					Namespace: testVMNamespace,
+					Name:      testSnapshotName,
+				})),
+			))
+
+			Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(vs), vs)).To(Succeed())
+			Expect(vs.Labels).To(HaveKeyWithValue(v1beta1.LabelRequestUID, string(tplReq.UID)))
+			Expect(vs.OwnerReferences).To(HaveLen(1))
+			Expect(metav1.IsControlledBy(vs, tpl)).To(BeTrue())
+
+			err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(snap), snap)
+			Expect(err).To(MatchError(k8serrors.IsNotFound, "k8serrors.IsNotFound"))
+		})
+	})
+
+	It("should reject requests for a VirtualMachine in another namespace", func() {
+		tplReq := createRequestWithStrategy(testNamespace)
+		Expect(reconcileRequest(tplReq)).To(Succeed())
+
+		expectCondition(tplReq, v1beta1.ConditionReady, metav1.ConditionFalse, v1beta1.ReasonInvalidConfiguration,
+			ContainSubstring("diskStrategy Snapshot requires the VirtualMachine to be in the namespace of the request"))
+		expectCondition(tplReq, v1beta1.ConditionProgressing, metav1.ConditionFalse, v1beta1.ReasonInvalidConfiguration)
+	})
+})
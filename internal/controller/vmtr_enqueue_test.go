@@ -126,6 +126,83 @@ var _ = Describe("VirtualMachineTemplateRequest controller EnqueueRequestByUID",
 	)
 })
 
+var _ = Describe("VirtualMachineTemplateRequest controller EnqueueRequestsBySnapshot", func() {
+	var (
+		fakeClient client.Client
+		reconciler *controller.VirtualMachineTemplateRequestReconciler
+	)
+
+	BeforeEach(func() {
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(testScheme).
+			WithIndex(&v1beta1.VirtualMachineTemplateRequest{}, "metadata.uid", func(obj client.Object) []string {
+				return []string{string(obj.GetUID())}
+			}).
+			WithIndex(&v1beta1.VirtualMachineTemplateRequest{}, "spec.virtualMachineSnapshotName", func(obj client.Object) []string {
+				tplReq := obj.(*v1beta1.VirtualMachineTemplateRequest)
+				if tplReq.Spec.VirtualMachineSnapshotName == "" {
+					return nil
+				}
+				return []string{tplReq.Spec.VirtualMachineRef.Namespace + "/" + tplReq.Spec.VirtualMachineSnapshotName}
+			}).
+			Build()
+
+		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
+			Client: fakeClient,
+			Scheme: fakeClient.Scheme(),
+		}
+	})
+
+	It("should enqueue requests referencing an existing snapshot", func() {
+		tplReq := fakeRequest()
+		tplReq.Spec.VirtualMachineRef = v1beta1.VirtualMachineReference{Namespace: testVMNamespace, Name: testVMName}
+		tplReq.Spec.VirtualMachineSnapshotName = testSnapshotName
+		Expect(fakeClient.Create(context.Background(), tplReq)).To(Succeed())
+
+		requests := reconciler.EnqueueRequestsBySnapshot(context.Background(), &snapshotv1beta1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testSnapshotName,
+				Namespace: testVMNamespace,
+			},
+		})
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Namespace).To(Equal(tplReq.Namespace))
+		Expect(requests[0].Name).To(Equal(tplReq.Name))
+	})
+
+	It("should not enqueue requests referencing a snapshot in another namespace", func() {
+		tplReq := fakeRequest()
+		tplReq.Spec.VirtualMachineRef = v1beta1.VirtualMachineReference{Namespace: testVMNamespace, Name: testVMName}
+		tplReq.Spec.VirtualMachineSnapshotName = testSnapshotName
+		Expect(fakeClient.Create(context.Background(), tplReq)).To(Succeed())
+
+		requests := reconciler.EnqueueRequestsBySnapshot(context.Background(), &snapshotv1beta1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testSnapshotName,
+				Namespace: testNamespace,
+			},
+		})
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should enqueue the request that created the snapshot", func() {
+		tplReq := fakeRequest()
+		Expect(fakeClient.Create(context.Background(), tplReq)).To(Succeed())
+
+		requests := reconciler.EnqueueRequestsBySnapshot(context.Background(), &snapshotv1beta1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testSnapshotName,
+				Namespace: testVMNamespace,
+				Labels: map[string]string{
+					v1beta1.LabelRequestUID: fakeUID,
+				},
+			},
+		})
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Name).To(Equal(tplReq.Name))
+	})
+})
+
 func fakeRequest() *v1beta1.VirtualMachineTemplateRequest {
 	return &v1beta1.VirtualMachineTemplateRequest{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,129 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controller_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/controller"
+)
+
+var _ = Describe("VirtualMachineTemplateRequest Controller existing snapshot", func() {
+	var reconciler *controller.VirtualMachineTemplateRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
+			Client:     k8sClient,
+			APIReader:  k8sClient,
+			VirtClient: &fakeKubevirtClient{},
+			Scheme:     k8sClient.Scheme(),
+		}
+	})
+
+	createExistingSnapshot := func(vmName string) *snapshotv1beta1.VirtualMachineSnapshot {
+		snap := &snapshotv1beta1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "scheduled-",
+				Namespace:    testVMNamespace,
+			},
+			Spec: snapshotv1beta1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &virtv1.VirtualMachineGroupVersionKind.Group,
+					Kind:     virtv1.VirtualMachineGroupVersionKind.Kind,
+					Name:     vmName,
+				},
+			},
+		}
+		ExpectWithOffset(1, k8sClient.Create(context.Background(), snap)).To(Succeed())
+		return snap
+	}
+
+	createRequestFromSnapshot := func(snap *snapshotv1beta1.VirtualMachineSnapshot) *v1beta1.VirtualMachineTemplateRequest {
+		return createRequest(k8sClient, testNamespace, testVMNamespace, func(spec *v1beta1.VirtualMachineTemplateRequestSpec) {
+			spec.VirtualMachineSnapshotName = snap.Name
+		})
+	}
+
+	reconcileRequest := func(tplReq *v1beta1.VirtualMachineTemplateRequest) (reconcile.Result, error) {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(tplReq),
+		})
+		ExpectWithOffset(1, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tplReq)).To(Succeed())
+		return result, err
+	}
+
+	It("should create the template from the existing snapshot and keep it", func() {
+		snap := createExistingSnapshot(testVMName)
+		snap = setSnapshotStatus(k8sClient, snap, withPhase(snapshotv1beta1.Succeeded), withReady())
+		setSnapshotContentStatus(k8sClient, createSnapshotContent(k8sClient, snap), true)
+		tplReq := createRequestFromSnapshot(snap)
+		dv := createDataVolume(k8sClient, tplReq)
+		setDataVolumeStatus(k8sClient, dv, cdiv1beta1.Succeeded, true, false)
+
+		_, err := reconcileRequest(tplReq)
+		Expect(err).ToNot(HaveOccurred())
+
+		tpl := &v1beta1.VirtualMachineTemplate{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tpl)).To(Succeed())
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(snap), snap)).To(Succeed())
+		Expect(snap.DeletionTimestamp).To(BeNil())
+	})
+
+	It("should wait for the existing snapshot to be ready", func() {
+		snap := createExistingSnapshot(testVMName)
+		snap = setSnapshotStatus(k8sClient, snap, withPhase(snapshotv1beta1.InProgress), withProgressing())
+		tplReq := createRequestFromSnapshot(snap)
+
+		result, err := reconcileRequest(tplReq)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeZero())
+
+		expectCondition(tplReq, v1beta1.ConditionReady, metav1.ConditionFalse, v1beta1.ReasonWaiting)
+
+		By("Labeling the snapshot so that its changes trigger a reconcile")
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(snap), snap)).To(Succeed())
+		Expect(snap.Labels).To(HaveKey(v1beta1.LabelSnapshotSource))
+	})
+
+	It("should fail when the snapshot is not a snapshot of the VirtualMachine", func() {
+		snap := createExistingSnapshot("other-vm")
+		tplReq := createRequestFromSnapshot(snap)
+
+		matcher := MatchRegexp("virtualMachineSnapshot .* is not a snapshot of VirtualMachine " + testVMName)
+		_, err := reconcileRequest(tplReq)
+		Expect(err).To(MatchError(matcher))
+
+		expectCondition(tplReq, v1beta1.ConditionReady, metav1.ConditionFalse, v1beta1.ReasonFailed, matcher)
+		expectCondition(tplReq, v1beta1.ConditionProgressing, metav1.ConditionFalse, v1beta1.ReasonFailed)
+	})
+})
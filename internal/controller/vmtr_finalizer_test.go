@@ -42,6 +42,7 @@ var _ = Describe("VirtualMachineTemplateRequest controller finalizer management
 	BeforeEach(func() {
 		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
 			Client:     k8sClient,
+			APIReader:  k8sClient,
 			VirtClient: &fakeKubevirtClient{},
 			Scheme:     k8sClient.Scheme(),
 		}
@@ -0,0 +1,164 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controller_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/controller"
+)
+
+var _ = Describe("VirtualMachineTemplateRequest Controller generalization", func() {
+	const testGeneralizationImage = "quay.io/kubevirt/virt-sysprep:latest"
+
+	var (
+		reconciler *controller.VirtualMachineTemplateRequestReconciler
+		tplReq     *v1beta1.VirtualMachineTemplateRequest
+		dv         *cdiv1beta1.DataVolume
+	)
+
+	BeforeEach(func() {
+		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
+			Client:     k8sClient,
+			APIReader:  k8sClient,
+			VirtClient: &fakeKubevirtClient{},
+			Scheme:     k8sClient.Scheme(),
+		}
+
+		tplReq = createRequest(k8sClient, testNamespace, testVMNamespace, func(spec *v1beta1.VirtualMachineTemplateRequestSpec) {
+			spec.Generalization = &v1beta1.Generalization{
+				Image: testGeneralizationImage,
+				Args:  []string{"--operations", "machine-id,ssh-hostkeys"},
+			}
+		})
+		snap := createSnapshot(k8sClient, tplReq)
+		snap = setSnapshotStatus(k8sClient, snap, withPhase(snapshotv1beta1.Succeeded), withReady())
+		snapContent := createSnapshotContent(k8sClient, snap)
+		setSnapshotContentStatus(k8sClient, snapContent, true)
+		dv = createDataVolume(k8sClient, tplReq)
+		setDataVolumeStatus(k8sClient, dv, cdiv1beta1.Succeeded, true, false)
+	})
+
+	reconcileRequest := func() error {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(tplReq),
+		})
+		ExpectWithOffset(1, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tplReq)).To(Succeed())
+		return err
+	}
+
+	getJob := func() *batchv1.Job {
+		jobs := &batchv1.JobList{}
+		ExpectWithOffset(1, k8sClient.List(context.Background(), jobs,
+			client.InNamespace(tplReq.Namespace),
+			client.MatchingLabels{v1beta1.LabelRequestUID: string(tplReq.UID)},
+		)).To(Succeed())
+		ExpectWithOffset(1, jobs.Items).To(HaveLen(1))
+		return &jobs.Items[0]
+	}
+
+	setJobStatus := func(job *batchv1.Job, condTypes ...batchv1.JobConditionType) {
+		now := metav1.Now()
+		job.Status.StartTime = &now
+		for _, condType := range condTypes {
+			job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{
+				Type:               condType,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: now,
+				Message:            "generalization " + string(condType),
+			})
+			switch condType {
+			case batchv1.JobComplete:
+				job.Status.CompletionTime = &now
+				job.Status.Succeeded = 1
+			case batchv1.JobFailed:
+				job.Status.Failed = 1
+			}
+		}
+		ExpectWithOffset(1, k8sClient.Status().Update(context.Background(), job)).To(Succeed())
+	}
+
+	expectNoTemplate := func() {
+		tpl := &v1beta1.VirtualMachineTemplate{}
+		err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tpl)
+		ExpectWithOffset(1, err).To(MatchError(k8serrors.IsNotFound, "k8serrors.IsNotFound"))
+	}
+
+	It("should run the generalization Job against the cloned disks", func() {
+		Expect(reconcileRequest()).To(Succeed())
+
+		job := getJob()
+		Expect(metav1.IsControlledBy(job, tplReq)).To(BeTrue())
+		Expect(job.Spec.Template.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+		Expect(job.Spec.Template.Spec.AutomountServiceAccountToken).To(HaveValue(BeFalse()))
+		Expect(job.Spec.Template.Spec.SecurityContext.RunAsNonRoot).To(HaveValue(BeTrue()))
+		Expect(job.Spec.Template.Spec.Volumes).To(ConsistOf(
+			HaveField("PersistentVolumeClaim.ClaimName", dv.Name),
+		))
+		Expect(job.Spec.Template.Spec.Containers).To(ConsistOf(And(
+			HaveField("Image", testGeneralizationImage),
+			HaveField("Args", tplReq.Spec.Generalization.Args),
+			HaveField("SecurityContext.AllowPrivilegeEscalation", HaveValue(BeFalse())),
+			HaveField("VolumeMounts", ConsistOf(HaveField("MountPath", "/disks/"+testVolumeName))),
+			HaveField("Env", ConsistOf(corev1.EnvVar{Name: "DISKS", Value: "/disks/" + testVolumeName + "/disk.img"})),
+		)))
+
+		expectNoTemplate()
+		expectCondition(tplReq, v1beta1.ConditionReady, metav1.ConditionFalse, v1beta1.ReasonWaiting,
+			MatchRegexp("Waiting for generalization Job .* to complete"))
+		expectCondition(tplReq, v1beta1.ConditionProgressing, metav1.ConditionTrue, v1beta1.ReasonWaiting)
+	})
+
+	It("should create the template once the generalization Job completed", func() {
+		Expect(reconcileRequest()).To(Succeed())
+		setJobStatus(getJob(), batchv1.JobSuccessCriteriaMet, batchv1.JobComplete)
+
+		Expect(reconcileRequest()).To(Succeed())
+
+		tpl := &v1beta1.VirtualMachineTemplate{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tpl)).To(Succeed())
+	})
+
+	It("should fail when the generalization Job failed", func() {
+		Expect(reconcileRequest()).To(Succeed())
+		setJobStatus(getJob(), batchv1.JobFailureTarget, batchv1.JobFailed)
+
+		matcher := MatchRegexp("generalization Job .* failed: generalization Failed")
+		Expect(reconcileRequest()).To(MatchError(matcher))
+
+		expectNoTemplate()
+		expectCondition(tplReq, v1beta1.ConditionReady, metav1.ConditionFalse, v1beta1.ReasonFailed, matcher)
+		expectCondition(tplReq, v1beta1.ConditionProgressing, metav1.ConditionFalse, v1beta1.ReasonFailed)
+	})
+})
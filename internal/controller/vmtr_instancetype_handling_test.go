@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controller_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/controller"
+)
+
+var _ = Describe("VirtualMachineTemplateRequest Controller instance type handling", func() {
+	const (
+		testInstancetypeName = "test-instancetype"
+		testPreferenceName   = "test-preference"
+		testRevisionName     = "test-revision"
+	)
+
+	var (
+		reconciler *controller.VirtualMachineTemplateRequestReconciler
+		virtClient *fakeKubevirtClient
+	)
+
+	BeforeEach(func() {
+		// Expanding the VM fails, so that the tests notice if references are expanded unexpectedly
+		virtClient = &fakeKubevirtClient{err: errors.New("unexpected expansion")}
+		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
+			Client:     k8sClient,
+			APIReader:  k8sClient,
+			VirtClient: virtClient,
+			Scheme:     k8sClient.Scheme(),
+		}
+	})
+
+	createRequestWithCapture := func(capture *v1beta1.InstancetypeCapture) *v1beta1.VirtualMachineTemplateRequest {
+		tplReq := &v1beta1.VirtualMachineTemplateRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: testRequestPrefix,
+				Namespace:    testNamespace,
+			},
+			Spec: v1beta1.VirtualMachineTemplateRequestSpec{
+				VirtualMachineRef: v1beta1.VirtualMachineReference{
+					Namespace: testVMNamespace,
+					Name:      testVMName,
+				},
+				Instancetypes: capture,
+			},
+		}
+		ExpectWithOffset(1, k8sClient.Create(context.Background(), tplReq)).To(Succeed())
+		return tplReq
+	}
+
+	reconcileRequest := func(tplReq *v1beta1.VirtualMachineTemplateRequest) *v1beta1.VirtualMachineTemplate {
+		snap := createSnapshot(k8sClient, tplReq)
+		snap = setSnapshotStatus(k8sClient, snap, withPhase(snapshotv1beta1.Succeeded), withReady())
+		snapContent := createSnapshotContent(k8sClient, snap)
+		snapContent.Spec.Source.VirtualMachine.Spec.Instancetype = &virtv1.InstancetypeMatcher{
+			Name:         testInstancetypeName,
+			Kind:         "VirtualMachineInstancetype",
+			RevisionName: testRevisionName,
+		}
+		snapContent.Spec.Source.VirtualMachine.Spec.Preference = &virtv1.PreferenceMatcher{
+			Name:         testPreferenceName,
+			RevisionName: testRevisionName,
+		}
+		ExpectWithOffset(1, k8sClient.Update(context.Background(), snapContent)).To(Succeed())
+		setSnapshotContentStatus(k8sClient, snapContent, true)
+		dv := createDataVolume(k8sClient, tplReq)
+		setDataVolumeStatus(k8sClient, dv, cdiv1beta1.Succeeded, true, false)
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(tplReq),
+		})
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+		tpl := &v1beta1.VirtualMachineTemplate{}
+		ExpectWithOffset(1, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tpl)).To(Succeed())
+		return tpl
+	}
+
+	It("should keep the references without their revisions", func() {
+		tplReq := createRequestWithCapture(&v1beta1.InstancetypeCapture{Policy: v1beta1.InstancetypeCapturePolicyKeep})
+		tpl := reconcileRequest(tplReq)
+
+		vm := decodeVM(tpl.Spec.VirtualMachine.Raw)
+		Expect(vm.Spec.Instancetype).To(Equal(&virtv1.InstancetypeMatcher{
+			Name: testInstancetypeName,
+			Kind: "VirtualMachineInstancetype",
+		}))
+		Expect(vm.Spec.Preference).To(Equal(&virtv1.PreferenceMatcher{Name: testPreferenceName}))
+		Expect(virtClient.instancetypes).To(BeEmpty())
+	})
+
+	It("should copy the namespaced instance type into the namespace of the template", func() {
+		virtClient.instancetypes = map[string]*instancetypev1beta1.VirtualMachineInstancetype{
+			testVMNamespace + "/" + testInstancetypeName: {
+				ObjectMeta: metav1.ObjectMeta{Name: testInstancetypeName, Namespace: testVMNamespace},
+				Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+					CPU: instancetypev1beta1.CPUInstancetype{Guest: 2},
+				},
+			},
+		}
+
+		tplReq := createRequestWithCapture(&v1beta1.InstancetypeCapture{
+			Policy: v1beta1.InstancetypeCapturePolicyKeep,
+			Copy:   true,
+		})
+		reconcileRequest(tplReq)
+
+		Expect(virtClient.instancetypes).To(HaveKey(testNamespace + "/" + testInstancetypeName))
+		copied := virtClient.instancetypes[testNamespace+"/"+testInstancetypeName]
+		Expect(copied.Spec.CPU.Guest).To(Equal(uint32(2)))
+		Expect(copied.Labels).To(HaveKeyWithValue(v1beta1.LabelRequestUID, string(tplReq.UID)))
+		// The preference refers to a cluster-wide preference and is not copied
+		Expect(virtClient.preferences).To(BeEmpty())
+	})
+
+	It("should replace the names of the references with parameters", func() {
+		tplReq := createRequestWithCapture(&v1beta1.InstancetypeCapture{
+			Policy:       v1beta1.InstancetypeCapturePolicyKeep,
+			Parameterize: true,
+		})
+		tpl := reconcileRequest(tplReq)
+
+		vm := decodeVM(tpl.Spec.VirtualMachine.Raw)
+		Expect(vm.Spec.Instancetype.Name).To(Equal("${INSTANCETYPE}"))
+		Expect(vm.Spec.Preference.Name).To(Equal("${PREFERENCE}"))
+		Expect(tpl.Spec.Parameters).To(ContainElements(
+			And(HaveField("Name", "INSTANCETYPE"), HaveField("Value", testInstancetypeName)),
+			And(HaveField("Name", "PREFERENCE"), HaveField("Value", testPreferenceName)),
+		))
+	})
+
+	It("should expand the references by default", func() {
+		virtClient.err = nil
+		tplReq := createRequestWithCapture(nil)
+		tpl := reconcileRequest(tplReq)
+		Expect(tpl.Spec.Parameters).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controller_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/controller"
+)
+
+var _ = Describe("VirtualMachineTemplateRequest Controller parameterization", func() {
+	const (
+		testUserData      = "#cloud-config\npassword: secret"
+		testSSHSecretName = "ssh-keys"
+		testNetworkName   = "secondary-net"
+		testNADName       = "my-nad"
+	)
+
+	var reconciler *controller.VirtualMachineTemplateRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
+			Client:     k8sClient,
+			APIReader:  k8sClient,
+			VirtClient: &fakeKubevirtClient{},
+			Scheme:     k8sClient.Scheme(),
+		}
+	})
+
+	reconcileRequest := func(parameterize *v1beta1.TemplateParameterization) (*v1beta1.VirtualMachineTemplate, map[string]any) {
+		tplReq := createRequest(k8sClient, testNamespace, testVMNamespace, func(spec *v1beta1.VirtualMachineTemplateRequestSpec) {
+			spec.Parameterize = parameterize
+		})
+		snap := createSnapshot(k8sClient, tplReq)
+		snap = setSnapshotStatus(k8sClient, snap, withPhase(snapshotv1beta1.Succeeded), withReady())
+		snapContent := createSnapshotContent(k8sClient, snap)
+
+		vmSpec := &snapContent.Spec.Source.VirtualMachine.Spec
+		vmSpec.RunStrategy = ptr.To(virtv1.RunStrategyAlways)
+		vmSpec.Template.Spec.Domain.CPU = &virtv1.CPU{Sockets: 1, Cores: 2}
+		vmSpec.Template.Spec.Domain.Memory = &virtv1.Memory{Guest: ptr.To(resource.MustParse("2Gi"))}
+		vmSpec.Template.Spec.Volumes = append(vmSpec.Template.Spec.Volumes, virtv1.Volume{
+			Name: "cloudinit",
+			VolumeSource: virtv1.VolumeSource{
+				CloudInitNoCloud: &virtv1.CloudInitNoCloudSource{UserData: testUserData},
+			},
+		})
+		vmSpec.Template.Spec.AccessCredentials = []virtv1.AccessCredential{{
+			SSHPublicKey: &virtv1.SSHPublicKeyAccessCredential{
+				Source: virtv1.SSHPublicKeyAccessCredentialSource{
+					Secret: &virtv1.AccessCredentialSecretSource{SecretName: testSSHSecretName},
+				},
+				PropagationMethod: virtv1.SSHPublicKeyAccessCredentialPropagationMethod{
+					NoCloud: &virtv1.NoCloudSSHPublicKeyAccessCredentialPropagation{},
+				},
+			},
+		}}
+		vmSpec.Template.Spec.Networks = []virtv1.Network{
+			*virtv1.DefaultPodNetwork(),
+			{
+				Name: testNetworkName,
+				NetworkSource: virtv1.NetworkSource{
+					Multus: &virtv1.MultusNetwork{NetworkName: testNADName},
+				},
+			},
+		}
+		ExpectWithOffset(1, k8sClient.Update(context.Background(), snapContent)).To(Succeed())
+		setSnapshotContentStatus(k8sClient, snapContent, true)
+		dv := createDataVolume(k8sClient, tplReq)
+		setDataVolumeStatus(k8sClient, dv, cdiv1beta1.Succeeded, true, false)
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(tplReq),
+		})
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+		tpl := &v1beta1.VirtualMachineTemplate{}
+		ExpectWithOffset(1, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tpl)).To(Succeed())
+		vm := map[string]any{}
+		ExpectWithOffset(1, json.Unmarshal(tpl.Spec.VirtualMachine.Raw, &vm)).To(Succeed())
+		return tpl, vm
+	}
+
+	expectField := func(vm map[string]any, value any, fields ...string) {
+		actual, found, err := unstructured.NestedFieldNoCopy(vm, fields...)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, found).To(BeTrue())
+		ExpectWithOffset(1, actual).To(Equal(value))
+	}
+
+	It("should replace the selected settings with parameters", func() {
+		tpl, vm := reconcileRequest(&v1beta1.TemplateParameterization{
+			CPU:               true,
+			Memory:            true,
+			RunStrategy:       true,
+			CloudInitUserData: true,
+			SSHKeys:           true,
+			NetworkNames:      true,
+		})
+
+		expectField(vm, "${RUN_STRATEGY}", "spec", "runStrategy")
+		expectField(vm, "${{CPU_SOCKETS}}", "spec", "template", "spec", "domain", "cpu", "sockets")
+		expectField(vm, "${{CPU_CORES}}", "spec", "template", "spec", "domain", "cpu", "cores")
+		expectField(vm, "${MEMORY}", "spec", "template", "spec", "domain", "memory", "guest")
+
+		spec := vm["spec"].(map[string]any)["template"].(map[string]any)["spec"].(map[string]any)
+		expectField(spec["volumes"].([]any)[1].(map[string]any), "${CLOUD_INIT_USER_DATA}", "cloudInitNoCloud", "userData")
+		expectField(spec["accessCredentials"].([]any)[0].(map[string]any), "${SSH_KEYS_SECRET}",
+			"sshPublicKey", "source", "secret", "secretName")
+		expectField(spec["networks"].([]any)[1].(map[string]any), "${NETWORK_SECONDARY_NET}", "multus", "networkName")
+
+		Expect(tpl.Spec.Parameters).To(ConsistOf(
+			HaveField("Name", "NAME"),
+			And(HaveField("Name", "RUN_STRATEGY"), HaveField("Value", string(virtv1.RunStrategyAlways))),
+			And(HaveField("Name", "CPU_SOCKETS"), HaveField("Value", "1")),
+			And(HaveField("Name", "CPU_CORES"), HaveField("Value", "2")),
+			And(HaveField("Name", "MEMORY"), HaveField("Value", "2Gi")),
+			And(HaveField("Name", "CLOUD_INIT_USER_DATA"), HaveField("Value", testUserData)),
+			And(HaveField("Name", "SSH_KEYS_SECRET"), HaveField("Value", testSSHSecretName)),
+			And(HaveField("Name", "NETWORK_SECONDARY_NET"), HaveField("Value", testNADName)),
+		))
+	})
+
+	It("should only replace the selected settings", func() {
+		tpl, vm := reconcileRequest(&v1beta1.TemplateParameterization{RunStrategy: true})
+
+		expectField(vm, "${RUN_STRATEGY}", "spec", "runStrategy")
+		expectField(vm, float64(2), "spec", "template", "spec", "domain", "cpu", "cores")
+		Expect(tpl.Spec.Parameters).To(HaveLen(2))
+	})
+
+	It("should not replace any settings by default", func() {
+		tpl, vm := reconcileRequest(nil)
+
+		expectField(vm, string(virtv1.RunStrategyAlways), "spec", "runStrategy")
+		Expect(tpl.Spec.Parameters).To(HaveLen(1))
+	})
+})
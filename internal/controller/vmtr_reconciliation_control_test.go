@@ -44,6 +44,7 @@ var _ = Describe("VirtualMachineTemplateRequest controller reconciliation contro
 	BeforeEach(func() {
 		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
 			Client:     k8sClient,
+			APIReader:  k8sClient,
 			VirtClient: &fakeKubevirtClient{},
 			Scheme:     k8sClient.Scheme(),
 		}
@@ -0,0 +1,153 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controller_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/controller"
+)
+
+var _ = Describe("VirtualMachineTemplateRequest Controller sanitization", func() {
+	const (
+		testHostname        = "source-host"
+		testCloudInitName   = "cloudinit"
+		testNodeSelectorKey = "kubernetes.io/hostname"
+	)
+
+	var reconciler *controller.VirtualMachineTemplateRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
+			Client:     k8sClient,
+			APIReader:  k8sClient,
+			VirtClient: &fakeKubevirtClient{},
+			Scheme:     k8sClient.Scheme(),
+		}
+	})
+
+	reconcileRequest := func(sanitization *v1beta1.Sanitization) (*v1beta1.VirtualMachineTemplateRequest, *virtv1.VirtualMachine) {
+		tplReq := createRequest(k8sClient, testNamespace, testVMNamespace, func(spec *v1beta1.VirtualMachineTemplateRequestSpec) {
+			spec.Sanitization = sanitization
+		})
+		snap := createSnapshot(k8sClient, tplReq)
+		snap = setSnapshotStatus(k8sClient, snap, withPhase(snapshotv1beta1.Succeeded), withReady())
+		snapContent := createSnapshotContent(k8sClient, snap)
+
+		tplSpec := snapContent.Spec.Source.VirtualMachine.Spec.Template
+		tplSpec.ObjectMeta.Labels = map[string]string{"kubevirt.io/domain": testVMName}
+		tplSpec.Spec.Hostname = testHostname
+		tplSpec.Spec.NodeSelector = map[string]string{testNodeSelectorKey: "node01"}
+		tplSpec.Spec.Domain.Devices.Disks = []virtv1.Disk{{Name: testCloudInitName}}
+		tplSpec.Spec.Volumes = append(tplSpec.Spec.Volumes, virtv1.Volume{
+			Name: testCloudInitName,
+			VolumeSource: virtv1.VolumeSource{
+				CloudInitNoCloud: &virtv1.CloudInitNoCloudSource{
+					UserDataSecretRef: &corev1.LocalObjectReference{Name: "cloud-init-secret"},
+				},
+			},
+		})
+		tplSpec.Spec.AccessCredentials = []virtv1.AccessCredential{{
+			SSHPublicKey: &virtv1.SSHPublicKeyAccessCredential{
+				Source: virtv1.SSHPublicKeyAccessCredentialSource{
+					Secret: &virtv1.AccessCredentialSecretSource{SecretName: "ssh-keys"},
+				},
+				PropagationMethod: virtv1.SSHPublicKeyAccessCredentialPropagationMethod{
+					NoCloud: &virtv1.NoCloudSSHPublicKeyAccessCredentialPropagation{},
+				},
+			},
+		}}
+		ExpectWithOffset(1, k8sClient.Update(context.Background(), snapContent)).To(Succeed())
+		setSnapshotContentStatus(k8sClient, snapContent, true)
+		dv := createDataVolume(k8sClient, tplReq)
+		setDataVolumeStatus(k8sClient, dv, cdiv1beta1.Succeeded, true, false)
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(tplReq),
+		})
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+		ExpectWithOffset(1, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tplReq)).To(Succeed())
+		tpl := &v1beta1.VirtualMachineTemplate{}
+		ExpectWithOffset(1, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tpl)).To(Succeed())
+		return tplReq, decodeVM(tpl.Spec.VirtualMachine.Raw)
+	}
+
+	It("should apply the Minimal profile by default", func() {
+		tplReq, vm := reconcileRequest(nil)
+
+		Expect(vm.Spec.Template.ObjectMeta.Labels).To(HaveKey("kubevirt.io/domain"))
+		Expect(vm.Spec.Template.Spec.Hostname).To(Equal(testHostname))
+		Expect(vm.Spec.Template.Spec.NodeSelector).To(HaveKey(testNodeSelectorKey))
+		Expect(tplReq.Status.SanitizedFields).To(BeEmpty())
+	})
+
+	It("should apply the default profile of the cluster", func() {
+		reconciler.DefaultSanitizationProfile = v1beta1.SanitizationProfileDefault
+		tplReq, vm := reconcileRequest(nil)
+
+		Expect(vm.Spec.Template.ObjectMeta.Labels).To(BeEmpty())
+		Expect(vm.Spec.Template.Spec.Hostname).To(BeEmpty())
+		Expect(vm.Spec.Template.Spec.NodeSelector).To(BeEmpty())
+		Expect(vm.Spec.Template.Spec.AccessCredentials).To(HaveLen(1))
+		Expect(vm.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", testCloudInitName)))
+		Expect(tplReq.Status.SanitizedFields).To(ConsistOf(
+			"spec.template.spec.hostname",
+			"spec.template.metadata.labels",
+			"spec.template.spec.nodeSelector",
+		))
+	})
+
+	It("should apply the profile of the request with additional rules to strip and keep", func() {
+		tplReq, vm := reconcileRequest(&v1beta1.Sanitization{
+			Profile: v1beta1.SanitizationProfileAggressive,
+			Keep:    []v1beta1.SanitizationRule{v1beta1.SanitizationRuleAccessCredentials},
+		})
+
+		Expect(vm.Spec.Template.Spec.AccessCredentials).To(HaveLen(1))
+		Expect(vm.Spec.Template.Spec.Volumes).ToNot(ContainElement(HaveField("Name", testCloudInitName)))
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Disks).To(BeEmpty())
+		Expect(tplReq.Status.SanitizedFields).To(ContainElements(
+			"spec.template.spec.volumes[cloudinit]",
+			"spec.template.spec.domain.devices.disks[cloudinit]",
+		))
+
+		tplReq, vm = reconcileRequest(&v1beta1.Sanitization{
+			Profile: v1beta1.SanitizationProfileMinimal,
+			Strip:   []v1beta1.SanitizationRule{v1beta1.SanitizationRuleHostname},
+		})
+
+		Expect(vm.Spec.Template.Spec.Hostname).To(BeEmpty())
+		Expect(vm.Spec.Template.Spec.NodeSelector).To(HaveKey(testNodeSelectorKey))
+		Expect(tplReq.Status.SanitizedFields).To(ConsistOf("spec.template.spec.hostname"))
+	})
+})
@@ -47,6 +47,7 @@ var _ = Describe("VirtualMachineTemplateRequest Controller VirtualMachineSnapsho
 	BeforeEach(func() {
 		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
 			Client:     k8sClient,
+			APIReader:  k8sClient,
 			VirtClient: &fakeKubevirtClient{},
 			Scheme:     k8sClient.Scheme(),
 		}
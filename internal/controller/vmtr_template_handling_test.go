@@ -67,6 +67,7 @@ var _ = Describe("VirtualMachineTemplateRequest Controller VirtualMachineTemplat
 	BeforeEach(func() {
 		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
 			Client:     k8sClient,
+			APIReader:  k8sClient,
 			VirtClient: &fakeKubevirtClient{},
 			Scheme:     k8sClient.Scheme(),
 		}
@@ -644,6 +645,69 @@ var _ = Describe("VirtualMachineTemplateRequest Controller VirtualMachineTemplat
 		Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(1))
 		Expect(vm.Spec.DataVolumeTemplates[0].Name).To(ContainSubstring(regularVolumeName))
 	})
+
+	It("should capture backend storage PVC when requested", func() {
+		const backendStorageVolumeName = "efi-volume"
+
+		tplReq := createRequest(k8sClient, testNamespace, testVMNamespace, func(spec *v1beta1.VirtualMachineTemplateRequestSpec) {
+			spec.CaptureBackendStorage = true
+		})
+		snap := createSnapshot(k8sClient, tplReq)
+		snap = setSnapshotStatus(k8sClient, snap, withPhase(snapshotv1beta1.Succeeded), withReady())
+		snapContent := createSnapshotContent(k8sClient, snap)
+
+		snapContent.Spec.VolumeBackups = []snapshotv1beta1.VolumeBackup{
+			{
+				// The backend storage PVC is identified by its label, not by its name
+				VolumeName: backendStorageVolumeName,
+				PersistentVolumeClaim: snapshotv1beta1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   efiPVCName,
+						Labels: map[string]string{"persistent-state-for": testVMName},
+					},
+				},
+				VolumeSnapshotName: ptr.To("efi-snapshot"),
+			},
+		}
+		Expect(k8sClient.Update(context.Background(), snapContent)).To(Succeed())
+		setSnapshotContentStatus(k8sClient, snapContent, true)
+
+		// First reconcile creates DataVolume for backend storage volume
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(tplReq),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// Mark the DataVolume as ready
+		dvName := apimachinery.GetStableName(tplReq.Name, string(tplReq.UID), backendStorageVolumeName)
+		dv := &cdiv1beta1.DataVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      dvName,
+				Namespace: tplReq.Namespace,
+			},
+		}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(dv), dv)).To(Succeed())
+		setDataVolumeStatus(k8sClient, dv, cdiv1beta1.Succeeded, true, false)
+
+		// Second reconcile creates the template
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(tplReq),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		tpl := &v1beta1.VirtualMachineTemplate{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tpl)).To(Succeed())
+
+		vm := decodeVM(tpl.Spec.VirtualMachine.Raw)
+		Expect(vm.Spec.DataVolumeTemplates).To(ConsistOf(And(
+			HaveField("Name", "persistent-state-for"+paramNameSuffix),
+			HaveField("Labels", HaveKeyWithValue("persistent-state-for", paramName)),
+			HaveField("Spec.Source.PVC", Equal(&cdiv1beta1.DataVolumeSourcePVC{
+				Namespace: tplReq.Namespace,
+				Name:      dvName,
+			})),
+		)))
+	})
 })
 
 type testPipeline struct {
@@ -0,0 +1,218 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package controller_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+	"kubevirt.io/virt-template/internal/controller"
+)
+
+var _ = Describe("VirtualMachineTemplateRequest Controller template source", func() {
+	const (
+		clonedDVTName  = "rootdisk"
+		keptDVTName    = "datadisk"
+		goldenPVCName  = "golden-fedora"
+		sourceTplLabel = "os.template.kubevirt.io/fedora"
+	)
+
+	var reconciler *controller.VirtualMachineTemplateRequestReconciler
+
+	BeforeEach(func() {
+		reconciler = &controller.VirtualMachineTemplateRequestReconciler{
+			Client:     k8sClient,
+			APIReader:  k8sClient,
+			VirtClient: &fakeKubevirtClient{},
+			Scheme:     k8sClient.Scheme(),
+		}
+	})
+
+	createSourceTemplate := func(pvcNamespace string) *v1beta1.VirtualMachineTemplate {
+		vm := map[string]any{
+			"apiVersion": "kubevirt.io/v1",
+			"kind":       "VirtualMachine",
+			"metadata": map[string]any{
+				"name": "${NAME}",
+			},
+			"spec": map[string]any{
+				"dataVolumeTemplates": []any{
+					map[string]any{
+						"metadata": map[string]any{"name": clonedDVTName},
+						"spec": map[string]any{
+							"source": map[string]any{
+								"pvc": map[string]any{"namespace": pvcNamespace, "name": goldenPVCName},
+							},
+							"storage": map[string]any{},
+						},
+					},
+					map[string]any{
+						"metadata": map[string]any{"name": keptDVTName},
+						"spec": map[string]any{
+							"sourceRef": map[string]any{"kind": "DataSource", "namespace": testVMNamespace, "name": "data"},
+							"storage":   map[string]any{},
+						},
+					},
+				},
+				"template": map[string]any{
+					"spec": map[string]any{
+						"volumes": []any{
+							map[string]any{"name": "root", "dataVolume": map[string]any{"name": clonedDVTName}},
+						},
+					},
+				},
+			},
+		}
+		raw, err := json.Marshal(vm)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+
+		tpl := &v1beta1.VirtualMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "source-",
+				Namespace:    testVMNamespace,
+				Labels: map[string]string{
+					sourceTplLabel: "true",
+				},
+			},
+			Spec: v1beta1.VirtualMachineTemplateSpec{
+				VirtualMachine: &runtime.RawExtension{Raw: raw},
+				Parameters: []v1beta1.Parameter{
+					{Name: "NAME", Required: true},
+				},
+			},
+		}
+		ExpectWithOffset(1, k8sClient.Create(context.Background(), tpl)).To(Succeed())
+		return tpl
+	}
+
+	createRequestFromTemplate := func(namespace, name string) *v1beta1.VirtualMachineTemplateRequest {
+		tplReq := &v1beta1.VirtualMachineTemplateRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: testRequestPrefix,
+				Namespace:    testNamespace,
+			},
+			Spec: v1beta1.VirtualMachineTemplateRequestSpec{
+				VirtualMachineTemplateRef: &v1beta1.VirtualMachineTemplateReference{
+					Namespace: namespace,
+					Name:      name,
+				},
+			},
+		}
+		ExpectWithOffset(1, k8sClient.Create(context.Background(), tplReq)).To(Succeed())
+		return tplReq
+	}
+
+	reconcileRequest := func(tplReq *v1beta1.VirtualMachineTemplateRequest) error {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(tplReq),
+		})
+		ExpectWithOffset(1, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tplReq)).To(Succeed())
+		return err
+	}
+
+	It("should clone the PVCs of the source template and rewrite its DataVolumeTemplates", func() {
+		source := createSourceTemplate(testVMNamespace)
+		tplReq := createRequestFromTemplate(source.Namespace, source.Name)
+
+		Expect(reconcileRequest(tplReq)).To(Succeed())
+
+		dvName := apimachinery.GetStableName(tplReq.Name, string(tplReq.UID), clonedDVTName)
+		dv := &cdiv1beta1.DataVolume{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: dvName}, dv)).To(Succeed())
+		Expect(dv.Labels).To(HaveKeyWithValue(v1beta1.LabelRequestUID, string(tplReq.UID)))
+		Expect(dv.Spec.Source.PVC).To(Equal(&cdiv1beta1.DataVolumeSourcePVC{Namespace: testVMNamespace, Name: goldenPVCName}))
+
+		tpl := &v1beta1.VirtualMachineTemplate{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tpl)).
+			To(MatchError(ContainSubstring("not found")))
+
+		setDataVolumeStatus(k8sClient, dv, cdiv1beta1.Succeeded, true, false)
+		Expect(reconcileRequest(tplReq)).To(Succeed())
+
+		Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(tplReq), tpl)).To(Succeed())
+		Expect(tpl.Labels).To(HaveKeyWithValue(sourceTplLabel, "true"))
+		Expect(tpl.Labels).To(HaveKeyWithValue(v1beta1.LabelRequestUID, string(tplReq.UID)))
+		Expect(tpl.Spec.Parameters).To(Equal(source.Spec.Parameters))
+
+		vm := map[string]any{}
+		Expect(json.Unmarshal(tpl.Spec.VirtualMachine.Raw, &vm)).To(Succeed())
+		dvts, _, err := unstructured.NestedSlice(vm, "spec", "dataVolumeTemplates")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dvts).To(HaveLen(2))
+
+		clonedSource, _, err := unstructured.NestedMap(dvts[0].(map[string]any), "spec", "source", "pvc")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clonedSource).To(Equal(map[string]any{"namespace": testNamespace, "name": dvName}))
+
+		keptSourceRef, _, err := unstructured.NestedMap(dvts[1].(map[string]any), "spec", "sourceRef")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keptSourceRef).To(HaveKeyWithValue("name", "data"))
+	})
+
+	It("should clone PVCs without a namespace from the namespace of the source template", func() {
+		source := createSourceTemplate("")
+		tplReq := createRequestFromTemplate(source.Namespace, source.Name)
+
+		Expect(reconcileRequest(tplReq)).To(Succeed())
+
+		dvName := apimachinery.GetStableName(tplReq.Name, string(tplReq.UID), clonedDVTName)
+		dv := &cdiv1beta1.DataVolume{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: dvName}, dv)).To(Succeed())
+		Expect(dv.Spec.Source.PVC).To(Equal(&cdiv1beta1.DataVolumeSourcePVC{Namespace: testVMNamespace, Name: goldenPVCName}))
+	})
+
+	It("should reject DataVolumeTemplate sources outside the namespace of the source template", func() {
+		source := createSourceTemplate(testNamespace)
+		tplReq := createRequestFromTemplate(source.Namespace, source.Name)
+
+		matcher := ContainSubstring("is not in the namespace " + testVMNamespace + " of the VirtualMachineTemplate")
+		Expect(reconcileRequest(tplReq)).To(MatchError(matcher))
+
+		dvName := apimachinery.GetStableName(tplReq.Name, string(tplReq.UID), clonedDVTName)
+		dv := &cdiv1beta1.DataVolume{}
+		Expect(k8sClient.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: dvName}, dv)).
+			To(MatchError(ContainSubstring("not found")))
+
+		expectCondition(tplReq, v1beta1.ConditionReady, metav1.ConditionFalse, v1beta1.ReasonFailed, matcher)
+		expectCondition(tplReq, v1beta1.ConditionProgressing, metav1.ConditionFalse, v1beta1.ReasonFailed)
+	})
+
+	It("should retry when the source template does not exist", func() {
+		tplReq := createRequestFromTemplate(testVMNamespace, "missing")
+
+		Expect(reconcileRequest(tplReq)).To(MatchError(ContainSubstring("not found")))
+
+		expectCondition(tplReq, v1beta1.ConditionReady, metav1.ConditionFalse, v1beta1.ReasonFailed)
+		expectCondition(tplReq, v1beta1.ConditionProgressing, metav1.ConditionTrue, v1beta1.ReasonReconciling)
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -51,6 +52,7 @@ const (
 	verbList             = "list"
 	verbPatch            = "patch"
 	verbUpdate           = "update"
+	verbUse              = "use"
 	verbWatch            = "watch"
 )
 
@@ -167,7 +169,7 @@ var _ = Describe("RBAC Roles", func() {
 	}
 
 	// All resource verbs we assert; tests define expected allowed subset per role.
-	allVerbs := []string{verbCreate, verbDelete, verbDeletecollection, verbGet, verbList, verbPatch, verbUpdate, verbWatch}
+	allVerbs := []string{verbCreate, verbDelete, verbDeletecollection, verbGet, verbList, verbPatch, verbUpdate, verbUse, verbWatch}
 
 	checkPermission := func(sa *corev1.ServiceAccount, apiGroup, resource, verb string) bool {
 		sar := &authorizationv1.SubjectAccessReview{
@@ -206,16 +208,38 @@ var _ = Describe("RBAC Roles", func() {
 	adminVerbs := []string{verbCreate, verbDelete, verbDeletecollection, verbGet, verbList, verbPatch, verbUpdate, verbWatch}
 	editorVerbs := []string{verbCreate, verbDelete, verbGet, verbList, verbPatch, verbUpdate, verbWatch}
 	viewerVerbs := []string{verbGet, verbList, verbWatch}
+	templateAdminVerbs := append(slices.Clone(adminVerbs), verbUse)
+	templateEditorVerbs := append(slices.Clone(editorVerbs), verbUse)
 
 	Context("VirtualMachineTemplate roles", func() {
 		DescribeTable(
 			"RBAC permissions", testRBACPermissions,
-			Entry("Admin role", "virtualmachinetemplate-admin-role", templateapi.PluralResourceName, templateapi.GroupName, adminVerbs),
-			Entry("Editor role", "virtualmachinetemplate-editor-role", templateapi.PluralResourceName, templateapi.GroupName, editorVerbs),
-			Entry("Viewer role", "virtualmachinetemplate-viewer-role", templateapi.PluralResourceName, templateapi.GroupName, viewerVerbs),
+			Entry("Admin role", "virtualmachinetemplate-admin-role",
+				templateapi.PluralResourceName, templateapi.GroupName, templateAdminVerbs),
+			Entry("Editor role", "virtualmachinetemplate-editor-role",
+				templateapi.PluralResourceName, templateapi.GroupName, templateEditorVerbs),
+			Entry("Viewer role", "virtualmachinetemplate-viewer-role",
+				templateapi.PluralResourceName, templateapi.GroupName, viewerVerbs),
 		)
 	})
 
+	Context("VirtualMachineTemplate use role", func() {
+		It("should only allow using namespaced and cluster-scoped templates", func() {
+			const roleName = "virtualmachinetemplate-use-role"
+			Expect(clusterRoles).To(HaveKey(roleName))
+
+			sa := createServiceAccount(roleName + "-sa")
+			createClusterRoleBinding(roleName+"-crb", roleName, sa)
+
+			for _, resource := range []string{templateapi.PluralResourceName, templateapi.PluralClusterResourceName} {
+				for _, verb := range allVerbs {
+					Expect(checkPermission(sa, templateapi.GroupName, resource, verb)).To(Equal(verb == verbUse),
+						"Role %s should only have %s permission on %s", roleName, verbUse, resource)
+				}
+			}
+		})
+	})
+
 	Context("VirtualMachineTemplateRequest roles", func() {
 		DescribeTable(
 			"RBAC permissions", testRBACPermissions,
@@ -227,6 +251,42 @@ var _ = Describe("RBAC Roles", func() {
 				templateapi.PluralRequestResourceName, templateapi.GroupName, viewerVerbs),
 		)
 	})
+
+	Context("VirtualMachineTemplateInstance roles", func() {
+		DescribeTable(
+			"RBAC permissions", testRBACPermissions,
+			Entry("Admin role", "virtualmachinetemplateinstance-admin-role",
+				templateapi.PluralInstanceResourceName, templateapi.GroupName, adminVerbs),
+			Entry("Editor role", "virtualmachinetemplateinstance-editor-role",
+				templateapi.PluralInstanceResourceName, templateapi.GroupName, editorVerbs),
+			Entry("Viewer role", "virtualmachinetemplateinstance-viewer-role",
+				templateapi.PluralInstanceResourceName, templateapi.GroupName, viewerVerbs),
+		)
+	})
+
+	Context("VirtualMachineTemplateGrant roles", func() {
+		DescribeTable(
+			"RBAC permissions", testRBACPermissions,
+			Entry("Admin role", "virtualmachinetemplategrant-admin-role",
+				templateapi.PluralGrantResourceName, templateapi.GroupName, adminVerbs),
+			Entry("Editor role", "virtualmachinetemplategrant-editor-role",
+				templateapi.PluralGrantResourceName, templateapi.GroupName, editorVerbs),
+			Entry("Viewer role", "virtualmachinetemplategrant-viewer-role",
+				templateapi.PluralGrantResourceName, templateapi.GroupName, viewerVerbs),
+		)
+	})
+
+	Context("ClusterVirtualMachineTemplate roles", func() {
+		DescribeTable(
+			"RBAC permissions", testRBACPermissions,
+			Entry("Admin role", "clustervirtualmachinetemplate-admin-role",
+				templateapi.PluralClusterResourceName, templateapi.GroupName, templateAdminVerbs),
+			Entry("Editor role", "clustervirtualmachinetemplate-editor-role",
+				templateapi.PluralClusterResourceName, templateapi.GroupName, templateEditorVerbs),
+			Entry("Viewer role", "clustervirtualmachinetemplate-viewer-role",
+				templateapi.PluralClusterResourceName, templateapi.GroupName, viewerVerbs),
+		)
+	})
 })
 
 func getFirstFoundEnvTestBinaryDir() string {
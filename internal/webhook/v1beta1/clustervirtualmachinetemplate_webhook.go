@@ -0,0 +1,79 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	templatev1beta1 "kubevirt.io/virt-template-api/core/v1beta1"
+
+	"kubevirt.io/virt-template/internal/apimachinery"
+)
+
+// SetupClusterVirtualMachineTemplateWebhookWithManager registers the webhook for ClusterVirtualMachineTemplate in the manager.
+func SetupClusterVirtualMachineTemplateWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&templatev1beta1.ClusterVirtualMachineTemplate{}).
+		WithValidator(&ClusterVirtualMachineTemplateCustomValidator{}).
+		Complete()
+}
+
+// NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
+// Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
+//nolint:lll
+// +kubebuilder:webhook:path=/validate-template-kubevirt-io-v1beta1-clustervirtualmachinetemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=template.kubevirt.io,resources=clustervirtualmachinetemplates,verbs=create;update,versions=v1beta1,name=vclustervirtualmachinetemplate-v1beta1.kb.io,admissionReviewVersions=v1
+
+// ClusterVirtualMachineTemplateCustomValidator struct is responsible for validating the ClusterVirtualMachineTemplate
+// resource when it is created, updated, or deleted.
+type ClusterVirtualMachineTemplateCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ClusterVirtualMachineTemplateCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ClusterVirtualMachineTemplate.
+func (v *ClusterVirtualMachineTemplateCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	clustervirtualmachinetemplate, ok := obj.(*templatev1beta1.ClusterVirtualMachineTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterVirtualMachineTemplate object but got %T", obj)
+	}
+
+	return ValidateTemplate(apimachinery.TemplateFromClusterTemplate(clustervirtualmachinetemplate))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ClusterVirtualMachineTemplate.
+func (v *ClusterVirtualMachineTemplateCustomValidator) ValidateUpdate(
+	_ context.Context, _, newObj runtime.Object,
+) (admission.Warnings, error) {
+	clustervirtualmachinetemplate, ok := newObj.(*templatev1beta1.ClusterVirtualMachineTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterVirtualMachineTemplate object for the newObj but got %T", newObj)
+	}
+
+	return ValidateTemplate(apimachinery.TemplateFromClusterTemplate(clustervirtualmachinetemplate))
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ClusterVirtualMachineTemplate.
+func (v *ClusterVirtualMachineTemplateCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
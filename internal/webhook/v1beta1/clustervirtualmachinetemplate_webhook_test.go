@@ -0,0 +1,88 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"kubevirt.io/virt-template-api/core/v1beta1"
+
+	webhookv1beta1 "kubevirt.io/virt-template/internal/webhook/v1beta1"
+)
+
+var _ = Describe("ClusterVirtualMachineTemplate Webhook", func() {
+	var validator webhookv1beta1.ClusterVirtualMachineTemplateCustomValidator
+
+	newClusterVirtualMachineTemplate := func(vm string) *v1beta1.ClusterVirtualMachineTemplate {
+		return &v1beta1.ClusterVirtualMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-cluster-template-",
+			},
+			Spec: v1beta1.VirtualMachineTemplateSpec{
+				Parameters: []v1beta1.Parameter{
+					{
+						Name:  param1Name,
+						Value: testVMValue,
+					},
+				},
+				VirtualMachine: &runtime.RawExtension{
+					Raw: []byte(vm),
+				},
+			},
+		}
+	}
+
+	It("should accept a valid template on create and update", func() {
+		clusterTpl := newClusterVirtualMachineTemplate(validVMWithParam)
+
+		warnings, err := validator.ValidateCreate(context.Background(), clusterTpl)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+
+		warnings, err = validator.ValidateUpdate(context.Background(), nil, clusterTpl)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("should reject a template with undefined parameter reference", func() {
+		_, err := validator.ValidateCreate(context.Background(), newClusterVirtualMachineTemplate(validVMWithParams))
+		Expect(err).To(MatchError(ContainSubstring("references undefined parameter PREFERENCE")))
+	})
+
+	It("should reject a template that fails processing", func() {
+		_, err := validator.ValidateCreate(context.Background(), newClusterVirtualMachineTemplate(invalidVMWithParam))
+		Expect(err).To(MatchError(ContainSubstring("processing validation failed")))
+	})
+
+	It("should be enforced by the API server", func() {
+		clusterTpl := newClusterVirtualMachineTemplate(validVMWithParams)
+		Expect(k8sClient.Create(ctx, clusterTpl)).To(MatchError(ContainSubstring("references undefined parameter PREFERENCE")))
+
+		clusterTpl = newClusterVirtualMachineTemplate(validVMWithParam)
+		Expect(k8sClient.Create(ctx, clusterTpl)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, clusterTpl)).To(Succeed())
+	})
+})
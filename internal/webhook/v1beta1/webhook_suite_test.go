@@ -116,6 +116,8 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 	err = webhookv1beta1.SetupVirtualMachineTemplateWebhookWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
+	err = webhookv1beta1.SetupClusterVirtualMachineTemplateWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
 
 	// +kubebuilder:scaffold:webhook
 
@@ -35,684 +35,745 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                             schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
-		"k8s.io/api/core/v1.Affinity":                                                                     schema_k8sio_api_core_v1_Affinity(ref),
-		"k8s.io/api/core/v1.AppArmorProfile":                                                              schema_k8sio_api_core_v1_AppArmorProfile(ref),
-		"k8s.io/api/core/v1.AttachedVolume":                                                               schema_k8sio_api_core_v1_AttachedVolume(ref),
-		"k8s.io/api/core/v1.AvoidPods":                                                                    schema_k8sio_api_core_v1_AvoidPods(ref),
-		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                                        schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                              schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFileVolumeSource":                                                        schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
-		"k8s.io/api/core/v1.Binding":                                                                      schema_k8sio_api_core_v1_Binding(ref),
-		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                                    schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CSIVolumeSource":                                                              schema_k8sio_api_core_v1_CSIVolumeSource(ref),
-		"k8s.io/api/core/v1.Capabilities":                                                                 schema_k8sio_api_core_v1_Capabilities(ref),
-		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                                 schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CephFSVolumeSource":                                                           schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                                 schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderVolumeSource":                                                           schema_k8sio_api_core_v1_CinderVolumeSource(ref),
-		"k8s.io/api/core/v1.ClientIPConfig":                                                               schema_k8sio_api_core_v1_ClientIPConfig(ref),
-		"k8s.io/api/core/v1.ClusterTrustBundleProjection":                                                 schema_k8sio_api_core_v1_ClusterTrustBundleProjection(ref),
-		"k8s.io/api/core/v1.ComponentCondition":                                                           schema_k8sio_api_core_v1_ComponentCondition(ref),
-		"k8s.io/api/core/v1.ComponentStatus":                                                              schema_k8sio_api_core_v1_ComponentStatus(ref),
-		"k8s.io/api/core/v1.ComponentStatusList":                                                          schema_k8sio_api_core_v1_ComponentStatusList(ref),
-		"k8s.io/api/core/v1.ConfigMap":                                                                    schema_k8sio_api_core_v1_ConfigMap(ref),
-		"k8s.io/api/core/v1.ConfigMapEnvSource":                                                           schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
-		"k8s.io/api/core/v1.ConfigMapKeySelector":                                                         schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
-		"k8s.io/api/core/v1.ConfigMapList":                                                                schema_k8sio_api_core_v1_ConfigMapList(ref),
-		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                                    schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
-		"k8s.io/api/core/v1.ConfigMapProjection":                                                          schema_k8sio_api_core_v1_ConfigMapProjection(ref),
-		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                                        schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
-		"k8s.io/api/core/v1.Container":                                                                    schema_k8sio_api_core_v1_Container(ref),
-		"k8s.io/api/core/v1.ContainerExtendedResourceRequest":                                             schema_k8sio_api_core_v1_ContainerExtendedResourceRequest(ref),
-		"k8s.io/api/core/v1.ContainerImage":                                                               schema_k8sio_api_core_v1_ContainerImage(ref),
-		"k8s.io/api/core/v1.ContainerPort":                                                                schema_k8sio_api_core_v1_ContainerPort(ref),
-		"k8s.io/api/core/v1.ContainerResizePolicy":                                                        schema_k8sio_api_core_v1_ContainerResizePolicy(ref),
-		"k8s.io/api/core/v1.ContainerRestartRule":                                                         schema_k8sio_api_core_v1_ContainerRestartRule(ref),
-		"k8s.io/api/core/v1.ContainerRestartRuleOnExitCodes":                                              schema_k8sio_api_core_v1_ContainerRestartRuleOnExitCodes(ref),
-		"k8s.io/api/core/v1.ContainerState":                                                               schema_k8sio_api_core_v1_ContainerState(ref),
-		"k8s.io/api/core/v1.ContainerStateRunning":                                                        schema_k8sio_api_core_v1_ContainerStateRunning(ref),
-		"k8s.io/api/core/v1.ContainerStateTerminated":                                                     schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
-		"k8s.io/api/core/v1.ContainerStateWaiting":                                                        schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
-		"k8s.io/api/core/v1.ContainerStatus":                                                              schema_k8sio_api_core_v1_ContainerStatus(ref),
-		"k8s.io/api/core/v1.ContainerUser":                                                                schema_k8sio_api_core_v1_ContainerUser(ref),
-		"k8s.io/api/core/v1.DaemonEndpoint":                                                               schema_k8sio_api_core_v1_DaemonEndpoint(ref),
-		"k8s.io/api/core/v1.DownwardAPIProjection":                                                        schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                                        schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                                      schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
-		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                                         schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
-		"k8s.io/api/core/v1.EndpointAddress":                                                              schema_k8sio_api_core_v1_EndpointAddress(ref),
-		"k8s.io/api/core/v1.EndpointPort":                                                                 schema_k8sio_api_core_v1_EndpointPort(ref),
-		"k8s.io/api/core/v1.EndpointSubset":                                                               schema_k8sio_api_core_v1_EndpointSubset(ref),
-		"k8s.io/api/core/v1.Endpoints":                                                                    schema_k8sio_api_core_v1_Endpoints(ref),
-		"k8s.io/api/core/v1.EndpointsList":                                                                schema_k8sio_api_core_v1_EndpointsList(ref),
-		"k8s.io/api/core/v1.EnvFromSource":                                                                schema_k8sio_api_core_v1_EnvFromSource(ref),
-		"k8s.io/api/core/v1.EnvVar":                                                                       schema_k8sio_api_core_v1_EnvVar(ref),
-		"k8s.io/api/core/v1.EnvVarSource":                                                                 schema_k8sio_api_core_v1_EnvVarSource(ref),
-		"k8s.io/api/core/v1.EphemeralContainer":                                                           schema_k8sio_api_core_v1_EphemeralContainer(ref),
-		"k8s.io/api/core/v1.EphemeralContainerCommon":                                                     schema_k8sio_api_core_v1_EphemeralContainerCommon(ref),
-		"k8s.io/api/core/v1.EphemeralVolumeSource":                                                        schema_k8sio_api_core_v1_EphemeralVolumeSource(ref),
-		"k8s.io/api/core/v1.Event":                                                                        schema_k8sio_api_core_v1_Event(ref),
-		"k8s.io/api/core/v1.EventList":                                                                    schema_k8sio_api_core_v1_EventList(ref),
-		"k8s.io/api/core/v1.EventSeries":                                                                  schema_k8sio_api_core_v1_EventSeries(ref),
-		"k8s.io/api/core/v1.EventSource":                                                                  schema_k8sio_api_core_v1_EventSource(ref),
-		"k8s.io/api/core/v1.ExecAction":                                                                   schema_k8sio_api_core_v1_ExecAction(ref),
-		"k8s.io/api/core/v1.FCVolumeSource":                                                               schema_k8sio_api_core_v1_FCVolumeSource(ref),
-		"k8s.io/api/core/v1.FileKeySelector":                                                              schema_k8sio_api_core_v1_FileKeySelector(ref),
-		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                                   schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexVolumeSource":                                                             schema_k8sio_api_core_v1_FlexVolumeSource(ref),
-		"k8s.io/api/core/v1.FlockerVolumeSource":                                                          schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
-		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                                schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.GRPCAction":                                                                   schema_k8sio_api_core_v1_GRPCAction(ref),
-		"k8s.io/api/core/v1.GitRepoVolumeSource":                                                          schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                              schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                                        schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
-		"k8s.io/api/core/v1.HTTPGetAction":                                                                schema_k8sio_api_core_v1_HTTPGetAction(ref),
-		"k8s.io/api/core/v1.HTTPHeader":                                                                   schema_k8sio_api_core_v1_HTTPHeader(ref),
-		"k8s.io/api/core/v1.HostAlias":                                                                    schema_k8sio_api_core_v1_HostAlias(ref),
-		"k8s.io/api/core/v1.HostIP":                                                                       schema_k8sio_api_core_v1_HostIP(ref),
-		"k8s.io/api/core/v1.HostPathVolumeSource":                                                         schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                                  schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIVolumeSource":                                                            schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
-		"k8s.io/api/core/v1.ImageVolumeSource":                                                            schema_k8sio_api_core_v1_ImageVolumeSource(ref),
-		"k8s.io/api/core/v1.KeyToPath":                                                                    schema_k8sio_api_core_v1_KeyToPath(ref),
-		"k8s.io/api/core/v1.Lifecycle":                                                                    schema_k8sio_api_core_v1_Lifecycle(ref),
-		"k8s.io/api/core/v1.LifecycleHandler":                                                             schema_k8sio_api_core_v1_LifecycleHandler(ref),
-		"k8s.io/api/core/v1.LimitRange":                                                                   schema_k8sio_api_core_v1_LimitRange(ref),
-		"k8s.io/api/core/v1.LimitRangeItem":                                                               schema_k8sio_api_core_v1_LimitRangeItem(ref),
-		"k8s.io/api/core/v1.LimitRangeList":                                                               schema_k8sio_api_core_v1_LimitRangeList(ref),
-		"k8s.io/api/core/v1.LimitRangeSpec":                                                               schema_k8sio_api_core_v1_LimitRangeSpec(ref),
-		"k8s.io/api/core/v1.LinuxContainerUser":                                                           schema_k8sio_api_core_v1_LinuxContainerUser(ref),
-		"k8s.io/api/core/v1.List":                                                                         schema_k8sio_api_core_v1_List(ref),
-		"k8s.io/api/core/v1.LoadBalancerIngress":                                                          schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
-		"k8s.io/api/core/v1.LoadBalancerStatus":                                                           schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
-		"k8s.io/api/core/v1.LocalObjectReference":                                                         schema_k8sio_api_core_v1_LocalObjectReference(ref),
-		"k8s.io/api/core/v1.LocalVolumeSource":                                                            schema_k8sio_api_core_v1_LocalVolumeSource(ref),
-		"k8s.io/api/core/v1.ModifyVolumeStatus":                                                           schema_k8sio_api_core_v1_ModifyVolumeStatus(ref),
-		"k8s.io/api/core/v1.NFSVolumeSource":                                                              schema_k8sio_api_core_v1_NFSVolumeSource(ref),
-		"k8s.io/api/core/v1.Namespace":                                                                    schema_k8sio_api_core_v1_Namespace(ref),
-		"k8s.io/api/core/v1.NamespaceCondition":                                                           schema_k8sio_api_core_v1_NamespaceCondition(ref),
-		"k8s.io/api/core/v1.NamespaceList":                                                                schema_k8sio_api_core_v1_NamespaceList(ref),
-		"k8s.io/api/core/v1.NamespaceSpec":                                                                schema_k8sio_api_core_v1_NamespaceSpec(ref),
-		"k8s.io/api/core/v1.NamespaceStatus":                                                              schema_k8sio_api_core_v1_NamespaceStatus(ref),
-		"k8s.io/api/core/v1.Node":                                                                         schema_k8sio_api_core_v1_Node(ref),
-		"k8s.io/api/core/v1.NodeAddress":                                                                  schema_k8sio_api_core_v1_NodeAddress(ref),
-		"k8s.io/api/core/v1.NodeAffinity":                                                                 schema_k8sio_api_core_v1_NodeAffinity(ref),
-		"k8s.io/api/core/v1.NodeCondition":                                                                schema_k8sio_api_core_v1_NodeCondition(ref),
-		"k8s.io/api/core/v1.NodeConfigSource":                                                             schema_k8sio_api_core_v1_NodeConfigSource(ref),
-		"k8s.io/api/core/v1.NodeConfigStatus":                                                             schema_k8sio_api_core_v1_NodeConfigStatus(ref),
-		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                                          schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
-		"k8s.io/api/core/v1.NodeFeatures":                                                                 schema_k8sio_api_core_v1_NodeFeatures(ref),
-		"k8s.io/api/core/v1.NodeList":                                                                     schema_k8sio_api_core_v1_NodeList(ref),
-		"k8s.io/api/core/v1.NodeProxyOptions":                                                             schema_k8sio_api_core_v1_NodeProxyOptions(ref),
-		"k8s.io/api/core/v1.NodeRuntimeHandler":                                                           schema_k8sio_api_core_v1_NodeRuntimeHandler(ref),
-		"k8s.io/api/core/v1.NodeRuntimeHandlerFeatures":                                                   schema_k8sio_api_core_v1_NodeRuntimeHandlerFeatures(ref),
-		"k8s.io/api/core/v1.NodeSelector":                                                                 schema_k8sio_api_core_v1_NodeSelector(ref),
-		"k8s.io/api/core/v1.NodeSelectorRequirement":                                                      schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
-		"k8s.io/api/core/v1.NodeSelectorTerm":                                                             schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
-		"k8s.io/api/core/v1.NodeSpec":                                                                     schema_k8sio_api_core_v1_NodeSpec(ref),
-		"k8s.io/api/core/v1.NodeStatus":                                                                   schema_k8sio_api_core_v1_NodeStatus(ref),
-		"k8s.io/api/core/v1.NodeSwapStatus":                                                               schema_k8sio_api_core_v1_NodeSwapStatus(ref),
-		"k8s.io/api/core/v1.NodeSystemInfo":                                                               schema_k8sio_api_core_v1_NodeSystemInfo(ref),
-		"k8s.io/api/core/v1.ObjectFieldSelector":                                                          schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
-		"k8s.io/api/core/v1.ObjectReference":                                                              schema_k8sio_api_core_v1_ObjectReference(ref),
-		"k8s.io/api/core/v1.PersistentVolume":                                                             schema_k8sio_api_core_v1_PersistentVolume(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaim":                                                        schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                               schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                                    schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                                    schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                                  schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimTemplate":                                                schema_k8sio_api_core_v1_PersistentVolumeClaimTemplate(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                            schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeList":                                                         schema_k8sio_api_core_v1_PersistentVolumeList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSource":                                                       schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSpec":                                                         schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeStatus":                                                       schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
-		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                             schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.Pod":                                                                          schema_k8sio_api_core_v1_Pod(ref),
-		"k8s.io/api/core/v1.PodAffinity":                                                                  schema_k8sio_api_core_v1_PodAffinity(ref),
-		"k8s.io/api/core/v1.PodAffinityTerm":                                                              schema_k8sio_api_core_v1_PodAffinityTerm(ref),
-		"k8s.io/api/core/v1.PodAntiAffinity":                                                              schema_k8sio_api_core_v1_PodAntiAffinity(ref),
-		"k8s.io/api/core/v1.PodAttachOptions":                                                             schema_k8sio_api_core_v1_PodAttachOptions(ref),
-		"k8s.io/api/core/v1.PodCertificateProjection":                                                     schema_k8sio_api_core_v1_PodCertificateProjection(ref),
-		"k8s.io/api/core/v1.PodCondition":                                                                 schema_k8sio_api_core_v1_PodCondition(ref),
-		"k8s.io/api/core/v1.PodDNSConfig":                                                                 schema_k8sio_api_core_v1_PodDNSConfig(ref),
-		"k8s.io/api/core/v1.PodDNSConfigOption":                                                           schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
-		"k8s.io/api/core/v1.PodExecOptions":                                                               schema_k8sio_api_core_v1_PodExecOptions(ref),
-		"k8s.io/api/core/v1.PodExtendedResourceClaimStatus":                                               schema_k8sio_api_core_v1_PodExtendedResourceClaimStatus(ref),
-		"k8s.io/api/core/v1.PodIP":                                                                        schema_k8sio_api_core_v1_PodIP(ref),
-		"k8s.io/api/core/v1.PodList":                                                                      schema_k8sio_api_core_v1_PodList(ref),
-		"k8s.io/api/core/v1.PodLogOptions":                                                                schema_k8sio_api_core_v1_PodLogOptions(ref),
-		"k8s.io/api/core/v1.PodOS":                                                                        schema_k8sio_api_core_v1_PodOS(ref),
-		"k8s.io/api/core/v1.PodPortForwardOptions":                                                        schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
-		"k8s.io/api/core/v1.PodProxyOptions":                                                              schema_k8sio_api_core_v1_PodProxyOptions(ref),
-		"k8s.io/api/core/v1.PodReadinessGate":                                                             schema_k8sio_api_core_v1_PodReadinessGate(ref),
-		"k8s.io/api/core/v1.PodResourceClaim":                                                             schema_k8sio_api_core_v1_PodResourceClaim(ref),
-		"k8s.io/api/core/v1.PodResourceClaimStatus":                                                       schema_k8sio_api_core_v1_PodResourceClaimStatus(ref),
-		"k8s.io/api/core/v1.PodSchedulingGate":                                                            schema_k8sio_api_core_v1_PodSchedulingGate(ref),
-		"k8s.io/api/core/v1.PodSecurityContext":                                                           schema_k8sio_api_core_v1_PodSecurityContext(ref),
-		"k8s.io/api/core/v1.PodSignature":                                                                 schema_k8sio_api_core_v1_PodSignature(ref),
-		"k8s.io/api/core/v1.PodSpec":                                                                      schema_k8sio_api_core_v1_PodSpec(ref),
-		"k8s.io/api/core/v1.PodStatus":                                                                    schema_k8sio_api_core_v1_PodStatus(ref),
-		"k8s.io/api/core/v1.PodStatusResult":                                                              schema_k8sio_api_core_v1_PodStatusResult(ref),
-		"k8s.io/api/core/v1.PodTemplate":                                                                  schema_k8sio_api_core_v1_PodTemplate(ref),
-		"k8s.io/api/core/v1.PodTemplateList":                                                              schema_k8sio_api_core_v1_PodTemplateList(ref),
-		"k8s.io/api/core/v1.PodTemplateSpec":                                                              schema_k8sio_api_core_v1_PodTemplateSpec(ref),
-		"k8s.io/api/core/v1.PortStatus":                                                                   schema_k8sio_api_core_v1_PortStatus(ref),
-		"k8s.io/api/core/v1.PortworxVolumeSource":                                                         schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
-		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                                         schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
-		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                                      schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
-		"k8s.io/api/core/v1.Probe":                                                                        schema_k8sio_api_core_v1_Probe(ref),
-		"k8s.io/api/core/v1.ProbeHandler":                                                                 schema_k8sio_api_core_v1_ProbeHandler(ref),
-		"k8s.io/api/core/v1.ProjectedVolumeSource":                                                        schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
-		"k8s.io/api/core/v1.QuobyteVolumeSource":                                                          schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                                    schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDVolumeSource":                                                              schema_k8sio_api_core_v1_RBDVolumeSource(ref),
-		"k8s.io/api/core/v1.RangeAllocation":                                                              schema_k8sio_api_core_v1_RangeAllocation(ref),
-		"k8s.io/api/core/v1.ReplicationController":                                                        schema_k8sio_api_core_v1_ReplicationController(ref),
-		"k8s.io/api/core/v1.ReplicationControllerCondition":                                               schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
-		"k8s.io/api/core/v1.ReplicationControllerList":                                                    schema_k8sio_api_core_v1_ReplicationControllerList(ref),
-		"k8s.io/api/core/v1.ReplicationControllerSpec":                                                    schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
-		"k8s.io/api/core/v1.ReplicationControllerStatus":                                                  schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
-		"k8s.io/api/core/v1.ResourceClaim":                                                                schema_k8sio_api_core_v1_ResourceClaim(ref),
-		"k8s.io/api/core/v1.ResourceFieldSelector":                                                        schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
-		"k8s.io/api/core/v1.ResourceHealth":                                                               schema_k8sio_api_core_v1_ResourceHealth(ref),
-		"k8s.io/api/core/v1.ResourceQuota":                                                                schema_k8sio_api_core_v1_ResourceQuota(ref),
-		"k8s.io/api/core/v1.ResourceQuotaList":                                                            schema_k8sio_api_core_v1_ResourceQuotaList(ref),
-		"k8s.io/api/core/v1.ResourceQuotaSpec":                                                            schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
-		"k8s.io/api/core/v1.ResourceQuotaStatus":                                                          schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
-		"k8s.io/api/core/v1.ResourceRequirements":                                                         schema_k8sio_api_core_v1_ResourceRequirements(ref),
-		"k8s.io/api/core/v1.ResourceStatus":                                                               schema_k8sio_api_core_v1_ResourceStatus(ref),
-		"k8s.io/api/core/v1.SELinuxOptions":                                                               schema_k8sio_api_core_v1_SELinuxOptions(ref),
-		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                                schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                                          schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
-		"k8s.io/api/core/v1.ScopeSelector":                                                                schema_k8sio_api_core_v1_ScopeSelector(ref),
-		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                                            schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
-		"k8s.io/api/core/v1.SeccompProfile":                                                               schema_k8sio_api_core_v1_SeccompProfile(ref),
-		"k8s.io/api/core/v1.Secret":                                                                       schema_k8sio_api_core_v1_Secret(ref),
-		"k8s.io/api/core/v1.SecretEnvSource":                                                              schema_k8sio_api_core_v1_SecretEnvSource(ref),
-		"k8s.io/api/core/v1.SecretKeySelector":                                                            schema_k8sio_api_core_v1_SecretKeySelector(ref),
-		"k8s.io/api/core/v1.SecretList":                                                                   schema_k8sio_api_core_v1_SecretList(ref),
-		"k8s.io/api/core/v1.SecretProjection":                                                             schema_k8sio_api_core_v1_SecretProjection(ref),
-		"k8s.io/api/core/v1.SecretReference":                                                              schema_k8sio_api_core_v1_SecretReference(ref),
-		"k8s.io/api/core/v1.SecretVolumeSource":                                                           schema_k8sio_api_core_v1_SecretVolumeSource(ref),
-		"k8s.io/api/core/v1.SecurityContext":                                                              schema_k8sio_api_core_v1_SecurityContext(ref),
-		"k8s.io/api/core/v1.SerializedReference":                                                          schema_k8sio_api_core_v1_SerializedReference(ref),
-		"k8s.io/api/core/v1.Service":                                                                      schema_k8sio_api_core_v1_Service(ref),
-		"k8s.io/api/core/v1.ServiceAccount":                                                               schema_k8sio_api_core_v1_ServiceAccount(ref),
-		"k8s.io/api/core/v1.ServiceAccountList":                                                           schema_k8sio_api_core_v1_ServiceAccountList(ref),
-		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                                schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
-		"k8s.io/api/core/v1.ServiceList":                                                                  schema_k8sio_api_core_v1_ServiceList(ref),
-		"k8s.io/api/core/v1.ServicePort":                                                                  schema_k8sio_api_core_v1_ServicePort(ref),
-		"k8s.io/api/core/v1.ServiceProxyOptions":                                                          schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
-		"k8s.io/api/core/v1.ServiceSpec":                                                                  schema_k8sio_api_core_v1_ServiceSpec(ref),
-		"k8s.io/api/core/v1.ServiceStatus":                                                                schema_k8sio_api_core_v1_ServiceStatus(ref),
-		"k8s.io/api/core/v1.SessionAffinityConfig":                                                        schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
-		"k8s.io/api/core/v1.SleepAction":                                                                  schema_k8sio_api_core_v1_SleepAction(ref),
-		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                              schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.StorageOSVolumeSource":                                                        schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
-		"k8s.io/api/core/v1.Sysctl":                                                                       schema_k8sio_api_core_v1_Sysctl(ref),
-		"k8s.io/api/core/v1.TCPSocketAction":                                                              schema_k8sio_api_core_v1_TCPSocketAction(ref),
-		"k8s.io/api/core/v1.Taint":                                                                        schema_k8sio_api_core_v1_Taint(ref),
-		"k8s.io/api/core/v1.Toleration":                                                                   schema_k8sio_api_core_v1_Toleration(ref),
-		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                             schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
-		"k8s.io/api/core/v1.TopologySelectorTerm":                                                         schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
-		"k8s.io/api/core/v1.TopologySpreadConstraint":                                                     schema_k8sio_api_core_v1_TopologySpreadConstraint(ref),
-		"k8s.io/api/core/v1.TypedLocalObjectReference":                                                    schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
-		"k8s.io/api/core/v1.TypedObjectReference":                                                         schema_k8sio_api_core_v1_TypedObjectReference(ref),
-		"k8s.io/api/core/v1.Volume":                                                                       schema_k8sio_api_core_v1_Volume(ref),
-		"k8s.io/api/core/v1.VolumeDevice":                                                                 schema_k8sio_api_core_v1_VolumeDevice(ref),
-		"k8s.io/api/core/v1.VolumeMount":                                                                  schema_k8sio_api_core_v1_VolumeMount(ref),
-		"k8s.io/api/core/v1.VolumeMountStatus":                                                            schema_k8sio_api_core_v1_VolumeMountStatus(ref),
-		"k8s.io/api/core/v1.VolumeNodeAffinity":                                                           schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
-		"k8s.io/api/core/v1.VolumeProjection":                                                             schema_k8sio_api_core_v1_VolumeProjection(ref),
-		"k8s.io/api/core/v1.VolumeResourceRequirements":                                                   schema_k8sio_api_core_v1_VolumeResourceRequirements(ref),
-		"k8s.io/api/core/v1.VolumeSource":                                                                 schema_k8sio_api_core_v1_VolumeSource(ref),
-		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                               schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                                      schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
-		"k8s.io/api/core/v1.WindowsSecurityContextOptions":                                                schema_k8sio_api_core_v1_WindowsSecurityContextOptions(ref),
-		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                                   schema_apimachinery_pkg_api_resource_Quantity(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                                   schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                               schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                                schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                            schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                                schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                                               schema_pkg_apis_meta_v1_ApplyOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                                  schema_pkg_apis_meta_v1_Condition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                              schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                              schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                                   schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":                                   schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                                   schema_pkg_apis_meta_v1_FieldsV1(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                                 schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                                  schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                              schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                               schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                                   schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                           schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                                       schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                              schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                              schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                                   schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                                       schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                                   schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                                schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                                         schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                                  schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                                 schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                             schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                                      schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                                  schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                                      schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                               schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                              schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                                  schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                                  schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                                     schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                                schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                              schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                                      schema_pkg_apis_meta_v1_Table(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                                      schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                                               schema_pkg_apis_meta_v1_TableOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                                   schema_pkg_apis_meta_v1_TableRow(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                                          schema_pkg_apis_meta_v1_TableRowCondition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                                       schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                                  schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                                   schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                              schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                                 schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                                    schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                        schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                         schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                                 schema_apimachinery_pkg_util_intstr_IntOrString(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                                            schema_k8sio_apimachinery_pkg_version_Info(ref),
-		"kubevirt.io/api/backup/v1alpha1.BackupCheckpoint":                                                schema_kubevirtio_api_backup_v1alpha1_BackupCheckpoint(ref),
-		"kubevirt.io/api/backup/v1alpha1.BackupOptions":                                                   schema_kubevirtio_api_backup_v1alpha1_BackupOptions(ref),
-		"kubevirt.io/api/backup/v1alpha1.BackupVolumeInfo":                                                schema_kubevirtio_api_backup_v1alpha1_BackupVolumeInfo(ref),
-		"kubevirt.io/api/backup/v1alpha1.Condition":                                                       schema_kubevirtio_api_backup_v1alpha1_Condition(ref),
-		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackup":                                            schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackup(ref),
-		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupList":                                        schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupList(ref),
-		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupSpec":                                        schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupSpec(ref),
-		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupStatus":                                      schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupStatus(ref),
-		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupTracker":                                     schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupTracker(ref),
-		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupTrackerList":                                 schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupTrackerList(ref),
-		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupTrackerSpec":                                 schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupTrackerSpec(ref),
-		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupTrackerStatus":                               schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupTrackerStatus(ref),
-		"kubevirt.io/api/core/v1.ACPI":                                                                    schema_kubevirtio_api_core_v1_ACPI(ref),
-		"kubevirt.io/api/core/v1.AccessCredential":                                                        schema_kubevirtio_api_core_v1_AccessCredential(ref),
-		"kubevirt.io/api/core/v1.AccessCredentialSecretSource":                                            schema_kubevirtio_api_core_v1_AccessCredentialSecretSource(ref),
-		"kubevirt.io/api/core/v1.AddVolumeOptions":                                                        schema_kubevirtio_api_core_v1_AddVolumeOptions(ref),
-		"kubevirt.io/api/core/v1.ArchConfiguration":                                                       schema_kubevirtio_api_core_v1_ArchConfiguration(ref),
-		"kubevirt.io/api/core/v1.ArchSpecificConfiguration":                                               schema_kubevirtio_api_core_v1_ArchSpecificConfiguration(ref),
-		"kubevirt.io/api/core/v1.AuthorizedKeysFile":                                                      schema_kubevirtio_api_core_v1_AuthorizedKeysFile(ref),
-		"kubevirt.io/api/core/v1.BIOS":                                                                    schema_kubevirtio_api_core_v1_BIOS(ref),
-		"kubevirt.io/api/core/v1.BlockSize":                                                               schema_kubevirtio_api_core_v1_BlockSize(ref),
-		"kubevirt.io/api/core/v1.Bootloader":                                                              schema_kubevirtio_api_core_v1_Bootloader(ref),
-		"kubevirt.io/api/core/v1.CDRomTarget":                                                             schema_kubevirtio_api_core_v1_CDRomTarget(ref),
-		"kubevirt.io/api/core/v1.CPU":                                                                     schema_kubevirtio_api_core_v1_CPU(ref),
-		"kubevirt.io/api/core/v1.CPUFeature":                                                              schema_kubevirtio_api_core_v1_CPUFeature(ref),
-		"kubevirt.io/api/core/v1.CPUTopology":                                                             schema_kubevirtio_api_core_v1_CPUTopology(ref),
-		"kubevirt.io/api/core/v1.CertConfig":                                                              schema_kubevirtio_api_core_v1_CertConfig(ref),
-		"kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors":                                           schema_kubevirtio_api_core_v1_ChangedBlockTrackingSelectors(ref),
-		"kubevirt.io/api/core/v1.ChangedBlockTrackingStatus":                                              schema_kubevirtio_api_core_v1_ChangedBlockTrackingStatus(ref),
-		"kubevirt.io/api/core/v1.Chassis":                                                                 schema_kubevirtio_api_core_v1_Chassis(ref),
-		"kubevirt.io/api/core/v1.ClaimRequest":                                                            schema_kubevirtio_api_core_v1_ClaimRequest(ref),
-		"kubevirt.io/api/core/v1.ClientPassthroughDevices":                                                schema_kubevirtio_api_core_v1_ClientPassthroughDevices(ref),
-		"kubevirt.io/api/core/v1.Clock":                                                                   schema_kubevirtio_api_core_v1_Clock(ref),
-		"kubevirt.io/api/core/v1.ClockOffset":                                                             schema_kubevirtio_api_core_v1_ClockOffset(ref),
-		"kubevirt.io/api/core/v1.ClockOffsetUTC":                                                          schema_kubevirtio_api_core_v1_ClockOffsetUTC(ref),
-		"kubevirt.io/api/core/v1.CloudInitConfigDriveSource":                                              schema_kubevirtio_api_core_v1_CloudInitConfigDriveSource(ref),
-		"kubevirt.io/api/core/v1.CloudInitNoCloudSource":                                                  schema_kubevirtio_api_core_v1_CloudInitNoCloudSource(ref),
-		"kubevirt.io/api/core/v1.ClusterProfilerRequest":                                                  schema_kubevirtio_api_core_v1_ClusterProfilerRequest(ref),
-		"kubevirt.io/api/core/v1.ClusterProfilerResults":                                                  schema_kubevirtio_api_core_v1_ClusterProfilerResults(ref),
-		"kubevirt.io/api/core/v1.CommonInstancetypesDeployment":                                           schema_kubevirtio_api_core_v1_CommonInstancetypesDeployment(ref),
-		"kubevirt.io/api/core/v1.ComponentConfig":                                                         schema_kubevirtio_api_core_v1_ComponentConfig(ref),
-		"kubevirt.io/api/core/v1.ConfigDriveSSHPublicKeyAccessCredentialPropagation":                      schema_kubevirtio_api_core_v1_ConfigDriveSSHPublicKeyAccessCredentialPropagation(ref),
-		"kubevirt.io/api/core/v1.ConfigMapVolumeSource":                                                   schema_kubevirtio_api_core_v1_ConfigMapVolumeSource(ref),
-		"kubevirt.io/api/core/v1.ContainerDiskInfo":                                                       schema_kubevirtio_api_core_v1_ContainerDiskInfo(ref),
-		"kubevirt.io/api/core/v1.ContainerDiskSource":                                                     schema_kubevirtio_api_core_v1_ContainerDiskSource(ref),
-		"kubevirt.io/api/core/v1.ContainerPathVolumeSource":                                               schema_kubevirtio_api_core_v1_ContainerPathVolumeSource(ref),
-		"kubevirt.io/api/core/v1.ControllerRevisionRef":                                                   schema_kubevirtio_api_core_v1_ControllerRevisionRef(ref),
-		"kubevirt.io/api/core/v1.CustomBlockSize":                                                         schema_kubevirtio_api_core_v1_CustomBlockSize(ref),
-		"kubevirt.io/api/core/v1.CustomProfile":                                                           schema_kubevirtio_api_core_v1_CustomProfile(ref),
-		"kubevirt.io/api/core/v1.CustomizeComponents":                                                     schema_kubevirtio_api_core_v1_CustomizeComponents(ref),
-		"kubevirt.io/api/core/v1.CustomizeComponentsPatch":                                                schema_kubevirtio_api_core_v1_CustomizeComponentsPatch(ref),
-		"kubevirt.io/api/core/v1.DHCPOptions":                                                             schema_kubevirtio_api_core_v1_DHCPOptions(ref),
-		"kubevirt.io/api/core/v1.DHCPPrivateOptions":                                                      schema_kubevirtio_api_core_v1_DHCPPrivateOptions(ref),
-		"kubevirt.io/api/core/v1.DataVolumeSource":                                                        schema_kubevirtio_api_core_v1_DataVolumeSource(ref),
-		"kubevirt.io/api/core/v1.DataVolumeTemplateDummyStatus":                                           schema_kubevirtio_api_core_v1_DataVolumeTemplateDummyStatus(ref),
-		"kubevirt.io/api/core/v1.DataVolumeTemplateSpec":                                                  schema_kubevirtio_api_core_v1_DataVolumeTemplateSpec(ref),
-		"kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap":                                              schema_kubevirtio_api_core_v1_DeprecatedInterfaceMacvtap(ref),
-		"kubevirt.io/api/core/v1.DeprecatedInterfacePasst":                                                schema_kubevirtio_api_core_v1_DeprecatedInterfacePasst(ref),
-		"kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp":                                                schema_kubevirtio_api_core_v1_DeprecatedInterfaceSlirp(ref),
-		"kubevirt.io/api/core/v1.DeveloperConfiguration":                                                  schema_kubevirtio_api_core_v1_DeveloperConfiguration(ref),
-		"kubevirt.io/api/core/v1.Devices":                                                                 schema_kubevirtio_api_core_v1_Devices(ref),
-		"kubevirt.io/api/core/v1.Diag288Watchdog":                                                         schema_kubevirtio_api_core_v1_Diag288Watchdog(ref),
-		"kubevirt.io/api/core/v1.DisableFreePageReporting":                                                schema_kubevirtio_api_core_v1_DisableFreePageReporting(ref),
-		"kubevirt.io/api/core/v1.DisableSerialConsoleLog":                                                 schema_kubevirtio_api_core_v1_DisableSerialConsoleLog(ref),
-		"kubevirt.io/api/core/v1.Disk":                                                                    schema_kubevirtio_api_core_v1_Disk(ref),
-		"kubevirt.io/api/core/v1.DiskDevice":                                                              schema_kubevirtio_api_core_v1_DiskDevice(ref),
-		"kubevirt.io/api/core/v1.DiskIOThreads":                                                           schema_kubevirtio_api_core_v1_DiskIOThreads(ref),
-		"kubevirt.io/api/core/v1.DiskTarget":                                                              schema_kubevirtio_api_core_v1_DiskTarget(ref),
-		"kubevirt.io/api/core/v1.DiskVerification":                                                        schema_kubevirtio_api_core_v1_DiskVerification(ref),
-		"kubevirt.io/api/core/v1.DomainMemoryDumpInfo":                                                    schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref),
-		"kubevirt.io/api/core/v1.DomainSpec":                                                              schema_kubevirtio_api_core_v1_DomainSpec(ref),
-		"kubevirt.io/api/core/v1.DownwardAPIVolumeSource":                                                 schema_kubevirtio_api_core_v1_DownwardAPIVolumeSource(ref),
-		"kubevirt.io/api/core/v1.DownwardMetrics":                                                         schema_kubevirtio_api_core_v1_DownwardMetrics(ref),
-		"kubevirt.io/api/core/v1.DownwardMetricsVolumeSource":                                             schema_kubevirtio_api_core_v1_DownwardMetricsVolumeSource(ref),
-		"kubevirt.io/api/core/v1.EFI":                                                                     schema_kubevirtio_api_core_v1_EFI(ref),
-		"kubevirt.io/api/core/v1.EmptyDiskSource":                                                         schema_kubevirtio_api_core_v1_EmptyDiskSource(ref),
-		"kubevirt.io/api/core/v1.EphemeralVolumeSource":                                                   schema_kubevirtio_api_core_v1_EphemeralVolumeSource(ref),
-		"kubevirt.io/api/core/v1.EvacuateCancelOptions":                                                   schema_kubevirtio_api_core_v1_EvacuateCancelOptions(ref),
-		"kubevirt.io/api/core/v1.FeatureAPIC":                                                             schema_kubevirtio_api_core_v1_FeatureAPIC(ref),
-		"kubevirt.io/api/core/v1.FeatureHyperv":                                                           schema_kubevirtio_api_core_v1_FeatureHyperv(ref),
-		"kubevirt.io/api/core/v1.FeatureKVM":                                                              schema_kubevirtio_api_core_v1_FeatureKVM(ref),
-		"kubevirt.io/api/core/v1.FeatureSpinlocks":                                                        schema_kubevirtio_api_core_v1_FeatureSpinlocks(ref),
-		"kubevirt.io/api/core/v1.FeatureState":                                                            schema_kubevirtio_api_core_v1_FeatureState(ref),
-		"kubevirt.io/api/core/v1.FeatureVendorID":                                                         schema_kubevirtio_api_core_v1_FeatureVendorID(ref),
-		"kubevirt.io/api/core/v1.Features":                                                                schema_kubevirtio_api_core_v1_Features(ref),
-		"kubevirt.io/api/core/v1.Filesystem":                                                              schema_kubevirtio_api_core_v1_Filesystem(ref),
-		"kubevirt.io/api/core/v1.FilesystemVirtiofs":                                                      schema_kubevirtio_api_core_v1_FilesystemVirtiofs(ref),
-		"kubevirt.io/api/core/v1.Firmware":                                                                schema_kubevirtio_api_core_v1_Firmware(ref),
-		"kubevirt.io/api/core/v1.Flags":                                                                   schema_kubevirtio_api_core_v1_Flags(ref),
-		"kubevirt.io/api/core/v1.FreezeUnfreezeTimeout":                                                   schema_kubevirtio_api_core_v1_FreezeUnfreezeTimeout(ref),
-		"kubevirt.io/api/core/v1.GPU":                                                                     schema_kubevirtio_api_core_v1_GPU(ref),
-		"kubevirt.io/api/core/v1.GenerationStatus":                                                        schema_kubevirtio_api_core_v1_GenerationStatus(ref),
-		"kubevirt.io/api/core/v1.GuestAgentCommandInfo":                                                   schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref),
-		"kubevirt.io/api/core/v1.GuestAgentPing":                                                          schema_kubevirtio_api_core_v1_GuestAgentPing(ref),
-		"kubevirt.io/api/core/v1.HPETTimer":                                                               schema_kubevirtio_api_core_v1_HPETTimer(ref),
-		"kubevirt.io/api/core/v1.Handler":                                                                 schema_kubevirtio_api_core_v1_Handler(ref),
-		"kubevirt.io/api/core/v1.HostDevice":                                                              schema_kubevirtio_api_core_v1_HostDevice(ref),
-		"kubevirt.io/api/core/v1.HostDisk":                                                                schema_kubevirtio_api_core_v1_HostDisk(ref),
-		"kubevirt.io/api/core/v1.HotplugVolumeSource":                                                     schema_kubevirtio_api_core_v1_HotplugVolumeSource(ref),
-		"kubevirt.io/api/core/v1.HotplugVolumeStatus":                                                     schema_kubevirtio_api_core_v1_HotplugVolumeStatus(ref),
-		"kubevirt.io/api/core/v1.Hugepages":                                                               schema_kubevirtio_api_core_v1_Hugepages(ref),
-		"kubevirt.io/api/core/v1.HyperVPassthrough":                                                       schema_kubevirtio_api_core_v1_HyperVPassthrough(ref),
-		"kubevirt.io/api/core/v1.HypervTimer":                                                             schema_kubevirtio_api_core_v1_HypervTimer(ref),
-		"kubevirt.io/api/core/v1.HypervisorConfiguration":                                                 schema_kubevirtio_api_core_v1_HypervisorConfiguration(ref),
-		"kubevirt.io/api/core/v1.I6300ESBWatchdog":                                                        schema_kubevirtio_api_core_v1_I6300ESBWatchdog(ref),
-		"kubevirt.io/api/core/v1.InitrdInfo":                                                              schema_kubevirtio_api_core_v1_InitrdInfo(ref),
-		"kubevirt.io/api/core/v1.Input":                                                                   schema_kubevirtio_api_core_v1_Input(ref),
-		"kubevirt.io/api/core/v1.InstancetypeConfiguration":                                               schema_kubevirtio_api_core_v1_InstancetypeConfiguration(ref),
-		"kubevirt.io/api/core/v1.InstancetypeMatcher":                                                     schema_kubevirtio_api_core_v1_InstancetypeMatcher(ref),
-		"kubevirt.io/api/core/v1.InstancetypeStatusRef":                                                   schema_kubevirtio_api_core_v1_InstancetypeStatusRef(ref),
-		"kubevirt.io/api/core/v1.Interface":                                                               schema_kubevirtio_api_core_v1_Interface(ref),
-		"kubevirt.io/api/core/v1.InterfaceBindingMethod":                                                  schema_kubevirtio_api_core_v1_InterfaceBindingMethod(ref),
-		"kubevirt.io/api/core/v1.InterfaceBindingMigration":                                               schema_kubevirtio_api_core_v1_InterfaceBindingMigration(ref),
-		"kubevirt.io/api/core/v1.InterfaceBindingPlugin":                                                  schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref),
-		"kubevirt.io/api/core/v1.InterfaceBridge":                                                         schema_kubevirtio_api_core_v1_InterfaceBridge(ref),
-		"kubevirt.io/api/core/v1.InterfaceMasquerade":                                                     schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref),
-		"kubevirt.io/api/core/v1.InterfacePasstBinding":                                                   schema_kubevirtio_api_core_v1_InterfacePasstBinding(ref),
-		"kubevirt.io/api/core/v1.InterfaceSRIOV":                                                          schema_kubevirtio_api_core_v1_InterfaceSRIOV(ref),
-		"kubevirt.io/api/core/v1.KSMConfiguration":                                                        schema_kubevirtio_api_core_v1_KSMConfiguration(ref),
-		"kubevirt.io/api/core/v1.KVMTimer":                                                                schema_kubevirtio_api_core_v1_KVMTimer(ref),
-		"kubevirt.io/api/core/v1.KernelBoot":                                                              schema_kubevirtio_api_core_v1_KernelBoot(ref),
-		"kubevirt.io/api/core/v1.KernelBootContainer":                                                     schema_kubevirtio_api_core_v1_KernelBootContainer(ref),
-		"kubevirt.io/api/core/v1.KernelBootStatus":                                                        schema_kubevirtio_api_core_v1_KernelBootStatus(ref),
-		"kubevirt.io/api/core/v1.KernelInfo":                                                              schema_kubevirtio_api_core_v1_KernelInfo(ref),
-		"kubevirt.io/api/core/v1.KubeVirt":                                                                schema_kubevirtio_api_core_v1_KubeVirt(ref),
-		"kubevirt.io/api/core/v1.KubeVirtCertificateRotateStrategy":                                       schema_kubevirtio_api_core_v1_KubeVirtCertificateRotateStrategy(ref),
-		"kubevirt.io/api/core/v1.KubeVirtCondition":                                                       schema_kubevirtio_api_core_v1_KubeVirtCondition(ref),
-		"kubevirt.io/api/core/v1.KubeVirtConfiguration":                                                   schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref),
-		"kubevirt.io/api/core/v1.KubeVirtList":                                                            schema_kubevirtio_api_core_v1_KubeVirtList(ref),
-		"kubevirt.io/api/core/v1.KubeVirtSelfSignConfiguration":                                           schema_kubevirtio_api_core_v1_KubeVirtSelfSignConfiguration(ref),
-		"kubevirt.io/api/core/v1.KubeVirtSpec":                                                            schema_kubevirtio_api_core_v1_KubeVirtSpec(ref),
-		"kubevirt.io/api/core/v1.KubeVirtStatus":                                                          schema_kubevirtio_api_core_v1_KubeVirtStatus(ref),
-		"kubevirt.io/api/core/v1.KubeVirtWorkloadUpdateStrategy":                                          schema_kubevirtio_api_core_v1_KubeVirtWorkloadUpdateStrategy(ref),
-		"kubevirt.io/api/core/v1.LaunchSecurity":                                                          schema_kubevirtio_api_core_v1_LaunchSecurity(ref),
-		"kubevirt.io/api/core/v1.LiveUpdateConfiguration":                                                 schema_kubevirtio_api_core_v1_LiveUpdateConfiguration(ref),
-		"kubevirt.io/api/core/v1.LogVerbosity":                                                            schema_kubevirtio_api_core_v1_LogVerbosity(ref),
-		"kubevirt.io/api/core/v1.LunTarget":                                                               schema_kubevirtio_api_core_v1_LunTarget(ref),
-		"kubevirt.io/api/core/v1.Machine":                                                                 schema_kubevirtio_api_core_v1_Machine(ref),
-		"kubevirt.io/api/core/v1.MediatedDevicesConfiguration":                                            schema_kubevirtio_api_core_v1_MediatedDevicesConfiguration(ref),
-		"kubevirt.io/api/core/v1.MediatedHostDevice":                                                      schema_kubevirtio_api_core_v1_MediatedHostDevice(ref),
-		"kubevirt.io/api/core/v1.Memory":                                                                  schema_kubevirtio_api_core_v1_Memory(ref),
-		"kubevirt.io/api/core/v1.MemoryDumpVolumeSource":                                                  schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref),
-		"kubevirt.io/api/core/v1.MemoryStatus":                                                            schema_kubevirtio_api_core_v1_MemoryStatus(ref),
-		"kubevirt.io/api/core/v1.MigrateOptions":                                                          schema_kubevirtio_api_core_v1_MigrateOptions(ref),
-		"kubevirt.io/api/core/v1.MigrationConfiguration":                                                  schema_kubevirtio_api_core_v1_MigrationConfiguration(ref),
-		"kubevirt.io/api/core/v1.MultusNetwork":                                                           schema_kubevirtio_api_core_v1_MultusNetwork(ref),
-		"kubevirt.io/api/core/v1.NUMA":                                                                    schema_kubevirtio_api_core_v1_NUMA(ref),
-		"kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough":                                             schema_kubevirtio_api_core_v1_NUMAGuestMappingPassthrough(ref),
-		"kubevirt.io/api/core/v1.Network":                                                                 schema_kubevirtio_api_core_v1_Network(ref),
-		"kubevirt.io/api/core/v1.NetworkConfiguration":                                                    schema_kubevirtio_api_core_v1_NetworkConfiguration(ref),
-		"kubevirt.io/api/core/v1.NetworkSource":                                                           schema_kubevirtio_api_core_v1_NetworkSource(ref),
-		"kubevirt.io/api/core/v1.NoCloudSSHPublicKeyAccessCredentialPropagation":                          schema_kubevirtio_api_core_v1_NoCloudSSHPublicKeyAccessCredentialPropagation(ref),
-		"kubevirt.io/api/core/v1.NodeMediatedDeviceTypesConfig":                                           schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref),
-		"kubevirt.io/api/core/v1.NodePlacement":                                                           schema_kubevirtio_api_core_v1_NodePlacement(ref),
-		"kubevirt.io/api/core/v1.ObjectGraphNode":                                                         schema_kubevirtio_api_core_v1_ObjectGraphNode(ref),
-		"kubevirt.io/api/core/v1.ObjectGraphOptions":                                                      schema_kubevirtio_api_core_v1_ObjectGraphOptions(ref),
-		"kubevirt.io/api/core/v1.PITTimer":                                                                schema_kubevirtio_api_core_v1_PITTimer(ref),
-		"kubevirt.io/api/core/v1.PanicDevice":                                                             schema_kubevirtio_api_core_v1_PanicDevice(ref),
-		"kubevirt.io/api/core/v1.PauseOptions":                                                            schema_kubevirtio_api_core_v1_PauseOptions(ref),
-		"kubevirt.io/api/core/v1.PciHostDevice":                                                           schema_kubevirtio_api_core_v1_PciHostDevice(ref),
-		"kubevirt.io/api/core/v1.PermittedHostDevices":                                                    schema_kubevirtio_api_core_v1_PermittedHostDevices(ref),
-		"kubevirt.io/api/core/v1.PersistentVolumeClaimInfo":                                               schema_kubevirtio_api_core_v1_PersistentVolumeClaimInfo(ref),
-		"kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                       schema_kubevirtio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
-		"kubevirt.io/api/core/v1.PluginBinding":                                                           schema_kubevirtio_api_core_v1_PluginBinding(ref),
-		"kubevirt.io/api/core/v1.PodNetwork":                                                              schema_kubevirtio_api_core_v1_PodNetwork(ref),
-		"kubevirt.io/api/core/v1.Port":                                                                    schema_kubevirtio_api_core_v1_Port(ref),
-		"kubevirt.io/api/core/v1.PreferenceMatcher":                                                       schema_kubevirtio_api_core_v1_PreferenceMatcher(ref),
-		"kubevirt.io/api/core/v1.Probe":                                                                   schema_kubevirtio_api_core_v1_Probe(ref),
-		"kubevirt.io/api/core/v1.ProfilerResult":                                                          schema_kubevirtio_api_core_v1_ProfilerResult(ref),
-		"kubevirt.io/api/core/v1.QemuGuestAgentSSHPublicKeyAccessCredentialPropagation":                   schema_kubevirtio_api_core_v1_QemuGuestAgentSSHPublicKeyAccessCredentialPropagation(ref),
-		"kubevirt.io/api/core/v1.QemuGuestAgentUserPasswordAccessCredentialPropagation":                   schema_kubevirtio_api_core_v1_QemuGuestAgentUserPasswordAccessCredentialPropagation(ref),
-		"kubevirt.io/api/core/v1.RESTClientConfiguration":                                                 schema_kubevirtio_api_core_v1_RESTClientConfiguration(ref),
-		"kubevirt.io/api/core/v1.RTCTimer":                                                                schema_kubevirtio_api_core_v1_RTCTimer(ref),
-		"kubevirt.io/api/core/v1.RateLimiter":                                                             schema_kubevirtio_api_core_v1_RateLimiter(ref),
-		"kubevirt.io/api/core/v1.Realtime":                                                                schema_kubevirtio_api_core_v1_Realtime(ref),
-		"kubevirt.io/api/core/v1.ReloadableComponentConfiguration":                                        schema_kubevirtio_api_core_v1_ReloadableComponentConfiguration(ref),
-		"kubevirt.io/api/core/v1.RemoveVolumeOptions":                                                     schema_kubevirtio_api_core_v1_RemoveVolumeOptions(ref),
-		"kubevirt.io/api/core/v1.ReservedOverhead":                                                        schema_kubevirtio_api_core_v1_ReservedOverhead(ref),
-		"kubevirt.io/api/core/v1.ResourceRequirements":                                                    schema_kubevirtio_api_core_v1_ResourceRequirements(ref),
-		"kubevirt.io/api/core/v1.ResourceRequirementsWithoutClaims":                                       schema_kubevirtio_api_core_v1_ResourceRequirementsWithoutClaims(ref),
-		"kubevirt.io/api/core/v1.RestartOptions":                                                          schema_kubevirtio_api_core_v1_RestartOptions(ref),
-		"kubevirt.io/api/core/v1.Rng":                                                                     schema_kubevirtio_api_core_v1_Rng(ref),
-		"kubevirt.io/api/core/v1.SEV":                                                                     schema_kubevirtio_api_core_v1_SEV(ref),
-		"kubevirt.io/api/core/v1.SEVAttestation":                                                          schema_kubevirtio_api_core_v1_SEVAttestation(ref),
-		"kubevirt.io/api/core/v1.SEVMeasurementInfo":                                                      schema_kubevirtio_api_core_v1_SEVMeasurementInfo(ref),
-		"kubevirt.io/api/core/v1.SEVPlatformInfo":                                                         schema_kubevirtio_api_core_v1_SEVPlatformInfo(ref),
-		"kubevirt.io/api/core/v1.SEVPolicy":                                                               schema_kubevirtio_api_core_v1_SEVPolicy(ref),
-		"kubevirt.io/api/core/v1.SEVSNP":                                                                  schema_kubevirtio_api_core_v1_SEVSNP(ref),
-		"kubevirt.io/api/core/v1.SEVSecretOptions":                                                        schema_kubevirtio_api_core_v1_SEVSecretOptions(ref),
-		"kubevirt.io/api/core/v1.SEVSessionOptions":                                                       schema_kubevirtio_api_core_v1_SEVSessionOptions(ref),
-		"kubevirt.io/api/core/v1.SMBiosConfiguration":                                                     schema_kubevirtio_api_core_v1_SMBiosConfiguration(ref),
-		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredential":                                            schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredential(ref),
-		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredentialPropagationMethod":                           schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredentialPropagationMethod(ref),
-		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredentialSource":                                      schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredentialSource(ref),
-		"kubevirt.io/api/core/v1.ScreenshotOptions":                                                       schema_kubevirtio_api_core_v1_ScreenshotOptions(ref),
-		"kubevirt.io/api/core/v1.SeccompConfiguration":                                                    schema_kubevirtio_api_core_v1_SeccompConfiguration(ref),
-		"kubevirt.io/api/core/v1.SecretVolumeSource":                                                      schema_kubevirtio_api_core_v1_SecretVolumeSource(ref),
-		"kubevirt.io/api/core/v1.ServiceAccountVolumeSource":                                              schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref),
-		"kubevirt.io/api/core/v1.SoundDevice":                                                             schema_kubevirtio_api_core_v1_SoundDevice(ref),
-		"kubevirt.io/api/core/v1.StartOptions":                                                            schema_kubevirtio_api_core_v1_StartOptions(ref),
-		"kubevirt.io/api/core/v1.StopOptions":                                                             schema_kubevirtio_api_core_v1_StopOptions(ref),
-		"kubevirt.io/api/core/v1.StorageMigratedVolumeInfo":                                               schema_kubevirtio_api_core_v1_StorageMigratedVolumeInfo(ref),
-		"kubevirt.io/api/core/v1.SupportContainerResources":                                               schema_kubevirtio_api_core_v1_SupportContainerResources(ref),
-		"kubevirt.io/api/core/v1.SyNICTimer":                                                              schema_kubevirtio_api_core_v1_SyNICTimer(ref),
-		"kubevirt.io/api/core/v1.SysprepSource":                                                           schema_kubevirtio_api_core_v1_SysprepSource(ref),
-		"kubevirt.io/api/core/v1.TDX":                                                                     schema_kubevirtio_api_core_v1_TDX(ref),
-		"kubevirt.io/api/core/v1.TLBFlush":                                                                schema_kubevirtio_api_core_v1_TLBFlush(ref),
-		"kubevirt.io/api/core/v1.TLSConfiguration":                                                        schema_kubevirtio_api_core_v1_TLSConfiguration(ref),
-		"kubevirt.io/api/core/v1.TPMDevice":                                                               schema_kubevirtio_api_core_v1_TPMDevice(ref),
-		"kubevirt.io/api/core/v1.Timer":                                                                   schema_kubevirtio_api_core_v1_Timer(ref),
-		"kubevirt.io/api/core/v1.TokenBucketRateLimiter":                                                  schema_kubevirtio_api_core_v1_TokenBucketRateLimiter(ref),
-		"kubevirt.io/api/core/v1.TopologyHints":                                                           schema_kubevirtio_api_core_v1_TopologyHints(ref),
-		"kubevirt.io/api/core/v1.USBHostDevice":                                                           schema_kubevirtio_api_core_v1_USBHostDevice(ref),
-		"kubevirt.io/api/core/v1.USBSelector":                                                             schema_kubevirtio_api_core_v1_USBSelector(ref),
-		"kubevirt.io/api/core/v1.UnpauseOptions":                                                          schema_kubevirtio_api_core_v1_UnpauseOptions(ref),
-		"kubevirt.io/api/core/v1.UserPasswordAccessCredential":                                            schema_kubevirtio_api_core_v1_UserPasswordAccessCredential(ref),
-		"kubevirt.io/api/core/v1.UserPasswordAccessCredentialPropagationMethod":                           schema_kubevirtio_api_core_v1_UserPasswordAccessCredentialPropagationMethod(ref),
-		"kubevirt.io/api/core/v1.UserPasswordAccessCredentialSource":                                      schema_kubevirtio_api_core_v1_UserPasswordAccessCredentialSource(ref),
-		"kubevirt.io/api/core/v1.UtilityVolume":                                                           schema_kubevirtio_api_core_v1_UtilityVolume(ref),
-		"kubevirt.io/api/core/v1.VGPUDisplayOptions":                                                      schema_kubevirtio_api_core_v1_VGPUDisplayOptions(ref),
-		"kubevirt.io/api/core/v1.VGPUOptions":                                                             schema_kubevirtio_api_core_v1_VGPUOptions(ref),
-		"kubevirt.io/api/core/v1.VMISelector":                                                             schema_kubevirtio_api_core_v1_VMISelector(ref),
-		"kubevirt.io/api/core/v1.VSOCKOptions":                                                            schema_kubevirtio_api_core_v1_VSOCKOptions(ref),
-		"kubevirt.io/api/core/v1.VideoDevice":                                                             schema_kubevirtio_api_core_v1_VideoDevice(ref),
-		"kubevirt.io/api/core/v1.VirtTemplateDeployment":                                                  schema_kubevirtio_api_core_v1_VirtTemplateDeployment(ref),
-		"kubevirt.io/api/core/v1.VirtualMachine":                                                          schema_kubevirtio_api_core_v1_VirtualMachine(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineCondition":                                                 schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstance":                                                  schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceBackupStatus":                                      schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupStatus(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceCommonMigrationState":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceCommonMigrationState(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceCondition":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemDisk":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemDisk(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemInfo(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemList":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemList(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestAgentInfo":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSInfo(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUser":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUser(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUserList":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUserList(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceList":                                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceList(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigration":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigration(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationCondition":                                schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationCondition(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationList":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationList(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationPhaseTransitionTimestamp":                 schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationPhaseTransitionTimestamp(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSource":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSource(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSourceState":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSourceState(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSpec":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSpec(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationState(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationStatus":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationStatus(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationTarget":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationTarget(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationTargetState":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationTargetState(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface":                                  schema_kubevirtio_api_core_v1_VirtualMachineInstanceNetworkInterface(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp":                          schema_kubevirtio_api_core_v1_VirtualMachineInstancePhaseTransitionTimestamp(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstancePreset":                                            schema_kubevirtio_api_core_v1_VirtualMachineInstancePreset(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstancePresetList":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstancePresetList(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstancePresetSpec":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstancePresetSpec(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceProfile":                                           schema_kubevirtio_api_core_v1_VirtualMachineInstanceProfile(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSet":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSet(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetCondition":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetCondition(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetList":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetList(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetSpec":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetSpec(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetStatus":                                  schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetStatus(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceSpec":                                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceStatus":                                            schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec":                                      schema_kubevirtio_api_core_v1_VirtualMachineInstanceTemplateSpec(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineList":                                                      schema_kubevirtio_api_core_v1_VirtualMachineList(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest":                                         schema_kubevirtio_api_core_v1_VirtualMachineMemoryDumpRequest(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineOptions":                                                   schema_kubevirtio_api_core_v1_VirtualMachineOptions(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineSpec":                                                      schema_kubevirtio_api_core_v1_VirtualMachineSpec(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineStartFailure":                                              schema_kubevirtio_api_core_v1_VirtualMachineStartFailure(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest":                                        schema_kubevirtio_api_core_v1_VirtualMachineStateChangeRequest(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineStatus":                                                    schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref),
-		"kubevirt.io/api/core/v1.VirtualMachineVolumeRequest":                                             schema_kubevirtio_api_core_v1_VirtualMachineVolumeRequest(ref),
-		"kubevirt.io/api/core/v1.Volume":                                                                  schema_kubevirtio_api_core_v1_Volume(ref),
-		"kubevirt.io/api/core/v1.VolumeMigrationState":                                                    schema_kubevirtio_api_core_v1_VolumeMigrationState(ref),
-		"kubevirt.io/api/core/v1.VolumeSnapshotStatus":                                                    schema_kubevirtio_api_core_v1_VolumeSnapshotStatus(ref),
-		"kubevirt.io/api/core/v1.VolumeSource":                                                            schema_kubevirtio_api_core_v1_VolumeSource(ref),
-		"kubevirt.io/api/core/v1.VolumeStatus":                                                            schema_kubevirtio_api_core_v1_VolumeStatus(ref),
-		"kubevirt.io/api/core/v1.VolumeUpdateState":                                                       schema_kubevirtio_api_core_v1_VolumeUpdateState(ref),
-		"kubevirt.io/api/core/v1.Watchdog":                                                                schema_kubevirtio_api_core_v1_Watchdog(ref),
-		"kubevirt.io/api/core/v1.WatchdogDevice":                                                          schema_kubevirtio_api_core_v1_WatchdogDevice(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDI":                           schema_pkg_apis_core_v1beta1_CDI(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDICertConfig":                 schema_pkg_apis_core_v1beta1_CDICertConfig(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDIConfig":                     schema_pkg_apis_core_v1beta1_CDIConfig(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDIConfigList":                 schema_pkg_apis_core_v1beta1_CDIConfigList(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDIConfigSpec":                 schema_pkg_apis_core_v1beta1_CDIConfigSpec(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDIConfigStatus":               schema_pkg_apis_core_v1beta1_CDIConfigStatus(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDIList":                       schema_pkg_apis_core_v1beta1_CDIList(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDISpec":                       schema_pkg_apis_core_v1beta1_CDISpec(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDIStatus":                     schema_pkg_apis_core_v1beta1_CDIStatus(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CertConfig":                    schema_pkg_apis_core_v1beta1_CertConfig(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ClaimPropertySet":              schema_pkg_apis_core_v1beta1_ClaimPropertySet(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ComponentConfig":               schema_pkg_apis_core_v1beta1_ComponentConfig(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ConditionState":                schema_pkg_apis_core_v1beta1_ConditionState(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CustomTLSProfile":              schema_pkg_apis_core_v1beta1_CustomTLSProfile(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CustomizeComponents":           schema_pkg_apis_core_v1beta1_CustomizeComponents(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CustomizeComponentsPatch":      schema_pkg_apis_core_v1beta1_CustomizeComponentsPatch(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataImportCron":                schema_pkg_apis_core_v1beta1_DataImportCron(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataImportCronCondition":       schema_pkg_apis_core_v1beta1_DataImportCronCondition(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataImportCronList":            schema_pkg_apis_core_v1beta1_DataImportCronList(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataImportCronSpec":            schema_pkg_apis_core_v1beta1_DataImportCronSpec(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataImportCronStatus":          schema_pkg_apis_core_v1beta1_DataImportCronStatus(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSource":                    schema_pkg_apis_core_v1beta1_DataSource(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSourceCondition":           schema_pkg_apis_core_v1beta1_DataSourceCondition(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSourceList":                schema_pkg_apis_core_v1beta1_DataSourceList(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSourceRefSourceDataSource": schema_pkg_apis_core_v1beta1_DataSourceRefSourceDataSource(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSourceSource":              schema_pkg_apis_core_v1beta1_DataSourceSource(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSourceSpec":                schema_pkg_apis_core_v1beta1_DataSourceSpec(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSourceStatus":              schema_pkg_apis_core_v1beta1_DataSourceStatus(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolume":                    schema_pkg_apis_core_v1beta1_DataVolume(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeBlankImage":          schema_pkg_apis_core_v1beta1_DataVolumeBlankImage(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeCheckpoint":          schema_pkg_apis_core_v1beta1_DataVolumeCheckpoint(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeCondition":           schema_pkg_apis_core_v1beta1_DataVolumeCondition(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeList":                schema_pkg_apis_core_v1beta1_DataVolumeList(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSource":              schema_pkg_apis_core_v1beta1_DataVolumeSource(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceGCS":           schema_pkg_apis_core_v1beta1_DataVolumeSourceGCS(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceHTTP":          schema_pkg_apis_core_v1beta1_DataVolumeSourceHTTP(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceImageIO":       schema_pkg_apis_core_v1beta1_DataVolumeSourceImageIO(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourcePVC":           schema_pkg_apis_core_v1beta1_DataVolumeSourcePVC(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceRef":           schema_pkg_apis_core_v1beta1_DataVolumeSourceRef(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceRegistry":      schema_pkg_apis_core_v1beta1_DataVolumeSourceRegistry(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceS3":            schema_pkg_apis_core_v1beta1_DataVolumeSourceS3(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceSnapshot":      schema_pkg_apis_core_v1beta1_DataVolumeSourceSnapshot(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceUpload":        schema_pkg_apis_core_v1beta1_DataVolumeSourceUpload(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceVDDK":          schema_pkg_apis_core_v1beta1_DataVolumeSourceVDDK(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSpec":                schema_pkg_apis_core_v1beta1_DataVolumeSpec(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeStatus":              schema_pkg_apis_core_v1beta1_DataVolumeStatus(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.FilesystemOverhead":            schema_pkg_apis_core_v1beta1_FilesystemOverhead(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.Flags":                         schema_pkg_apis_core_v1beta1_Flags(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ImportProxy":                   schema_pkg_apis_core_v1beta1_ImportProxy(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ImportSourceType":              schema_pkg_apis_core_v1beta1_ImportSourceType(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ImportStatus":                  schema_pkg_apis_core_v1beta1_ImportStatus(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.IntermediateTLSProfile":        schema_pkg_apis_core_v1beta1_IntermediateTLSProfile(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ModernTLSProfile":              schema_pkg_apis_core_v1beta1_ModernTLSProfile(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ObjectTransfer":                schema_pkg_apis_core_v1beta1_ObjectTransfer(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ObjectTransferCondition":       schema_pkg_apis_core_v1beta1_ObjectTransferCondition(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ObjectTransferList":            schema_pkg_apis_core_v1beta1_ObjectTransferList(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ObjectTransferSpec":            schema_pkg_apis_core_v1beta1_ObjectTransferSpec(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ObjectTransferStatus":          schema_pkg_apis_core_v1beta1_ObjectTransferStatus(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.OldTLSProfile":                 schema_pkg_apis_core_v1beta1_OldTLSProfile(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.PlatformOptions":               schema_pkg_apis_core_v1beta1_PlatformOptions(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.StorageProfile":                schema_pkg_apis_core_v1beta1_StorageProfile(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.StorageProfileList":            schema_pkg_apis_core_v1beta1_StorageProfileList(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.StorageProfileSpec":            schema_pkg_apis_core_v1beta1_StorageProfileSpec(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.StorageProfileStatus":          schema_pkg_apis_core_v1beta1_StorageProfileStatus(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.StorageSpec":                   schema_pkg_apis_core_v1beta1_StorageSpec(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.TLSProfileSpec":                schema_pkg_apis_core_v1beta1_TLSProfileSpec(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.TLSSecurityProfile":            schema_pkg_apis_core_v1beta1_TLSSecurityProfile(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.TransferSource":                schema_pkg_apis_core_v1beta1_TransferSource(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.TransferTarget":                schema_pkg_apis_core_v1beta1_TransferTarget(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeCloneSource":             schema_pkg_apis_core_v1beta1_VolumeCloneSource(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeCloneSourceList":         schema_pkg_apis_core_v1beta1_VolumeCloneSourceList(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeCloneSourceSpec":         schema_pkg_apis_core_v1beta1_VolumeCloneSourceSpec(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeImportSource":            schema_pkg_apis_core_v1beta1_VolumeImportSource(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeImportSourceList":        schema_pkg_apis_core_v1beta1_VolumeImportSourceList(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeImportSourceSpec":        schema_pkg_apis_core_v1beta1_VolumeImportSourceSpec(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeImportSourceStatus":      schema_pkg_apis_core_v1beta1_VolumeImportSourceStatus(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeUploadSource":            schema_pkg_apis_core_v1beta1_VolumeUploadSource(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeUploadSourceList":        schema_pkg_apis_core_v1beta1_VolumeUploadSourceList(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeUploadSourceSpec":        schema_pkg_apis_core_v1beta1_VolumeUploadSourceSpec(ref),
-		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeUploadSourceStatus":      schema_pkg_apis_core_v1beta1_VolumeUploadSourceStatus(ref),
-		"kubevirt.io/virt-template-api/core/subresourcesv1alpha1.ProcessOptions":                          schema_kubevirtio_virt_template_api_core_subresourcesv1alpha1_ProcessOptions(ref),
-		"kubevirt.io/virt-template-api/core/subresourcesv1alpha1.ProcessedVirtualMachineTemplate":         schema_kubevirtio_virt_template_api_core_subresourcesv1alpha1_ProcessedVirtualMachineTemplate(ref),
-		"kubevirt.io/virt-template-api/core/subresourcesv1alpha1.VirtualMachineTemplate":                  schema_kubevirtio_virt_template_api_core_subresourcesv1alpha1_VirtualMachineTemplate(ref),
-		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessOptions":                           schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessOptions(ref),
-		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessedVirtualMachineTemplate":          schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessedVirtualMachineTemplate(ref),
-		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineTemplate":                   schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineTemplate(ref),
-		"kubevirt.io/virt-template-api/core/v1alpha1.Parameter":                                           schema_kubevirtio_virt_template_api_core_v1alpha1_Parameter(ref),
-		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineReference":                             schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineReference(ref),
-		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplate":                              schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplate(ref),
-		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateList":                          schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateList(ref),
-		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequest":                       schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequest(ref),
-		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestList":                   schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequestList(ref),
-		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestSpec":                   schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequestSpec(ref),
-		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestStatus":                 schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequestStatus(ref),
-		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateSpec":                          schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateSpec(ref),
-		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateStatus":                        schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateStatus(ref),
-		"kubevirt.io/virt-template-api/core/v1beta1.Parameter":                                            schema_kubevirtio_virt_template_api_core_v1beta1_Parameter(ref),
-		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineReference":                              schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineReference(ref),
-		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplate":                               schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplate(ref),
-		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateList":                           schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateList(ref),
-		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateRequest":                        schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateRequest(ref),
-		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateRequestList":                    schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateRequestList(ref),
-		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateRequestSpec":                    schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateRequestSpec(ref),
-		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateRequestStatus":                  schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateRequestStatus(ref),
-		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateSpec":                           schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateSpec(ref),
-		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateStatus":                         schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateStatus(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                              schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"k8s.io/api/core/v1.Affinity":                                                                      schema_k8sio_api_core_v1_Affinity(ref),
+		"k8s.io/api/core/v1.AppArmorProfile":                                                               schema_k8sio_api_core_v1_AppArmorProfile(ref),
+		"k8s.io/api/core/v1.AttachedVolume":                                                                schema_k8sio_api_core_v1_AttachedVolume(ref),
+		"k8s.io/api/core/v1.AvoidPods":                                                                     schema_k8sio_api_core_v1_AvoidPods(ref),
+		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                                         schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                               schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFileVolumeSource":                                                         schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
+		"k8s.io/api/core/v1.Binding":                                                                       schema_k8sio_api_core_v1_Binding(ref),
+		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                                     schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CSIVolumeSource":                                                               schema_k8sio_api_core_v1_CSIVolumeSource(ref),
+		"k8s.io/api/core/v1.Capabilities":                                                                  schema_k8sio_api_core_v1_Capabilities(ref),
+		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                                  schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CephFSVolumeSource":                                                            schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                                  schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderVolumeSource":                                                            schema_k8sio_api_core_v1_CinderVolumeSource(ref),
+		"k8s.io/api/core/v1.ClientIPConfig":                                                                schema_k8sio_api_core_v1_ClientIPConfig(ref),
+		"k8s.io/api/core/v1.ClusterTrustBundleProjection":                                                  schema_k8sio_api_core_v1_ClusterTrustBundleProjection(ref),
+		"k8s.io/api/core/v1.ComponentCondition":                                                            schema_k8sio_api_core_v1_ComponentCondition(ref),
+		"k8s.io/api/core/v1.ComponentStatus":                                                               schema_k8sio_api_core_v1_ComponentStatus(ref),
+		"k8s.io/api/core/v1.ComponentStatusList":                                                           schema_k8sio_api_core_v1_ComponentStatusList(ref),
+		"k8s.io/api/core/v1.ConfigMap":                                                                     schema_k8sio_api_core_v1_ConfigMap(ref),
+		"k8s.io/api/core/v1.ConfigMapEnvSource":                                                            schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
+		"k8s.io/api/core/v1.ConfigMapKeySelector":                                                          schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
+		"k8s.io/api/core/v1.ConfigMapList":                                                                 schema_k8sio_api_core_v1_ConfigMapList(ref),
+		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                                     schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
+		"k8s.io/api/core/v1.ConfigMapProjection":                                                           schema_k8sio_api_core_v1_ConfigMapProjection(ref),
+		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                                         schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
+		"k8s.io/api/core/v1.Container":                                                                     schema_k8sio_api_core_v1_Container(ref),
+		"k8s.io/api/core/v1.ContainerExtendedResourceRequest":                                              schema_k8sio_api_core_v1_ContainerExtendedResourceRequest(ref),
+		"k8s.io/api/core/v1.ContainerImage":                                                                schema_k8sio_api_core_v1_ContainerImage(ref),
+		"k8s.io/api/core/v1.ContainerPort":                                                                 schema_k8sio_api_core_v1_ContainerPort(ref),
+		"k8s.io/api/core/v1.ContainerResizePolicy":                                                         schema_k8sio_api_core_v1_ContainerResizePolicy(ref),
+		"k8s.io/api/core/v1.ContainerRestartRule":                                                          schema_k8sio_api_core_v1_ContainerRestartRule(ref),
+		"k8s.io/api/core/v1.ContainerRestartRuleOnExitCodes":                                               schema_k8sio_api_core_v1_ContainerRestartRuleOnExitCodes(ref),
+		"k8s.io/api/core/v1.ContainerState":                                                                schema_k8sio_api_core_v1_ContainerState(ref),
+		"k8s.io/api/core/v1.ContainerStateRunning":                                                         schema_k8sio_api_core_v1_ContainerStateRunning(ref),
+		"k8s.io/api/core/v1.ContainerStateTerminated":                                                      schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
+		"k8s.io/api/core/v1.ContainerStateWaiting":                                                         schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
+		"k8s.io/api/core/v1.ContainerStatus":                                                               schema_k8sio_api_core_v1_ContainerStatus(ref),
+		"k8s.io/api/core/v1.ContainerUser":                                                                 schema_k8sio_api_core_v1_ContainerUser(ref),
+		"k8s.io/api/core/v1.DaemonEndpoint":                                                                schema_k8sio_api_core_v1_DaemonEndpoint(ref),
+		"k8s.io/api/core/v1.DownwardAPIProjection":                                                         schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                                         schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                                       schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
+		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                                          schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
+		"k8s.io/api/core/v1.EndpointAddress":                                                               schema_k8sio_api_core_v1_EndpointAddress(ref),
+		"k8s.io/api/core/v1.EndpointPort":                                                                  schema_k8sio_api_core_v1_EndpointPort(ref),
+		"k8s.io/api/core/v1.EndpointSubset":                                                                schema_k8sio_api_core_v1_EndpointSubset(ref),
+		"k8s.io/api/core/v1.Endpoints":                                                                     schema_k8sio_api_core_v1_Endpoints(ref),
+		"k8s.io/api/core/v1.EndpointsList":                                                                 schema_k8sio_api_core_v1_EndpointsList(ref),
+		"k8s.io/api/core/v1.EnvFromSource":                                                                 schema_k8sio_api_core_v1_EnvFromSource(ref),
+		"k8s.io/api/core/v1.EnvVar":                                                                        schema_k8sio_api_core_v1_EnvVar(ref),
+		"k8s.io/api/core/v1.EnvVarSource":                                                                  schema_k8sio_api_core_v1_EnvVarSource(ref),
+		"k8s.io/api/core/v1.EphemeralContainer":                                                            schema_k8sio_api_core_v1_EphemeralContainer(ref),
+		"k8s.io/api/core/v1.EphemeralContainerCommon":                                                      schema_k8sio_api_core_v1_EphemeralContainerCommon(ref),
+		"k8s.io/api/core/v1.EphemeralVolumeSource":                                                         schema_k8sio_api_core_v1_EphemeralVolumeSource(ref),
+		"k8s.io/api/core/v1.Event":                                                                         schema_k8sio_api_core_v1_Event(ref),
+		"k8s.io/api/core/v1.EventList":                                                                     schema_k8sio_api_core_v1_EventList(ref),
+		"k8s.io/api/core/v1.EventSeries":                                                                   schema_k8sio_api_core_v1_EventSeries(ref),
+		"k8s.io/api/core/v1.EventSource":                                                                   schema_k8sio_api_core_v1_EventSource(ref),
+		"k8s.io/api/core/v1.ExecAction":                                                                    schema_k8sio_api_core_v1_ExecAction(ref),
+		"k8s.io/api/core/v1.FCVolumeSource":                                                                schema_k8sio_api_core_v1_FCVolumeSource(ref),
+		"k8s.io/api/core/v1.FileKeySelector":                                                               schema_k8sio_api_core_v1_FileKeySelector(ref),
+		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                                    schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexVolumeSource":                                                              schema_k8sio_api_core_v1_FlexVolumeSource(ref),
+		"k8s.io/api/core/v1.FlockerVolumeSource":                                                           schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
+		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                                 schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.GRPCAction":                                                                    schema_k8sio_api_core_v1_GRPCAction(ref),
+		"k8s.io/api/core/v1.GitRepoVolumeSource":                                                           schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                               schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                                         schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
+		"k8s.io/api/core/v1.HTTPGetAction":                                                                 schema_k8sio_api_core_v1_HTTPGetAction(ref),
+		"k8s.io/api/core/v1.HTTPHeader":                                                                    schema_k8sio_api_core_v1_HTTPHeader(ref),
+		"k8s.io/api/core/v1.HostAlias":                                                                     schema_k8sio_api_core_v1_HostAlias(ref),
+		"k8s.io/api/core/v1.HostIP":                                                                        schema_k8sio_api_core_v1_HostIP(ref),
+		"k8s.io/api/core/v1.HostPathVolumeSource":                                                          schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                                   schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIVolumeSource":                                                             schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
+		"k8s.io/api/core/v1.ImageVolumeSource":                                                             schema_k8sio_api_core_v1_ImageVolumeSource(ref),
+		"k8s.io/api/core/v1.KeyToPath":                                                                     schema_k8sio_api_core_v1_KeyToPath(ref),
+		"k8s.io/api/core/v1.Lifecycle":                                                                     schema_k8sio_api_core_v1_Lifecycle(ref),
+		"k8s.io/api/core/v1.LifecycleHandler":                                                              schema_k8sio_api_core_v1_LifecycleHandler(ref),
+		"k8s.io/api/core/v1.LimitRange":                                                                    schema_k8sio_api_core_v1_LimitRange(ref),
+		"k8s.io/api/core/v1.LimitRangeItem":                                                                schema_k8sio_api_core_v1_LimitRangeItem(ref),
+		"k8s.io/api/core/v1.LimitRangeList":                                                                schema_k8sio_api_core_v1_LimitRangeList(ref),
+		"k8s.io/api/core/v1.LimitRangeSpec":                                                                schema_k8sio_api_core_v1_LimitRangeSpec(ref),
+		"k8s.io/api/core/v1.LinuxContainerUser":                                                            schema_k8sio_api_core_v1_LinuxContainerUser(ref),
+		"k8s.io/api/core/v1.List":                                                                          schema_k8sio_api_core_v1_List(ref),
+		"k8s.io/api/core/v1.LoadBalancerIngress":                                                           schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
+		"k8s.io/api/core/v1.LoadBalancerStatus":                                                            schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
+		"k8s.io/api/core/v1.LocalObjectReference":                                                          schema_k8sio_api_core_v1_LocalObjectReference(ref),
+		"k8s.io/api/core/v1.LocalVolumeSource":                                                             schema_k8sio_api_core_v1_LocalVolumeSource(ref),
+		"k8s.io/api/core/v1.ModifyVolumeStatus":                                                            schema_k8sio_api_core_v1_ModifyVolumeStatus(ref),
+		"k8s.io/api/core/v1.NFSVolumeSource":                                                               schema_k8sio_api_core_v1_NFSVolumeSource(ref),
+		"k8s.io/api/core/v1.Namespace":                                                                     schema_k8sio_api_core_v1_Namespace(ref),
+		"k8s.io/api/core/v1.NamespaceCondition":                                                            schema_k8sio_api_core_v1_NamespaceCondition(ref),
+		"k8s.io/api/core/v1.NamespaceList":                                                                 schema_k8sio_api_core_v1_NamespaceList(ref),
+		"k8s.io/api/core/v1.NamespaceSpec":                                                                 schema_k8sio_api_core_v1_NamespaceSpec(ref),
+		"k8s.io/api/core/v1.NamespaceStatus":                                                               schema_k8sio_api_core_v1_NamespaceStatus(ref),
+		"k8s.io/api/core/v1.Node":                                                                          schema_k8sio_api_core_v1_Node(ref),
+		"k8s.io/api/core/v1.NodeAddress":                                                                   schema_k8sio_api_core_v1_NodeAddress(ref),
+		"k8s.io/api/core/v1.NodeAffinity":                                                                  schema_k8sio_api_core_v1_NodeAffinity(ref),
+		"k8s.io/api/core/v1.NodeCondition":                                                                 schema_k8sio_api_core_v1_NodeCondition(ref),
+		"k8s.io/api/core/v1.NodeConfigSource":                                                              schema_k8sio_api_core_v1_NodeConfigSource(ref),
+		"k8s.io/api/core/v1.NodeConfigStatus":                                                              schema_k8sio_api_core_v1_NodeConfigStatus(ref),
+		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                                           schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
+		"k8s.io/api/core/v1.NodeFeatures":                                                                  schema_k8sio_api_core_v1_NodeFeatures(ref),
+		"k8s.io/api/core/v1.NodeList":                                                                      schema_k8sio_api_core_v1_NodeList(ref),
+		"k8s.io/api/core/v1.NodeProxyOptions":                                                              schema_k8sio_api_core_v1_NodeProxyOptions(ref),
+		"k8s.io/api/core/v1.NodeRuntimeHandler":                                                            schema_k8sio_api_core_v1_NodeRuntimeHandler(ref),
+		"k8s.io/api/core/v1.NodeRuntimeHandlerFeatures":                                                    schema_k8sio_api_core_v1_NodeRuntimeHandlerFeatures(ref),
+		"k8s.io/api/core/v1.NodeSelector":                                                                  schema_k8sio_api_core_v1_NodeSelector(ref),
+		"k8s.io/api/core/v1.NodeSelectorRequirement":                                                       schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
+		"k8s.io/api/core/v1.NodeSelectorTerm":                                                              schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
+		"k8s.io/api/core/v1.NodeSpec":                                                                      schema_k8sio_api_core_v1_NodeSpec(ref),
+		"k8s.io/api/core/v1.NodeStatus":                                                                    schema_k8sio_api_core_v1_NodeStatus(ref),
+		"k8s.io/api/core/v1.NodeSwapStatus":                                                                schema_k8sio_api_core_v1_NodeSwapStatus(ref),
+		"k8s.io/api/core/v1.NodeSystemInfo":                                                                schema_k8sio_api_core_v1_NodeSystemInfo(ref),
+		"k8s.io/api/core/v1.ObjectFieldSelector":                                                           schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
+		"k8s.io/api/core/v1.ObjectReference":                                                               schema_k8sio_api_core_v1_ObjectReference(ref),
+		"k8s.io/api/core/v1.PersistentVolume":                                                              schema_k8sio_api_core_v1_PersistentVolume(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaim":                                                         schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                                schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                                     schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                                     schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                                   schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimTemplate":                                                 schema_k8sio_api_core_v1_PersistentVolumeClaimTemplate(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                             schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeList":                                                          schema_k8sio_api_core_v1_PersistentVolumeList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSource":                                                        schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSpec":                                                          schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeStatus":                                                        schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
+		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                              schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.Pod":                                                                           schema_k8sio_api_core_v1_Pod(ref),
+		"k8s.io/api/core/v1.PodAffinity":                                                                   schema_k8sio_api_core_v1_PodAffinity(ref),
+		"k8s.io/api/core/v1.PodAffinityTerm":                                                               schema_k8sio_api_core_v1_PodAffinityTerm(ref),
+		"k8s.io/api/core/v1.PodAntiAffinity":                                                               schema_k8sio_api_core_v1_PodAntiAffinity(ref),
+		"k8s.io/api/core/v1.PodAttachOptions":                                                              schema_k8sio_api_core_v1_PodAttachOptions(ref),
+		"k8s.io/api/core/v1.PodCertificateProjection":                                                      schema_k8sio_api_core_v1_PodCertificateProjection(ref),
+		"k8s.io/api/core/v1.PodCondition":                                                                  schema_k8sio_api_core_v1_PodCondition(ref),
+		"k8s.io/api/core/v1.PodDNSConfig":                                                                  schema_k8sio_api_core_v1_PodDNSConfig(ref),
+		"k8s.io/api/core/v1.PodDNSConfigOption":                                                            schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
+		"k8s.io/api/core/v1.PodExecOptions":                                                                schema_k8sio_api_core_v1_PodExecOptions(ref),
+		"k8s.io/api/core/v1.PodExtendedResourceClaimStatus":                                                schema_k8sio_api_core_v1_PodExtendedResourceClaimStatus(ref),
+		"k8s.io/api/core/v1.PodIP":                                                                         schema_k8sio_api_core_v1_PodIP(ref),
+		"k8s.io/api/core/v1.PodList":                                                                       schema_k8sio_api_core_v1_PodList(ref),
+		"k8s.io/api/core/v1.PodLogOptions":                                                                 schema_k8sio_api_core_v1_PodLogOptions(ref),
+		"k8s.io/api/core/v1.PodOS":                                                                         schema_k8sio_api_core_v1_PodOS(ref),
+		"k8s.io/api/core/v1.PodPortForwardOptions":                                                         schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
+		"k8s.io/api/core/v1.PodProxyOptions":                                                               schema_k8sio_api_core_v1_PodProxyOptions(ref),
+		"k8s.io/api/core/v1.PodReadinessGate":                                                              schema_k8sio_api_core_v1_PodReadinessGate(ref),
+		"k8s.io/api/core/v1.PodResourceClaim":                                                              schema_k8sio_api_core_v1_PodResourceClaim(ref),
+		"k8s.io/api/core/v1.PodResourceClaimStatus":                                                        schema_k8sio_api_core_v1_PodResourceClaimStatus(ref),
+		"k8s.io/api/core/v1.PodSchedulingGate":                                                             schema_k8sio_api_core_v1_PodSchedulingGate(ref),
+		"k8s.io/api/core/v1.PodSecurityContext":                                                            schema_k8sio_api_core_v1_PodSecurityContext(ref),
+		"k8s.io/api/core/v1.PodSignature":                                                                  schema_k8sio_api_core_v1_PodSignature(ref),
+		"k8s.io/api/core/v1.PodSpec":                                                                       schema_k8sio_api_core_v1_PodSpec(ref),
+		"k8s.io/api/core/v1.PodStatus":                                                                     schema_k8sio_api_core_v1_PodStatus(ref),
+		"k8s.io/api/core/v1.PodStatusResult":                                                               schema_k8sio_api_core_v1_PodStatusResult(ref),
+		"k8s.io/api/core/v1.PodTemplate":                                                                   schema_k8sio_api_core_v1_PodTemplate(ref),
+		"k8s.io/api/core/v1.PodTemplateList":                                                               schema_k8sio_api_core_v1_PodTemplateList(ref),
+		"k8s.io/api/core/v1.PodTemplateSpec":                                                               schema_k8sio_api_core_v1_PodTemplateSpec(ref),
+		"k8s.io/api/core/v1.PortStatus":                                                                    schema_k8sio_api_core_v1_PortStatus(ref),
+		"k8s.io/api/core/v1.PortworxVolumeSource":                                                          schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
+		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                                          schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
+		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                                       schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
+		"k8s.io/api/core/v1.Probe":                                                                         schema_k8sio_api_core_v1_Probe(ref),
+		"k8s.io/api/core/v1.ProbeHandler":                                                                  schema_k8sio_api_core_v1_ProbeHandler(ref),
+		"k8s.io/api/core/v1.ProjectedVolumeSource":                                                         schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
+		"k8s.io/api/core/v1.QuobyteVolumeSource":                                                           schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                                     schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDVolumeSource":                                                               schema_k8sio_api_core_v1_RBDVolumeSource(ref),
+		"k8s.io/api/core/v1.RangeAllocation":                                                               schema_k8sio_api_core_v1_RangeAllocation(ref),
+		"k8s.io/api/core/v1.ReplicationController":                                                         schema_k8sio_api_core_v1_ReplicationController(ref),
+		"k8s.io/api/core/v1.ReplicationControllerCondition":                                                schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
+		"k8s.io/api/core/v1.ReplicationControllerList":                                                     schema_k8sio_api_core_v1_ReplicationControllerList(ref),
+		"k8s.io/api/core/v1.ReplicationControllerSpec":                                                     schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
+		"k8s.io/api/core/v1.ReplicationControllerStatus":                                                   schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
+		"k8s.io/api/core/v1.ResourceClaim":                                                                 schema_k8sio_api_core_v1_ResourceClaim(ref),
+		"k8s.io/api/core/v1.ResourceFieldSelector":                                                         schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
+		"k8s.io/api/core/v1.ResourceHealth":                                                                schema_k8sio_api_core_v1_ResourceHealth(ref),
+		"k8s.io/api/core/v1.ResourceQuota":                                                                 schema_k8sio_api_core_v1_ResourceQuota(ref),
+		"k8s.io/api/core/v1.ResourceQuotaList":                                                             schema_k8sio_api_core_v1_ResourceQuotaList(ref),
+		"k8s.io/api/core/v1.ResourceQuotaSpec":                                                             schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
+		"k8s.io/api/core/v1.ResourceQuotaStatus":                                                           schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
+		"k8s.io/api/core/v1.ResourceRequirements":                                                          schema_k8sio_api_core_v1_ResourceRequirements(ref),
+		"k8s.io/api/core/v1.ResourceStatus":                                                                schema_k8sio_api_core_v1_ResourceStatus(ref),
+		"k8s.io/api/core/v1.SELinuxOptions":                                                                schema_k8sio_api_core_v1_SELinuxOptions(ref),
+		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                                 schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                                           schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
+		"k8s.io/api/core/v1.ScopeSelector":                                                                 schema_k8sio_api_core_v1_ScopeSelector(ref),
+		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                                             schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
+		"k8s.io/api/core/v1.SeccompProfile":                                                                schema_k8sio_api_core_v1_SeccompProfile(ref),
+		"k8s.io/api/core/v1.Secret":                                                                        schema_k8sio_api_core_v1_Secret(ref),
+		"k8s.io/api/core/v1.SecretEnvSource":                                                               schema_k8sio_api_core_v1_SecretEnvSource(ref),
+		"k8s.io/api/core/v1.SecretKeySelector":                                                             schema_k8sio_api_core_v1_SecretKeySelector(ref),
+		"k8s.io/api/core/v1.SecretList":                                                                    schema_k8sio_api_core_v1_SecretList(ref),
+		"k8s.io/api/core/v1.SecretProjection":                                                              schema_k8sio_api_core_v1_SecretProjection(ref),
+		"k8s.io/api/core/v1.SecretReference":                                                               schema_k8sio_api_core_v1_SecretReference(ref),
+		"k8s.io/api/core/v1.SecretVolumeSource":                                                            schema_k8sio_api_core_v1_SecretVolumeSource(ref),
+		"k8s.io/api/core/v1.SecurityContext":                                                               schema_k8sio_api_core_v1_SecurityContext(ref),
+		"k8s.io/api/core/v1.SerializedReference":                                                           schema_k8sio_api_core_v1_SerializedReference(ref),
+		"k8s.io/api/core/v1.Service":                                                                       schema_k8sio_api_core_v1_Service(ref),
+		"k8s.io/api/core/v1.ServiceAccount":                                                                schema_k8sio_api_core_v1_ServiceAccount(ref),
+		"k8s.io/api/core/v1.ServiceAccountList":                                                            schema_k8sio_api_core_v1_ServiceAccountList(ref),
+		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                                 schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
+		"k8s.io/api/core/v1.ServiceList":                                                                   schema_k8sio_api_core_v1_ServiceList(ref),
+		"k8s.io/api/core/v1.ServicePort":                                                                   schema_k8sio_api_core_v1_ServicePort(ref),
+		"k8s.io/api/core/v1.ServiceProxyOptions":                                                           schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
+		"k8s.io/api/core/v1.ServiceSpec":                                                                   schema_k8sio_api_core_v1_ServiceSpec(ref),
+		"k8s.io/api/core/v1.ServiceStatus":                                                                 schema_k8sio_api_core_v1_ServiceStatus(ref),
+		"k8s.io/api/core/v1.SessionAffinityConfig":                                                         schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
+		"k8s.io/api/core/v1.SleepAction":                                                                   schema_k8sio_api_core_v1_SleepAction(ref),
+		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                               schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.StorageOSVolumeSource":                                                         schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
+		"k8s.io/api/core/v1.Sysctl":                                                                        schema_k8sio_api_core_v1_Sysctl(ref),
+		"k8s.io/api/core/v1.TCPSocketAction":                                                               schema_k8sio_api_core_v1_TCPSocketAction(ref),
+		"k8s.io/api/core/v1.Taint":                                                                         schema_k8sio_api_core_v1_Taint(ref),
+		"k8s.io/api/core/v1.Toleration":                                                                    schema_k8sio_api_core_v1_Toleration(ref),
+		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                              schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
+		"k8s.io/api/core/v1.TopologySelectorTerm":                                                          schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
+		"k8s.io/api/core/v1.TopologySpreadConstraint":                                                      schema_k8sio_api_core_v1_TopologySpreadConstraint(ref),
+		"k8s.io/api/core/v1.TypedLocalObjectReference":                                                     schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
+		"k8s.io/api/core/v1.TypedObjectReference":                                                          schema_k8sio_api_core_v1_TypedObjectReference(ref),
+		"k8s.io/api/core/v1.Volume":                                                                        schema_k8sio_api_core_v1_Volume(ref),
+		"k8s.io/api/core/v1.VolumeDevice":                                                                  schema_k8sio_api_core_v1_VolumeDevice(ref),
+		"k8s.io/api/core/v1.VolumeMount":                                                                   schema_k8sio_api_core_v1_VolumeMount(ref),
+		"k8s.io/api/core/v1.VolumeMountStatus":                                                             schema_k8sio_api_core_v1_VolumeMountStatus(ref),
+		"k8s.io/api/core/v1.VolumeNodeAffinity":                                                            schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
+		"k8s.io/api/core/v1.VolumeProjection":                                                              schema_k8sio_api_core_v1_VolumeProjection(ref),
+		"k8s.io/api/core/v1.VolumeResourceRequirements":                                                    schema_k8sio_api_core_v1_VolumeResourceRequirements(ref),
+		"k8s.io/api/core/v1.VolumeSource":                                                                  schema_k8sio_api_core_v1_VolumeSource(ref),
+		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                                schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                                       schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
+		"k8s.io/api/core/v1.WindowsSecurityContextOptions":                                                 schema_k8sio_api_core_v1_WindowsSecurityContextOptions(ref),
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                                    schema_apimachinery_pkg_api_resource_Quantity(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                                    schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                                schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                                 schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                             schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                                 schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                                                schema_pkg_apis_meta_v1_ApplyOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                                   schema_pkg_apis_meta_v1_Condition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                               schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                               schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                                    schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":                                    schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                                    schema_pkg_apis_meta_v1_FieldsV1(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                                  schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                                   schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                               schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                                schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                                    schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                            schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                                        schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                               schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                               schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                                    schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                                        schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                                    schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                                 schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                                          schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                                   schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                                  schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                              schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                                       schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                                   schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                                       schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                                schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                               schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                                   schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                                   schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                                      schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                                 schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                               schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                                       schema_pkg_apis_meta_v1_Table(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                                       schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                                                schema_pkg_apis_meta_v1_TableOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                                    schema_pkg_apis_meta_v1_TableRow(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                                           schema_pkg_apis_meta_v1_TableRowCondition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                                        schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                                   schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                                    schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                               schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                                  schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                                     schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                         schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                          schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/util/intstr.IntOrString":                                                  schema_apimachinery_pkg_util_intstr_IntOrString(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                                             schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"kubevirt.io/api/backup/v1alpha1.BackupCheckpoint":                                                 schema_kubevirtio_api_backup_v1alpha1_BackupCheckpoint(ref),
+		"kubevirt.io/api/backup/v1alpha1.BackupOptions":                                                    schema_kubevirtio_api_backup_v1alpha1_BackupOptions(ref),
+		"kubevirt.io/api/backup/v1alpha1.BackupVolumeInfo":                                                 schema_kubevirtio_api_backup_v1alpha1_BackupVolumeInfo(ref),
+		"kubevirt.io/api/backup/v1alpha1.Condition":                                                        schema_kubevirtio_api_backup_v1alpha1_Condition(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackup":                                             schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackup(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupList":                                         schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupList(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupSpec":                                         schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupSpec(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupStatus":                                       schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupStatus(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupTracker":                                      schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupTracker(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupTrackerList":                                  schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupTrackerList(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupTrackerSpec":                                  schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupTrackerSpec(ref),
+		"kubevirt.io/api/backup/v1alpha1.VirtualMachineBackupTrackerStatus":                                schema_kubevirtio_api_backup_v1alpha1_VirtualMachineBackupTrackerStatus(ref),
+		"kubevirt.io/api/core/v1.ACPI":                                                                     schema_kubevirtio_api_core_v1_ACPI(ref),
+		"kubevirt.io/api/core/v1.AccessCredential":                                                         schema_kubevirtio_api_core_v1_AccessCredential(ref),
+		"kubevirt.io/api/core/v1.AccessCredentialSecretSource":                                             schema_kubevirtio_api_core_v1_AccessCredentialSecretSource(ref),
+		"kubevirt.io/api/core/v1.AddVolumeOptions":                                                         schema_kubevirtio_api_core_v1_AddVolumeOptions(ref),
+		"kubevirt.io/api/core/v1.ArchConfiguration":                                                        schema_kubevirtio_api_core_v1_ArchConfiguration(ref),
+		"kubevirt.io/api/core/v1.ArchSpecificConfiguration":                                                schema_kubevirtio_api_core_v1_ArchSpecificConfiguration(ref),
+		"kubevirt.io/api/core/v1.AuthorizedKeysFile":                                                       schema_kubevirtio_api_core_v1_AuthorizedKeysFile(ref),
+		"kubevirt.io/api/core/v1.BIOS":                                                                     schema_kubevirtio_api_core_v1_BIOS(ref),
+		"kubevirt.io/api/core/v1.BlockSize":                                                                schema_kubevirtio_api_core_v1_BlockSize(ref),
+		"kubevirt.io/api/core/v1.Bootloader":                                                               schema_kubevirtio_api_core_v1_Bootloader(ref),
+		"kubevirt.io/api/core/v1.CDRomTarget":                                                              schema_kubevirtio_api_core_v1_CDRomTarget(ref),
+		"kubevirt.io/api/core/v1.CPU":                                                                      schema_kubevirtio_api_core_v1_CPU(ref),
+		"kubevirt.io/api/core/v1.CPUFeature":                                                               schema_kubevirtio_api_core_v1_CPUFeature(ref),
+		"kubevirt.io/api/core/v1.CPUTopology":                                                              schema_kubevirtio_api_core_v1_CPUTopology(ref),
+		"kubevirt.io/api/core/v1.CertConfig":                                                               schema_kubevirtio_api_core_v1_CertConfig(ref),
+		"kubevirt.io/api/core/v1.ChangedBlockTrackingSelectors":                                            schema_kubevirtio_api_core_v1_ChangedBlockTrackingSelectors(ref),
+		"kubevirt.io/api/core/v1.ChangedBlockTrackingStatus":                                               schema_kubevirtio_api_core_v1_ChangedBlockTrackingStatus(ref),
+		"kubevirt.io/api/core/v1.Chassis":                                                                  schema_kubevirtio_api_core_v1_Chassis(ref),
+		"kubevirt.io/api/core/v1.ClaimRequest":                                                             schema_kubevirtio_api_core_v1_ClaimRequest(ref),
+		"kubevirt.io/api/core/v1.ClientPassthroughDevices":                                                 schema_kubevirtio_api_core_v1_ClientPassthroughDevices(ref),
+		"kubevirt.io/api/core/v1.Clock":                                                                    schema_kubevirtio_api_core_v1_Clock(ref),
+		"kubevirt.io/api/core/v1.ClockOffset":                                                              schema_kubevirtio_api_core_v1_ClockOffset(ref),
+		"kubevirt.io/api/core/v1.ClockOffsetUTC":                                                           schema_kubevirtio_api_core_v1_ClockOffsetUTC(ref),
+		"kubevirt.io/api/core/v1.CloudInitConfigDriveSource":                                               schema_kubevirtio_api_core_v1_CloudInitConfigDriveSource(ref),
+		"kubevirt.io/api/core/v1.CloudInitNoCloudSource":                                                   schema_kubevirtio_api_core_v1_CloudInitNoCloudSource(ref),
+		"kubevirt.io/api/core/v1.ClusterProfilerRequest":                                                   schema_kubevirtio_api_core_v1_ClusterProfilerRequest(ref),
+		"kubevirt.io/api/core/v1.ClusterProfilerResults":                                                   schema_kubevirtio_api_core_v1_ClusterProfilerResults(ref),
+		"kubevirt.io/api/core/v1.CommonInstancetypesDeployment":                                            schema_kubevirtio_api_core_v1_CommonInstancetypesDeployment(ref),
+		"kubevirt.io/api/core/v1.ComponentConfig":                                                          schema_kubevirtio_api_core_v1_ComponentConfig(ref),
+		"kubevirt.io/api/core/v1.ConfigDriveSSHPublicKeyAccessCredentialPropagation":                       schema_kubevirtio_api_core_v1_ConfigDriveSSHPublicKeyAccessCredentialPropagation(ref),
+		"kubevirt.io/api/core/v1.ConfigMapVolumeSource":                                                    schema_kubevirtio_api_core_v1_ConfigMapVolumeSource(ref),
+		"kubevirt.io/api/core/v1.ContainerDiskInfo":                                                        schema_kubevirtio_api_core_v1_ContainerDiskInfo(ref),
+		"kubevirt.io/api/core/v1.ContainerDiskSource":                                                      schema_kubevirtio_api_core_v1_ContainerDiskSource(ref),
+		"kubevirt.io/api/core/v1.ContainerPathVolumeSource":                                                schema_kubevirtio_api_core_v1_ContainerPathVolumeSource(ref),
+		"kubevirt.io/api/core/v1.ControllerRevisionRef":                                                    schema_kubevirtio_api_core_v1_ControllerRevisionRef(ref),
+		"kubevirt.io/api/core/v1.CustomBlockSize":                                                          schema_kubevirtio_api_core_v1_CustomBlockSize(ref),
+		"kubevirt.io/api/core/v1.CustomProfile":                                                            schema_kubevirtio_api_core_v1_CustomProfile(ref),
+		"kubevirt.io/api/core/v1.CustomizeComponents":                                                      schema_kubevirtio_api_core_v1_CustomizeComponents(ref),
+		"kubevirt.io/api/core/v1.CustomizeComponentsPatch":                                                 schema_kubevirtio_api_core_v1_CustomizeComponentsPatch(ref),
+		"kubevirt.io/api/core/v1.DHCPOptions":                                                              schema_kubevirtio_api_core_v1_DHCPOptions(ref),
+		"kubevirt.io/api/core/v1.DHCPPrivateOptions":                                                       schema_kubevirtio_api_core_v1_DHCPPrivateOptions(ref),
+		"kubevirt.io/api/core/v1.DataVolumeSource":                                                         schema_kubevirtio_api_core_v1_DataVolumeSource(ref),
+		"kubevirt.io/api/core/v1.DataVolumeTemplateDummyStatus":                                            schema_kubevirtio_api_core_v1_DataVolumeTemplateDummyStatus(ref),
+		"kubevirt.io/api/core/v1.DataVolumeTemplateSpec":                                                   schema_kubevirtio_api_core_v1_DataVolumeTemplateSpec(ref),
+		"kubevirt.io/api/core/v1.DeprecatedInterfaceMacvtap":                                               schema_kubevirtio_api_core_v1_DeprecatedInterfaceMacvtap(ref),
+		"kubevirt.io/api/core/v1.DeprecatedInterfacePasst":                                                 schema_kubevirtio_api_core_v1_DeprecatedInterfacePasst(ref),
+		"kubevirt.io/api/core/v1.DeprecatedInterfaceSlirp":                                                 schema_kubevirtio_api_core_v1_DeprecatedInterfaceSlirp(ref),
+		"kubevirt.io/api/core/v1.DeveloperConfiguration":                                                   schema_kubevirtio_api_core_v1_DeveloperConfiguration(ref),
+		"kubevirt.io/api/core/v1.Devices":                                                                  schema_kubevirtio_api_core_v1_Devices(ref),
+		"kubevirt.io/api/core/v1.Diag288Watchdog":                                                          schema_kubevirtio_api_core_v1_Diag288Watchdog(ref),
+		"kubevirt.io/api/core/v1.DisableFreePageReporting":                                                 schema_kubevirtio_api_core_v1_DisableFreePageReporting(ref),
+		"kubevirt.io/api/core/v1.DisableSerialConsoleLog":                                                  schema_kubevirtio_api_core_v1_DisableSerialConsoleLog(ref),
+		"kubevirt.io/api/core/v1.Disk":                                                                     schema_kubevirtio_api_core_v1_Disk(ref),
+		"kubevirt.io/api/core/v1.DiskDevice":                                                               schema_kubevirtio_api_core_v1_DiskDevice(ref),
+		"kubevirt.io/api/core/v1.DiskIOThreads":                                                            schema_kubevirtio_api_core_v1_DiskIOThreads(ref),
+		"kubevirt.io/api/core/v1.DiskTarget":                                                               schema_kubevirtio_api_core_v1_DiskTarget(ref),
+		"kubevirt.io/api/core/v1.DiskVerification":                                                         schema_kubevirtio_api_core_v1_DiskVerification(ref),
+		"kubevirt.io/api/core/v1.DomainMemoryDumpInfo":                                                     schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref),
+		"kubevirt.io/api/core/v1.DomainSpec":                                                               schema_kubevirtio_api_core_v1_DomainSpec(ref),
+		"kubevirt.io/api/core/v1.DownwardAPIVolumeSource":                                                  schema_kubevirtio_api_core_v1_DownwardAPIVolumeSource(ref),
+		"kubevirt.io/api/core/v1.DownwardMetrics":                                                          schema_kubevirtio_api_core_v1_DownwardMetrics(ref),
+		"kubevirt.io/api/core/v1.DownwardMetricsVolumeSource":                                              schema_kubevirtio_api_core_v1_DownwardMetricsVolumeSource(ref),
+		"kubevirt.io/api/core/v1.EFI":                                                                      schema_kubevirtio_api_core_v1_EFI(ref),
+		"kubevirt.io/api/core/v1.EmptyDiskSource":                                                          schema_kubevirtio_api_core_v1_EmptyDiskSource(ref),
+		"kubevirt.io/api/core/v1.EphemeralVolumeSource":                                                    schema_kubevirtio_api_core_v1_EphemeralVolumeSource(ref),
+		"kubevirt.io/api/core/v1.EvacuateCancelOptions":                                                    schema_kubevirtio_api_core_v1_EvacuateCancelOptions(ref),
+		"kubevirt.io/api/core/v1.FeatureAPIC":                                                              schema_kubevirtio_api_core_v1_FeatureAPIC(ref),
+		"kubevirt.io/api/core/v1.FeatureHyperv":                                                            schema_kubevirtio_api_core_v1_FeatureHyperv(ref),
+		"kubevirt.io/api/core/v1.FeatureKVM":                                                               schema_kubevirtio_api_core_v1_FeatureKVM(ref),
+		"kubevirt.io/api/core/v1.FeatureSpinlocks":                                                         schema_kubevirtio_api_core_v1_FeatureSpinlocks(ref),
+		"kubevirt.io/api/core/v1.FeatureState":                                                             schema_kubevirtio_api_core_v1_FeatureState(ref),
+		"kubevirt.io/api/core/v1.FeatureVendorID":                                                          schema_kubevirtio_api_core_v1_FeatureVendorID(ref),
+		"kubevirt.io/api/core/v1.Features":                                                                 schema_kubevirtio_api_core_v1_Features(ref),
+		"kubevirt.io/api/core/v1.Filesystem":                                                               schema_kubevirtio_api_core_v1_Filesystem(ref),
+		"kubevirt.io/api/core/v1.FilesystemVirtiofs":                                                       schema_kubevirtio_api_core_v1_FilesystemVirtiofs(ref),
+		"kubevirt.io/api/core/v1.Firmware":                                                                 schema_kubevirtio_api_core_v1_Firmware(ref),
+		"kubevirt.io/api/core/v1.Flags":                                                                    schema_kubevirtio_api_core_v1_Flags(ref),
+		"kubevirt.io/api/core/v1.FreezeUnfreezeTimeout":                                                    schema_kubevirtio_api_core_v1_FreezeUnfreezeTimeout(ref),
+		"kubevirt.io/api/core/v1.GPU":                                                                      schema_kubevirtio_api_core_v1_GPU(ref),
+		"kubevirt.io/api/core/v1.GenerationStatus":                                                         schema_kubevirtio_api_core_v1_GenerationStatus(ref),
+		"kubevirt.io/api/core/v1.GuestAgentCommandInfo":                                                    schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref),
+		"kubevirt.io/api/core/v1.GuestAgentPing":                                                           schema_kubevirtio_api_core_v1_GuestAgentPing(ref),
+		"kubevirt.io/api/core/v1.HPETTimer":                                                                schema_kubevirtio_api_core_v1_HPETTimer(ref),
+		"kubevirt.io/api/core/v1.Handler":                                                                  schema_kubevirtio_api_core_v1_Handler(ref),
+		"kubevirt.io/api/core/v1.HostDevice":                                                               schema_kubevirtio_api_core_v1_HostDevice(ref),
+		"kubevirt.io/api/core/v1.HostDisk":                                                                 schema_kubevirtio_api_core_v1_HostDisk(ref),
+		"kubevirt.io/api/core/v1.HotplugVolumeSource":                                                      schema_kubevirtio_api_core_v1_HotplugVolumeSource(ref),
+		"kubevirt.io/api/core/v1.HotplugVolumeStatus":                                                      schema_kubevirtio_api_core_v1_HotplugVolumeStatus(ref),
+		"kubevirt.io/api/core/v1.Hugepages":                                                                schema_kubevirtio_api_core_v1_Hugepages(ref),
+		"kubevirt.io/api/core/v1.HyperVPassthrough":                                                        schema_kubevirtio_api_core_v1_HyperVPassthrough(ref),
+		"kubevirt.io/api/core/v1.HypervTimer":                                                              schema_kubevirtio_api_core_v1_HypervTimer(ref),
+		"kubevirt.io/api/core/v1.HypervisorConfiguration":                                                  schema_kubevirtio_api_core_v1_HypervisorConfiguration(ref),
+		"kubevirt.io/api/core/v1.I6300ESBWatchdog":                                                         schema_kubevirtio_api_core_v1_I6300ESBWatchdog(ref),
+		"kubevirt.io/api/core/v1.InitrdInfo":                                                               schema_kubevirtio_api_core_v1_InitrdInfo(ref),
+		"kubevirt.io/api/core/v1.Input":                                                                    schema_kubevirtio_api_core_v1_Input(ref),
+		"kubevirt.io/api/core/v1.InstancetypeConfiguration":                                                schema_kubevirtio_api_core_v1_InstancetypeConfiguration(ref),
+		"kubevirt.io/api/core/v1.InstancetypeMatcher":                                                      schema_kubevirtio_api_core_v1_InstancetypeMatcher(ref),
+		"kubevirt.io/api/core/v1.InstancetypeStatusRef":                                                    schema_kubevirtio_api_core_v1_InstancetypeStatusRef(ref),
+		"kubevirt.io/api/core/v1.Interface":                                                                schema_kubevirtio_api_core_v1_Interface(ref),
+		"kubevirt.io/api/core/v1.InterfaceBindingMethod":                                                   schema_kubevirtio_api_core_v1_InterfaceBindingMethod(ref),
+		"kubevirt.io/api/core/v1.InterfaceBindingMigration":                                                schema_kubevirtio_api_core_v1_InterfaceBindingMigration(ref),
+		"kubevirt.io/api/core/v1.InterfaceBindingPlugin":                                                   schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref),
+		"kubevirt.io/api/core/v1.InterfaceBridge":                                                          schema_kubevirtio_api_core_v1_InterfaceBridge(ref),
+		"kubevirt.io/api/core/v1.InterfaceMasquerade":                                                      schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref),
+		"kubevirt.io/api/core/v1.InterfacePasstBinding":                                                    schema_kubevirtio_api_core_v1_InterfacePasstBinding(ref),
+		"kubevirt.io/api/core/v1.InterfaceSRIOV":                                                           schema_kubevirtio_api_core_v1_InterfaceSRIOV(ref),
+		"kubevirt.io/api/core/v1.KSMConfiguration":                                                         schema_kubevirtio_api_core_v1_KSMConfiguration(ref),
+		"kubevirt.io/api/core/v1.KVMTimer":                                                                 schema_kubevirtio_api_core_v1_KVMTimer(ref),
+		"kubevirt.io/api/core/v1.KernelBoot":                                                               schema_kubevirtio_api_core_v1_KernelBoot(ref),
+		"kubevirt.io/api/core/v1.KernelBootContainer":                                                      schema_kubevirtio_api_core_v1_KernelBootContainer(ref),
+		"kubevirt.io/api/core/v1.KernelBootStatus":                                                         schema_kubevirtio_api_core_v1_KernelBootStatus(ref),
+		"kubevirt.io/api/core/v1.KernelInfo":                                                               schema_kubevirtio_api_core_v1_KernelInfo(ref),
+		"kubevirt.io/api/core/v1.KubeVirt":                                                                 schema_kubevirtio_api_core_v1_KubeVirt(ref),
+		"kubevirt.io/api/core/v1.KubeVirtCertificateRotateStrategy":                                        schema_kubevirtio_api_core_v1_KubeVirtCertificateRotateStrategy(ref),
+		"kubevirt.io/api/core/v1.KubeVirtCondition":                                                        schema_kubevirtio_api_core_v1_KubeVirtCondition(ref),
+		"kubevirt.io/api/core/v1.KubeVirtConfiguration":                                                    schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref),
+		"kubevirt.io/api/core/v1.KubeVirtList":                                                             schema_kubevirtio_api_core_v1_KubeVirtList(ref),
+		"kubevirt.io/api/core/v1.KubeVirtSelfSignConfiguration":                                            schema_kubevirtio_api_core_v1_KubeVirtSelfSignConfiguration(ref),
+		"kubevirt.io/api/core/v1.KubeVirtSpec":                                                             schema_kubevirtio_api_core_v1_KubeVirtSpec(ref),
+		"kubevirt.io/api/core/v1.KubeVirtStatus":                                                           schema_kubevirtio_api_core_v1_KubeVirtStatus(ref),
+		"kubevirt.io/api/core/v1.KubeVirtWorkloadUpdateStrategy":                                           schema_kubevirtio_api_core_v1_KubeVirtWorkloadUpdateStrategy(ref),
+		"kubevirt.io/api/core/v1.LaunchSecurity":                                                           schema_kubevirtio_api_core_v1_LaunchSecurity(ref),
+		"kubevirt.io/api/core/v1.LiveUpdateConfiguration":                                                  schema_kubevirtio_api_core_v1_LiveUpdateConfiguration(ref),
+		"kubevirt.io/api/core/v1.LogVerbosity":                                                             schema_kubevirtio_api_core_v1_LogVerbosity(ref),
+		"kubevirt.io/api/core/v1.LunTarget":                                                                schema_kubevirtio_api_core_v1_LunTarget(ref),
+		"kubevirt.io/api/core/v1.Machine":                                                                  schema_kubevirtio_api_core_v1_Machine(ref),
+		"kubevirt.io/api/core/v1.MediatedDevicesConfiguration":                                             schema_kubevirtio_api_core_v1_MediatedDevicesConfiguration(ref),
+		"kubevirt.io/api/core/v1.MediatedHostDevice":                                                       schema_kubevirtio_api_core_v1_MediatedHostDevice(ref),
+		"kubevirt.io/api/core/v1.Memory":                                                                   schema_kubevirtio_api_core_v1_Memory(ref),
+		"kubevirt.io/api/core/v1.MemoryDumpVolumeSource":                                                   schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref),
+		"kubevirt.io/api/core/v1.MemoryStatus":                                                             schema_kubevirtio_api_core_v1_MemoryStatus(ref),
+		"kubevirt.io/api/core/v1.MigrateOptions":                                                           schema_kubevirtio_api_core_v1_MigrateOptions(ref),
+		"kubevirt.io/api/core/v1.MigrationConfiguration":                                                   schema_kubevirtio_api_core_v1_MigrationConfiguration(ref),
+		"kubevirt.io/api/core/v1.MultusNetwork":                                                            schema_kubevirtio_api_core_v1_MultusNetwork(ref),
+		"kubevirt.io/api/core/v1.NUMA":                                                                     schema_kubevirtio_api_core_v1_NUMA(ref),
+		"kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough":                                              schema_kubevirtio_api_core_v1_NUMAGuestMappingPassthrough(ref),
+		"kubevirt.io/api/core/v1.Network":                                                                  schema_kubevirtio_api_core_v1_Network(ref),
+		"kubevirt.io/api/core/v1.NetworkConfiguration":                                                     schema_kubevirtio_api_core_v1_NetworkConfiguration(ref),
+		"kubevirt.io/api/core/v1.NetworkSource":                                                            schema_kubevirtio_api_core_v1_NetworkSource(ref),
+		"kubevirt.io/api/core/v1.NoCloudSSHPublicKeyAccessCredentialPropagation":                           schema_kubevirtio_api_core_v1_NoCloudSSHPublicKeyAccessCredentialPropagation(ref),
+		"kubevirt.io/api/core/v1.NodeMediatedDeviceTypesConfig":                                            schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref),
+		"kubevirt.io/api/core/v1.NodePlacement":                                                            schema_kubevirtio_api_core_v1_NodePlacement(ref),
+		"kubevirt.io/api/core/v1.ObjectGraphNode":                                                          schema_kubevirtio_api_core_v1_ObjectGraphNode(ref),
+		"kubevirt.io/api/core/v1.ObjectGraphOptions":                                                       schema_kubevirtio_api_core_v1_ObjectGraphOptions(ref),
+		"kubevirt.io/api/core/v1.PITTimer":                                                                 schema_kubevirtio_api_core_v1_PITTimer(ref),
+		"kubevirt.io/api/core/v1.PanicDevice":                                                              schema_kubevirtio_api_core_v1_PanicDevice(ref),
+		"kubevirt.io/api/core/v1.PauseOptions":                                                             schema_kubevirtio_api_core_v1_PauseOptions(ref),
+		"kubevirt.io/api/core/v1.PciHostDevice":                                                            schema_kubevirtio_api_core_v1_PciHostDevice(ref),
+		"kubevirt.io/api/core/v1.PermittedHostDevices":                                                     schema_kubevirtio_api_core_v1_PermittedHostDevices(ref),
+		"kubevirt.io/api/core/v1.PersistentVolumeClaimInfo":                                                schema_kubevirtio_api_core_v1_PersistentVolumeClaimInfo(ref),
+		"kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                        schema_kubevirtio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
+		"kubevirt.io/api/core/v1.PluginBinding":                                                            schema_kubevirtio_api_core_v1_PluginBinding(ref),
+		"kubevirt.io/api/core/v1.PodNetwork":                                                               schema_kubevirtio_api_core_v1_PodNetwork(ref),
+		"kubevirt.io/api/core/v1.Port":                                                                     schema_kubevirtio_api_core_v1_Port(ref),
+		"kubevirt.io/api/core/v1.PreferenceMatcher":                                                        schema_kubevirtio_api_core_v1_PreferenceMatcher(ref),
+		"kubevirt.io/api/core/v1.Probe":                                                                    schema_kubevirtio_api_core_v1_Probe(ref),
+		"kubevirt.io/api/core/v1.ProfilerResult":                                                           schema_kubevirtio_api_core_v1_ProfilerResult(ref),
+		"kubevirt.io/api/core/v1.QemuGuestAgentSSHPublicKeyAccessCredentialPropagation":                    schema_kubevirtio_api_core_v1_QemuGuestAgentSSHPublicKeyAccessCredentialPropagation(ref),
+		"kubevirt.io/api/core/v1.QemuGuestAgentUserPasswordAccessCredentialPropagation":                    schema_kubevirtio_api_core_v1_QemuGuestAgentUserPasswordAccessCredentialPropagation(ref),
+		"kubevirt.io/api/core/v1.RESTClientConfiguration":                                                  schema_kubevirtio_api_core_v1_RESTClientConfiguration(ref),
+		"kubevirt.io/api/core/v1.RTCTimer":                                                                 schema_kubevirtio_api_core_v1_RTCTimer(ref),
+		"kubevirt.io/api/core/v1.RateLimiter":                                                              schema_kubevirtio_api_core_v1_RateLimiter(ref),
+		"kubevirt.io/api/core/v1.Realtime":                                                                 schema_kubevirtio_api_core_v1_Realtime(ref),
+		"kubevirt.io/api/core/v1.ReloadableComponentConfiguration":                                         schema_kubevirtio_api_core_v1_ReloadableComponentConfiguration(ref),
+		"kubevirt.io/api/core/v1.RemoveVolumeOptions":                                                      schema_kubevirtio_api_core_v1_RemoveVolumeOptions(ref),
+		"kubevirt.io/api/core/v1.ReservedOverhead":                                                         schema_kubevirtio_api_core_v1_ReservedOverhead(ref),
+		"kubevirt.io/api/core/v1.ResourceRequirements":                                                     schema_kubevirtio_api_core_v1_ResourceRequirements(ref),
+		"kubevirt.io/api/core/v1.ResourceRequirementsWithoutClaims":                                        schema_kubevirtio_api_core_v1_ResourceRequirementsWithoutClaims(ref),
+		"kubevirt.io/api/core/v1.RestartOptions":                                                           schema_kubevirtio_api_core_v1_RestartOptions(ref),
+		"kubevirt.io/api/core/v1.Rng":                                                                      schema_kubevirtio_api_core_v1_Rng(ref),
+		"kubevirt.io/api/core/v1.SEV":                                                                      schema_kubevirtio_api_core_v1_SEV(ref),
+		"kubevirt.io/api/core/v1.SEVAttestation":                                                           schema_kubevirtio_api_core_v1_SEVAttestation(ref),
+		"kubevirt.io/api/core/v1.SEVMeasurementInfo":                                                       schema_kubevirtio_api_core_v1_SEVMeasurementInfo(ref),
+		"kubevirt.io/api/core/v1.SEVPlatformInfo":                                                          schema_kubevirtio_api_core_v1_SEVPlatformInfo(ref),
+		"kubevirt.io/api/core/v1.SEVPolicy":                                                                schema_kubevirtio_api_core_v1_SEVPolicy(ref),
+		"kubevirt.io/api/core/v1.SEVSNP":                                                                   schema_kubevirtio_api_core_v1_SEVSNP(ref),
+		"kubevirt.io/api/core/v1.SEVSecretOptions":                                                         schema_kubevirtio_api_core_v1_SEVSecretOptions(ref),
+		"kubevirt.io/api/core/v1.SEVSessionOptions":                                                        schema_kubevirtio_api_core_v1_SEVSessionOptions(ref),
+		"kubevirt.io/api/core/v1.SMBiosConfiguration":                                                      schema_kubevirtio_api_core_v1_SMBiosConfiguration(ref),
+		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredential":                                             schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredential(ref),
+		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredentialPropagationMethod":                            schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredentialPropagationMethod(ref),
+		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredentialSource":                                       schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredentialSource(ref),
+		"kubevirt.io/api/core/v1.ScreenshotOptions":                                                        schema_kubevirtio_api_core_v1_ScreenshotOptions(ref),
+		"kubevirt.io/api/core/v1.SeccompConfiguration":                                                     schema_kubevirtio_api_core_v1_SeccompConfiguration(ref),
+		"kubevirt.io/api/core/v1.SecretVolumeSource":                                                       schema_kubevirtio_api_core_v1_SecretVolumeSource(ref),
+		"kubevirt.io/api/core/v1.ServiceAccountVolumeSource":                                               schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref),
+		"kubevirt.io/api/core/v1.SoundDevice":                                                              schema_kubevirtio_api_core_v1_SoundDevice(ref),
+		"kubevirt.io/api/core/v1.StartOptions":                                                             schema_kubevirtio_api_core_v1_StartOptions(ref),
+		"kubevirt.io/api/core/v1.StopOptions":                                                              schema_kubevirtio_api_core_v1_StopOptions(ref),
+		"kubevirt.io/api/core/v1.StorageMigratedVolumeInfo":                                                schema_kubevirtio_api_core_v1_StorageMigratedVolumeInfo(ref),
+		"kubevirt.io/api/core/v1.SupportContainerResources":                                                schema_kubevirtio_api_core_v1_SupportContainerResources(ref),
+		"kubevirt.io/api/core/v1.SyNICTimer":                                                               schema_kubevirtio_api_core_v1_SyNICTimer(ref),
+		"kubevirt.io/api/core/v1.SysprepSource":                                                            schema_kubevirtio_api_core_v1_SysprepSource(ref),
+		"kubevirt.io/api/core/v1.TDX":                                                                      schema_kubevirtio_api_core_v1_TDX(ref),
+		"kubevirt.io/api/core/v1.TLBFlush":                                                                 schema_kubevirtio_api_core_v1_TLBFlush(ref),
+		"kubevirt.io/api/core/v1.TLSConfiguration":                                                         schema_kubevirtio_api_core_v1_TLSConfiguration(ref),
+		"kubevirt.io/api/core/v1.TPMDevice":                                                                schema_kubevirtio_api_core_v1_TPMDevice(ref),
+		"kubevirt.io/api/core/v1.Timer":                                                                    schema_kubevirtio_api_core_v1_Timer(ref),
+		"kubevirt.io/api/core/v1.TokenBucketRateLimiter":                                                   schema_kubevirtio_api_core_v1_TokenBucketRateLimiter(ref),
+		"kubevirt.io/api/core/v1.TopologyHints":                                                            schema_kubevirtio_api_core_v1_TopologyHints(ref),
+		"kubevirt.io/api/core/v1.USBHostDevice":                                                            schema_kubevirtio_api_core_v1_USBHostDevice(ref),
+		"kubevirt.io/api/core/v1.USBSelector":                                                              schema_kubevirtio_api_core_v1_USBSelector(ref),
+		"kubevirt.io/api/core/v1.UnpauseOptions":                                                           schema_kubevirtio_api_core_v1_UnpauseOptions(ref),
+		"kubevirt.io/api/core/v1.UserPasswordAccessCredential":                                             schema_kubevirtio_api_core_v1_UserPasswordAccessCredential(ref),
+		"kubevirt.io/api/core/v1.UserPasswordAccessCredentialPropagationMethod":                            schema_kubevirtio_api_core_v1_UserPasswordAccessCredentialPropagationMethod(ref),
+		"kubevirt.io/api/core/v1.UserPasswordAccessCredentialSource":                                       schema_kubevirtio_api_core_v1_UserPasswordAccessCredentialSource(ref),
+		"kubevirt.io/api/core/v1.UtilityVolume":                                                            schema_kubevirtio_api_core_v1_UtilityVolume(ref),
+		"kubevirt.io/api/core/v1.VGPUDisplayOptions":                                                       schema_kubevirtio_api_core_v1_VGPUDisplayOptions(ref),
+		"kubevirt.io/api/core/v1.VGPUOptions":                                                              schema_kubevirtio_api_core_v1_VGPUOptions(ref),
+		"kubevirt.io/api/core/v1.VMISelector":                                                              schema_kubevirtio_api_core_v1_VMISelector(ref),
+		"kubevirt.io/api/core/v1.VSOCKOptions":                                                             schema_kubevirtio_api_core_v1_VSOCKOptions(ref),
+		"kubevirt.io/api/core/v1.VideoDevice":                                                              schema_kubevirtio_api_core_v1_VideoDevice(ref),
+		"kubevirt.io/api/core/v1.VirtTemplateDeployment":                                                   schema_kubevirtio_api_core_v1_VirtTemplateDeployment(ref),
+		"kubevirt.io/api/core/v1.VirtualMachine":                                                           schema_kubevirtio_api_core_v1_VirtualMachine(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineCondition":                                                  schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstance":                                                   schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceBackupStatus":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupStatus(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceCommonMigrationState":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceCommonMigrationState(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceCondition":                                          schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemDisk":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemDisk(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemInfo(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemList":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemList(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestAgentInfo":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSInfo(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUser":                                        schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUser(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSUserList":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestOSUserList(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceList":                                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceList(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigration":                                          schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigration(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationCondition":                                 schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationList":                                      schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationList(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationPhaseTransitionTimestamp":                  schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationPhaseTransitionTimestamp(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSource":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSource(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSourceState":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSourceState(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSpec":                                      schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSpec(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationState(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationStatus":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationStatus(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationTarget":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationTarget(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationTargetState":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationTargetState(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceNetworkInterface(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp":                           schema_kubevirtio_api_core_v1_VirtualMachineInstancePhaseTransitionTimestamp(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstancePreset":                                             schema_kubevirtio_api_core_v1_VirtualMachineInstancePreset(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstancePresetList":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstancePresetList(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstancePresetSpec":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstancePresetSpec(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceProfile":                                            schema_kubevirtio_api_core_v1_VirtualMachineInstanceProfile(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSet":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSet(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetCondition":                                schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetList":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetList(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetSpec":                                     schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetSpec(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSetStatus":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceReplicaSetStatus(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceSpec":                                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceStatus":                                             schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceTemplateSpec(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineList":                                                       schema_kubevirtio_api_core_v1_VirtualMachineList(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest":                                          schema_kubevirtio_api_core_v1_VirtualMachineMemoryDumpRequest(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineOptions":                                                    schema_kubevirtio_api_core_v1_VirtualMachineOptions(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineSpec":                                                       schema_kubevirtio_api_core_v1_VirtualMachineSpec(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineStartFailure":                                               schema_kubevirtio_api_core_v1_VirtualMachineStartFailure(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineStateChangeRequest":                                         schema_kubevirtio_api_core_v1_VirtualMachineStateChangeRequest(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineStatus":                                                     schema_kubevirtio_api_core_v1_VirtualMachineStatus(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineVolumeRequest":                                              schema_kubevirtio_api_core_v1_VirtualMachineVolumeRequest(ref),
+		"kubevirt.io/api/core/v1.Volume":                                                                   schema_kubevirtio_api_core_v1_Volume(ref),
+		"kubevirt.io/api/core/v1.VolumeMigrationState":                                                     schema_kubevirtio_api_core_v1_VolumeMigrationState(ref),
+		"kubevirt.io/api/core/v1.VolumeSnapshotStatus":                                                     schema_kubevirtio_api_core_v1_VolumeSnapshotStatus(ref),
+		"kubevirt.io/api/core/v1.VolumeSource":                                                             schema_kubevirtio_api_core_v1_VolumeSource(ref),
+		"kubevirt.io/api/core/v1.VolumeStatus":                                                             schema_kubevirtio_api_core_v1_VolumeStatus(ref),
+		"kubevirt.io/api/core/v1.VolumeUpdateState":                                                        schema_kubevirtio_api_core_v1_VolumeUpdateState(ref),
+		"kubevirt.io/api/core/v1.Watchdog":                                                                 schema_kubevirtio_api_core_v1_Watchdog(ref),
+		"kubevirt.io/api/core/v1.WatchdogDevice":                                                           schema_kubevirtio_api_core_v1_WatchdogDevice(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachineOpportunisticUpdateStrategy":                           schema_kubevirtio_api_pool_v1beta1_VirtualMachineOpportunisticUpdateStrategy(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePool":                                                  schema_kubevirtio_api_pool_v1beta1_VirtualMachinePool(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolAutohealingStrategy":                               schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolAutohealingStrategy(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolCondition":                                         schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolCondition(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolList":                                              schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolList(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolNameGeneration":                                    schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolNameGeneration(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolOpportunisticScaleInStrategy":                      schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolOpportunisticScaleInStrategy(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolProactiveScaleInStrategy":                          schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolProactiveScaleInStrategy(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolProactiveUpdateStrategy":                           schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolProactiveUpdateStrategy(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolScaleInStrategy":                                   schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolScaleInStrategy(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSelectionPolicy":                                   schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolSelectionPolicy(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSelectors":                                         schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolSelectors(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSpec":                                              schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolSpec(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolStatus":                                            schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolStatus(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolUnmanagedStrategy":                                 schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolUnmanagedStrategy(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolUpdateStrategy":                                    schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolUpdateStrategy(ref),
+		"kubevirt.io/api/pool/v1beta1.VirtualMachineTemplateSpec":                                          schema_kubevirtio_api_pool_v1beta1_VirtualMachineTemplateSpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDI":                            schema_pkg_apis_core_v1beta1_CDI(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDICertConfig":                  schema_pkg_apis_core_v1beta1_CDICertConfig(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDIConfig":                      schema_pkg_apis_core_v1beta1_CDIConfig(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDIConfigList":                  schema_pkg_apis_core_v1beta1_CDIConfigList(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDIConfigSpec":                  schema_pkg_apis_core_v1beta1_CDIConfigSpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDIConfigStatus":                schema_pkg_apis_core_v1beta1_CDIConfigStatus(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDIList":                        schema_pkg_apis_core_v1beta1_CDIList(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDISpec":                        schema_pkg_apis_core_v1beta1_CDISpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CDIStatus":                      schema_pkg_apis_core_v1beta1_CDIStatus(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CertConfig":                     schema_pkg_apis_core_v1beta1_CertConfig(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ClaimPropertySet":               schema_pkg_apis_core_v1beta1_ClaimPropertySet(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ComponentConfig":                schema_pkg_apis_core_v1beta1_ComponentConfig(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ConditionState":                 schema_pkg_apis_core_v1beta1_ConditionState(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CustomTLSProfile":               schema_pkg_apis_core_v1beta1_CustomTLSProfile(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CustomizeComponents":            schema_pkg_apis_core_v1beta1_CustomizeComponents(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.CustomizeComponentsPatch":       schema_pkg_apis_core_v1beta1_CustomizeComponentsPatch(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataImportCron":                 schema_pkg_apis_core_v1beta1_DataImportCron(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataImportCronCondition":        schema_pkg_apis_core_v1beta1_DataImportCronCondition(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataImportCronList":             schema_pkg_apis_core_v1beta1_DataImportCronList(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataImportCronSpec":             schema_pkg_apis_core_v1beta1_DataImportCronSpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataImportCronStatus":           schema_pkg_apis_core_v1beta1_DataImportCronStatus(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSource":                     schema_pkg_apis_core_v1beta1_DataSource(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSourceCondition":            schema_pkg_apis_core_v1beta1_DataSourceCondition(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSourceList":                 schema_pkg_apis_core_v1beta1_DataSourceList(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSourceRefSourceDataSource":  schema_pkg_apis_core_v1beta1_DataSourceRefSourceDataSource(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSourceSource":               schema_pkg_apis_core_v1beta1_DataSourceSource(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSourceSpec":                 schema_pkg_apis_core_v1beta1_DataSourceSpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataSourceStatus":               schema_pkg_apis_core_v1beta1_DataSourceStatus(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolume":                     schema_pkg_apis_core_v1beta1_DataVolume(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeBlankImage":           schema_pkg_apis_core_v1beta1_DataVolumeBlankImage(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeCheckpoint":           schema_pkg_apis_core_v1beta1_DataVolumeCheckpoint(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeCondition":            schema_pkg_apis_core_v1beta1_DataVolumeCondition(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeList":                 schema_pkg_apis_core_v1beta1_DataVolumeList(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSource":               schema_pkg_apis_core_v1beta1_DataVolumeSource(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceGCS":            schema_pkg_apis_core_v1beta1_DataVolumeSourceGCS(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceHTTP":           schema_pkg_apis_core_v1beta1_DataVolumeSourceHTTP(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceImageIO":        schema_pkg_apis_core_v1beta1_DataVolumeSourceImageIO(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourcePVC":            schema_pkg_apis_core_v1beta1_DataVolumeSourcePVC(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceRef":            schema_pkg_apis_core_v1beta1_DataVolumeSourceRef(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceRegistry":       schema_pkg_apis_core_v1beta1_DataVolumeSourceRegistry(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceS3":             schema_pkg_apis_core_v1beta1_DataVolumeSourceS3(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceSnapshot":       schema_pkg_apis_core_v1beta1_DataVolumeSourceSnapshot(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceUpload":         schema_pkg_apis_core_v1beta1_DataVolumeSourceUpload(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSourceVDDK":           schema_pkg_apis_core_v1beta1_DataVolumeSourceVDDK(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeSpec":                 schema_pkg_apis_core_v1beta1_DataVolumeSpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.DataVolumeStatus":               schema_pkg_apis_core_v1beta1_DataVolumeStatus(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.FilesystemOverhead":             schema_pkg_apis_core_v1beta1_FilesystemOverhead(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.Flags":                          schema_pkg_apis_core_v1beta1_Flags(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ImportProxy":                    schema_pkg_apis_core_v1beta1_ImportProxy(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ImportSourceType":               schema_pkg_apis_core_v1beta1_ImportSourceType(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ImportStatus":                   schema_pkg_apis_core_v1beta1_ImportStatus(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.IntermediateTLSProfile":         schema_pkg_apis_core_v1beta1_IntermediateTLSProfile(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ModernTLSProfile":               schema_pkg_apis_core_v1beta1_ModernTLSProfile(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ObjectTransfer":                 schema_pkg_apis_core_v1beta1_ObjectTransfer(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ObjectTransferCondition":        schema_pkg_apis_core_v1beta1_ObjectTransferCondition(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ObjectTransferList":             schema_pkg_apis_core_v1beta1_ObjectTransferList(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ObjectTransferSpec":             schema_pkg_apis_core_v1beta1_ObjectTransferSpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ObjectTransferStatus":           schema_pkg_apis_core_v1beta1_ObjectTransferStatus(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.OldTLSProfile":                  schema_pkg_apis_core_v1beta1_OldTLSProfile(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.PlatformOptions":                schema_pkg_apis_core_v1beta1_PlatformOptions(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.StorageProfile":                 schema_pkg_apis_core_v1beta1_StorageProfile(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.StorageProfileList":             schema_pkg_apis_core_v1beta1_StorageProfileList(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.StorageProfileSpec":             schema_pkg_apis_core_v1beta1_StorageProfileSpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.StorageProfileStatus":           schema_pkg_apis_core_v1beta1_StorageProfileStatus(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.StorageSpec":                    schema_pkg_apis_core_v1beta1_StorageSpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.TLSProfileSpec":                 schema_pkg_apis_core_v1beta1_TLSProfileSpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.TLSSecurityProfile":             schema_pkg_apis_core_v1beta1_TLSSecurityProfile(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.TransferSource":                 schema_pkg_apis_core_v1beta1_TransferSource(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.TransferTarget":                 schema_pkg_apis_core_v1beta1_TransferTarget(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeCloneSource":              schema_pkg_apis_core_v1beta1_VolumeCloneSource(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeCloneSourceList":          schema_pkg_apis_core_v1beta1_VolumeCloneSourceList(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeCloneSourceSpec":          schema_pkg_apis_core_v1beta1_VolumeCloneSourceSpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeImportSource":             schema_pkg_apis_core_v1beta1_VolumeImportSource(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeImportSourceList":         schema_pkg_apis_core_v1beta1_VolumeImportSourceList(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeImportSourceSpec":         schema_pkg_apis_core_v1beta1_VolumeImportSourceSpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeImportSourceStatus":       schema_pkg_apis_core_v1beta1_VolumeImportSourceStatus(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeUploadSource":             schema_pkg_apis_core_v1beta1_VolumeUploadSource(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeUploadSourceList":         schema_pkg_apis_core_v1beta1_VolumeUploadSourceList(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeUploadSourceSpec":         schema_pkg_apis_core_v1beta1_VolumeUploadSourceSpec(ref),
+		"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.VolumeUploadSourceStatus":       schema_pkg_apis_core_v1beta1_VolumeUploadSourceStatus(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1alpha1.ProcessOptions":                           schema_kubevirtio_virt_template_api_core_subresourcesv1alpha1_ProcessOptions(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1alpha1.ProcessedVirtualMachineTemplate":          schema_kubevirtio_virt_template_api_core_subresourcesv1alpha1_ProcessedVirtualMachineTemplate(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1alpha1.VirtualMachineTemplate":                   schema_kubevirtio_virt_template_api_core_subresourcesv1alpha1_VirtualMachineTemplate(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.ClusterVirtualMachineTemplate":             schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ClusterVirtualMachineTemplate(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessOptions":                            schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessOptions(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessedVirtualMachineTemplate":           schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessedVirtualMachineTemplate(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch":      schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessedVirtualMachineTemplateBatch(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessedVirtualMachineTemplateBatchError": schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessedVirtualMachineTemplateBatchError(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessedVirtualMachineTemplateBatchItem":  schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessedVirtualMachineTemplateBatchItem(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.RollbackOptions":                           schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_RollbackOptions(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.RolledBackVirtualMachineTemplate":          schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_RolledBackVirtualMachineTemplate(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.TemplateInstance":                          schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_TemplateInstance(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.UpgradeOptions":                            schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_UpgradeOptions(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineOverrides":                   schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineOverrides(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachinePatch":                       schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachinePatch(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineSpecChange":                  schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineSpecChange(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineTemplate":                    schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineTemplate(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineTemplateInstances":           schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineTemplateInstances(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineTemplatePreview":             schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineTemplatePreview(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineTemplatePreviewSpec":         schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineTemplatePreviewSpec(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineTemplatePreviewStatus":       schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineTemplatePreviewStatus(ref),
+		"kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineUpgrade":                     schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineUpgrade(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.Generalization":                                       schema_kubevirtio_virt_template_api_core_v1alpha1_Generalization(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.InstancetypeCapture":                                  schema_kubevirtio_virt_template_api_core_v1alpha1_InstancetypeCapture(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.Parameter":                                            schema_kubevirtio_virt_template_api_core_v1alpha1_Parameter(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.Sanitization":                                         schema_kubevirtio_virt_template_api_core_v1alpha1_Sanitization(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.StorageOverride":                                      schema_kubevirtio_virt_template_api_core_v1alpha1_StorageOverride(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.StorageOverrides":                                     schema_kubevirtio_virt_template_api_core_v1alpha1_StorageOverrides(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.TemplateParameterization":                             schema_kubevirtio_virt_template_api_core_v1alpha1_TemplateParameterization(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineReference":                              schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineReference(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplate":                               schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplate(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateList":                           schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateList(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateReference":                      schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateReference(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequest":                        schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequest(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestList":                    schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequestList(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestSpec":                    schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequestSpec(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestStatus":                  schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequestStatus(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateSpec":                           schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateSpec(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateStatus":                         schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateStatus(ref),
+		"kubevirt.io/virt-template-api/core/v1alpha1.VolumeStorageOverride":                                schema_kubevirtio_virt_template_api_core_v1alpha1_VolumeStorageOverride(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.ClusterVirtualMachineTemplate":                         schema_kubevirtio_virt_template_api_core_v1beta1_ClusterVirtualMachineTemplate(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.ClusterVirtualMachineTemplateList":                     schema_kubevirtio_virt_template_api_core_v1beta1_ClusterVirtualMachineTemplateList(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.Generalization":                                        schema_kubevirtio_virt_template_api_core_v1beta1_Generalization(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.InstancetypeCapture":                                   schema_kubevirtio_virt_template_api_core_v1beta1_InstancetypeCapture(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.Parameter":                                             schema_kubevirtio_virt_template_api_core_v1beta1_Parameter(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.Sanitization":                                          schema_kubevirtio_virt_template_api_core_v1beta1_Sanitization(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.StorageOverride":                                       schema_kubevirtio_virt_template_api_core_v1beta1_StorageOverride(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.StorageOverrides":                                      schema_kubevirtio_virt_template_api_core_v1beta1_StorageOverrides(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.TemplateParameterization":                              schema_kubevirtio_virt_template_api_core_v1beta1_TemplateParameterization(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineReference":                               schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineReference(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplate":                                schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplate(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrant":                           schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateGrant(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantFrom":                       schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateGrantFrom(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantList":                       schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateGrantList(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantSpec":                       schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateGrantSpec(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantSubject":                    schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateGrantSubject(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantTo":                         schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateGrantTo(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateInstance":                        schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateInstance(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateInstanceList":                    schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateInstanceList(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateInstanceSpec":                    schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateInstanceSpec(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateInstanceStatus":                  schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateInstanceStatus(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateList":                            schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateList(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateReference":                       schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateReference(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateRequest":                         schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateRequest(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateRequestList":                     schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateRequestList(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateRequestSpec":                     schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateRequestSpec(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateRequestStatus":                   schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateRequestStatus(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateSpec":                            schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateSpec(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateStatus":                          schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateStatus(ref),
+		"kubevirt.io/virt-template-api/core/v1beta1.VolumeStorageOverride":                                 schema_kubevirtio_virt_template_api_core_v1beta1_VolumeStorageOverride(ref),
 	}
 }
 
@@ -29214,6 +29275,569 @@ func schema_kubevirtio_api_core_v1_WatchdogDevice(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachineOpportunisticUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePool resource contains a VirtualMachine configuration that can be used to replicate multiple VirtualMachine resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSpec", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolStatus"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolAutohealingStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"startUpFailureThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartUpFailureThreshold is the number of consecutive VMI start failures (it tracks the value of Status.StartFailure.ConsecutiveFailCount field) before replacing the VM. Defaults to 3",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"minFailingToStartDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinFailingToStartDuration is the minimum time a VM must be in a failing status (applies to status conditions like CrashLoopBackOff, Unschedulable) before being replaced. It measures the duration since the VM's Ready condition transitioned to False. Defaults to 5 minutes",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolList is a list of VirtualMachinePool resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePool"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/pool/v1beta1.VirtualMachinePool"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolNameGeneration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"appendIndexToConfigMapRefs": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"appendIndexToSecretRefs": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolOpportunisticScaleInStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolOpportunisticScaleInStrategy represents opportunistic scale-in strategy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"statePreservation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies if and how to preserve the state of the VMs selected during scale-in. Disabled - (Default) all state for VMs selected for scale-in will be deleted. Offline - PVCs for VMs selected for scale-in will be preserved and reused on scale-out (decreases provisioning time during scale out). Online - PVCs and memory for VMs selected for scale-in will be preserved and reused on scale-out (decreases provisioning and boot time during scale out).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolProactiveScaleInStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolProactiveScaleInStrategy represents proactive scale-in strategy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selectionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SelectionPolicy defines the priority in which VM instances are selected for proactive scale-in Defaults to \"Random\" base policy when no SelectionPolicy is configured",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSelectionPolicy"),
+						},
+					},
+					"statePreservation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies if and how to preserve the state of the VMs selected during scale-in. Disabled - (Default) all state for VMs selected for scale-in will be deleted. Offline - PVCs for VMs selected for scale-in will be preserved and reused on scale-out (decreases provisioning time during scale out). Online - PVCs and memory for VMs selected for scale-in will be preserved and reused on scale-out (decreases provisioning and boot time during scale out).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSelectionPolicy"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolProactiveUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolProactiveUpdateStrategy represents proactive update strategy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selectionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SelectionPolicy defines the priority in which VM instances are selected for proactive update Defaults to \"Random\" base policy when no SelectionPolicy is configured",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSelectionPolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSelectionPolicy"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolScaleInStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolScaleInStrategy specifies how the VMPool controller manages scaling in VMs within a VMPool",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"unmanaged": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The VM is never touched after creation. Users are responsible for scaling in the pool manually.",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolUnmanagedStrategy"),
+						},
+					},
+					"opportunistic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Opportunistic scale-in is a strategy when vms are deleted by some other means than the scale-in action. For example, when the VM is deleted by the user or when the VM is deleted by the node that is hosting the VM.",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolOpportunisticScaleInStrategy"),
+						},
+					},
+					"proactive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Proactive scale-in by forcing VMs to shutdown during scale-in (Default)",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolProactiveScaleInStrategy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolOpportunisticScaleInStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolProactiveScaleInStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolUnmanagedStrategy"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolSelectionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolSelectionPolicy defines the priority in which VM instances are selected for proactive scale-in or update",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sortPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SortPolicy is a catch-all policy [AscendingOrder|DescendingOrder|Newest|Oldest|Random]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selectors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selectors is a list of selection policies.",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSelectors"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolSelectors"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolSelectors(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolSelectors specifies filtering criteria for VM selection. If both are specified, both must match for a VM to be selected. If only one is specified, only that one must match for a VM to be selected.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector is a list of label selector for VMs.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"nodeSelectorRequirementMatcher": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelectorRequirementMatcher is a list of node selector requirement for VMs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.NodeSelectorRequirement"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.NodeSelectorRequirement", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of desired pods. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Label selector for pods. Existing Poolss whose pods are selected by this will be the ones affected by this deployment.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"virtualMachineTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template describes the VM that will be created.",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachineTemplateSpec"),
+						},
+					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that the pool is paused.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"nameGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Options for the name generation in a pool.",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolNameGeneration"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "(Defaults to 100%) Integer or string pointer, that when set represents either a percentage or number of VMs in a pool that can be unavailable (ready condition false) at a time during automated update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"scaleInStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleInStrategy specifies how the VMPool controller manages scaling in VMs within a VMPool",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolScaleInStrategy"),
+						},
+					},
+					"updateStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateStrategy specifies how the VMPool controller manages updating VMs within a VMPool",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolUpdateStrategy"),
+						},
+					},
+					"autohealing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autohealing specifies when a VMpool should replace a failing VM with a reprovisioned instance",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolAutohealingStrategy"),
+						},
+					},
+				},
+				Required: []string{"selector", "virtualMachineTemplate"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolAutohealingStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolNameGeneration", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolScaleInStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolUpdateStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachineTemplateSpec"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolCondition"),
+									},
+								},
+							},
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/pool/v1beta1.VirtualMachinePoolCondition"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolUnmanagedStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachinePoolUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePoolUpdateStrategy specifies how the VMPool controller manages updating VMs within a VMPool, by default it is proactive update.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"unmanaged": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unmanaged indicates that no automatic update of VMs within a VMPool is performed. When this is set, the VMPool controller will not update the VMs within the pool.",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolUnmanagedStrategy"),
+						},
+					},
+					"opportunistic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Opportunistic update only gets applied to the VM, VMI is updated naturally upon the restart. Whereas proactive it applies both the VM and VMI right away.",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachineOpportunisticUpdateStrategy"),
+						},
+					},
+					"proactive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Proactive update by forcing the VMs to restart during update",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePoolProactiveUpdateStrategy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/pool/v1beta1.VirtualMachineOpportunisticUpdateStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolProactiveUpdateStrategy", "kubevirt.io/api/pool/v1beta1.VirtualMachinePoolUnmanagedStrategy"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1beta1_VirtualMachineTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineSpec contains the VirtualMachine specification.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachineSpec"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_CDI(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -32930,6 +33554,41 @@ func schema_kubevirtio_virt_template_api_core_subresourcesv1alpha1_VirtualMachin
 	}
 }
 
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ClusterVirtualMachineTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterVirtualMachineTemplate is a dummy object to satisfy the k8s.io/apiserver conventions. A subresource cannot be served without a storage for its parent resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
 func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -32972,11 +33631,93 @@ func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessOptions
 							},
 						},
 					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision selects the revision of the template to process. If unset, the current state of the template is processed. Optional.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"targetNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetNamespace is the namespace the template is processed into. If it differs from the namespace of the template, a VirtualMachineTemplateGrant in the namespace of the template must allow it. Defaults to the namespace of the template. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun, when present, indicates that the objects created by the create subresource should not be persisted. They are still defaulted, admitted and validated by the API server and returned. The only valid value is All. Optional.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of VirtualMachines created from the template by the create subresource. If set, the template is processed once per VirtualMachine, its generated parameters are generated anew for each of them, and the create subresource responds with a ProcessedVirtualMachineTemplateBatch. If the template declares the parameter INDEX, it is set to the index of each VirtualMachine, starting at zero. At most 100 VirtualMachines can be created at once. Optional.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"namePattern": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamePattern is the name of the VirtualMachines created if Count is set. Every occurrence of ${INDEX} is replaced with the index of the VirtualMachine. If unset, the name of the processed VirtualMachines is used, which must then be unique for each of them. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"outputKind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutputKind is the kind of object the template is processed into and created as, one of VirtualMachine, VirtualMachinePool or VirtualMachineInstanceReplicaSet. Defaults to VirtualMachine. Other kinds cannot be combined with a Count. Optional.\n\nPossible enum values:\n - `\"VirtualMachine\"` processes a template into a VirtualMachine.\n - `\"VirtualMachineInstanceReplicaSet\"` processes a template into a VirtualMachineInstanceReplicaSet of the VirtualMachineInstance template of the rendered VirtualMachine.\n - `\"VirtualMachinePool\"` processes a template into a VirtualMachinePool whose VirtualMachines are rendered from the template.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"VirtualMachine", "VirtualMachineInstanceReplicaSet", "VirtualMachinePool"},
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of replicas of the VirtualMachinePool or VirtualMachineInstanceReplicaSet selected by the OutputKind. Defaults to the default of the selected kind. Optional.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"idempotencyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdempotencyKey identifies a request to the create subresource across retries. It is recorded on the created VirtualMachine, and a repeated request with the same key for the same template returns the existing VirtualMachine instead of creating another one. With a Count, the index of each VirtualMachine is appended to the key. It is only supported for the OutputKind VirtualMachine and must not be longer than 128 characters. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Apply makes the create subresource server-side apply the processed VirtualMachine instead of creating it, with a field manager named after the template. An existing VirtualMachine with the same name is updated to the processed state, for example to reconfigure it with new parameters. It is only supported for the OutputKind VirtualMachine and cannot be combined with an IdempotencyKey. Optional.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"overrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Overrides are applied to the processed VirtualMachine after parameter substitution. The template can restrict them with its PatchablePaths. Optional.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineOverrides"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineOverrides"},
 	}
 }
 
@@ -33015,7 +33756,7 @@ func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessedVirtu
 					},
 					"virtualMachine": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VirtualMachine is a VirtualMachine that was created from processing a template. Required.",
+							Description: "VirtualMachine is a VirtualMachine that was created from processing a template. It is only set if the OutputKind VirtualMachine was requested. Optional.",
 							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachine"),
 						},
 					},
@@ -33026,20 +33767,31 @@ func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessedVirtu
 							Format:      "",
 						},
 					},
+					"virtualMachinePool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachinePool is the VirtualMachinePool wrapping the processed VirtualMachine. It is only set if the OutputKind VirtualMachinePool was requested. Optional.",
+							Ref:         ref("kubevirt.io/api/pool/v1beta1.VirtualMachinePool"),
+						},
+					},
+					"virtualMachineInstanceReplicaSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineInstanceReplicaSet is the VirtualMachineInstanceReplicaSet wrapping the VirtualMachineInstance template of the processed VirtualMachine. It is only set if the OutputKind VirtualMachineInstanceReplicaSet was requested. Optional.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSet"),
+						},
+					},
 				},
-				Required: []string{"virtualMachine"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachine"},
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachine", "kubevirt.io/api/core/v1.VirtualMachineInstanceReplicaSet", "kubevirt.io/api/pool/v1beta1.VirtualMachinePool"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessedVirtualMachineTemplateBatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineTemplate is a dummy object to satisfy the k8s.io/apiserver conventions. A subresource cannot be served without a storage for its parent resource.",
+				Description: "ProcessedVirtualMachineTemplateBatch is the object served by the /create subresource if a Count is requested. It lists the result of creating each VirtualMachine, which succeeds or fails individually.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -33062,111 +33814,270 @@ func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachine
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
+					"templateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateRef contains a reference to the template that was processed. Optional.",
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items lists the result of creating each VirtualMachine, ordered by index. Optional.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessedVirtualMachineTemplateBatchItem"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessedVirtualMachineTemplateBatchItem"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1alpha1_Parameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessedVirtualMachineTemplateBatchError(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Parameter defines a name/value combination that is to be substituted during processing of the template.",
+				Description: "ProcessedVirtualMachineTemplateBatchError describes why creating a single VirtualMachine of a batch failed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"code": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the parameter. It can be referenced in the template VirtualMachine using ${PARAMETER_NAME}. Required.",
-							Default:     "",
+							Description: "Code is the HTTP status code of the error. Required.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a machine-readable description of the error. Optional.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"displayName": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DisplayName is an alternative name that can be shown in a UI instead of the parameter's name. Optional.",
+							Description: "Message is a human-readable description of the error. Optional.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"description": {
+				},
+				Required: []string{"code"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_ProcessedVirtualMachineTemplateBatchItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProcessedVirtualMachineTemplateBatchItem is the result of creating a single VirtualMachine of a batch.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"index": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is the description of the parameter. Optional.",
+							Description: "Index is the index of the VirtualMachine in the batch. Required.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"virtualMachine": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachine is the VirtualMachine that was created. It is unset if creating the VirtualMachine failed. Optional.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachine"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is an optional instructional message that should inform the user how to utilize the newly created VirtualMachine. Optional.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"value": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value holds the value of the Parameter. If specified, a generator will be ignored. The value replaces all occurrences of the ${PARAMETER_NAME} expression during processing of the template. Optional.",
+							Description: "Error is the reason creating the VirtualMachine failed. Optional.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessedVirtualMachineTemplateBatchError"),
+						},
+					},
+				},
+				Required: []string{"index"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VirtualMachine", "kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessedVirtualMachineTemplateBatchError"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_RollbackOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollbackOptions are the options used when rolling back a VirtualMachineTemplate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"generate": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Generate specifies the generator to be used to generate a Value for this parameter. The From field can be used to provide input to this generator If empty, no generator is being used, leaving the result Value untouched. Optional.\n\nThe only supported generator is \"expression\", which accepts a From value with a regex-like syntax, which should follow the form of \"[a-zA-Z0-9]{length}\". The expression defines the range and length of the resulting random characters.\n\nThe following character classes are supported in the range:\n\nrange | characters ------------------------------------------------------------- \"\\w\"  | abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ_ \"\\d\"  | 0123456789 \"\\a\"  | abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ \"\\A\"  | !\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~\n\nGenerated examples:\n\nexpression       | generated value ---------------------------------- \"test[0-9]{1}x\"  | \"test7x\" \"[0-1]{8}\"       | \"01001100\" \"0x[A-F0-9]{4}\"  | \"0xB3AF\" \"[a-zA-Z0-9]{8}\" | \"hW4yQU5i\"",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"from": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "From is used as input for the generator specified in Generate. Optional.",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision of the template to roll back to. Required.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"revision"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_RolledBackVirtualMachineTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolledBackVirtualMachineTemplate is the object served by the /rollback subresource. It represents a rollback action on the parent VirtualMachineTemplate resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"required": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Indicates that the parameter must have a Value or valid Generate and From values. Defaults to false. Optional.",
-							Type:        []string{"boolean"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"templateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateRef contains a reference to the template that was rolled back. Optional.",
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision of the template that was restored. Required.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"templateGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateGeneration is the generation of the template after the rollback. Optional.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
-				Required: []string{"name"},
+				Required: []string{"revision"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_TemplateInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineReference holds a reference to a VirtualMachine.kubevirt.io",
+				Description: "TemplateInstance describes a single VirtualMachine created from a VirtualMachineTemplate.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of the VirtualMachine.",
+							Description: "Namespace is the namespace of the VirtualMachine. Required.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the VirtualMachine.",
+							Description: "Name is the name of the VirtualMachine. Required.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"creationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreationTimestamp is the creation timestamp of the VirtualMachine. Optional.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"templateGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateGeneration is the generation of the template the VirtualMachine was created from. It is zero if the generation is unknown. Optional.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"outdated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outdated indicates that the VirtualMachine was created from an older generation of the template. Optional.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"namespace", "name"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_UpgradeOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineTemplate is the Schema for the virtualmachinetemplates API",
+				Description: "UpgradeOptions are the options used when diffing or upgrading a VirtualMachine that was created from a VirtualMachineTemplate.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -33189,34 +34100,169 @@ func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplate(re
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"spec": {
+					"virtualMachineName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired state of the template",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateSpec"),
+							Description: "VirtualMachineName is the name of the VirtualMachine to diff or upgrade. The VirtualMachine must have been created from the template and must reside in the namespace of the template. Required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status defines the observed state of the template",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateStatus"),
+							Description: "Revision selects the revision of the template to render. If unset, the current state of the template is rendered. Optional.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"resourceVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceVersion is the expected resourceVersion of the VirtualMachine. If set, an upgrade fails with a conflict if the VirtualMachine was modified in the meantime. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"virtualMachineName"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateSpec", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineOverrides(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineTemplateList contains a list of VirtualMachineTemplate",
+				Description: "VirtualMachineOverrides are changes made to a processed VirtualMachine on request. The labels and annotations recording the provenance of the VirtualMachine cannot be changed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are added to the labels of the VirtualMachine. Optional.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations are added to the annotations of the VirtualMachine. Optional.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"runStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunStrategy overrides the runStrategy of the VirtualMachine. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"patch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patch is applied to the VirtualMachine after the labels, annotations and runStrategy. Optional.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachinePatch"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachinePatch"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachinePatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachinePatch is a patch of a processed VirtualMachine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the patch. Required.\n\nPossible enum values:\n - `\"JSON\"` is a JSON patch as defined by RFC 6902.\n - `\"StrategicMerge\"` is a strategic merge patch as used by kubectl.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"JSON", "StrategicMerge"},
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data is the patch document in JSON. Required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "data"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineSpecChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSpecChange is a single JSON patch operation on the spec of a VirtualMachine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"op": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the JSON patch operation, one of add, remove or replace. Required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the JSON pointer to the changed field, relative to the spec of the VirtualMachine. Required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the new value of the changed field. Optional.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"op", "path"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplate is a dummy object to satisfy the k8s.io/apiserver conventions. A subresource cannot be served without a storage for its parent resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -33236,35 +34282,1941 @@ func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateLis
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineTemplateInstances(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplateInstances is the object served by the /instances subresource. It lists the VirtualMachines that were created from the parent VirtualMachineTemplate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"templateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateRef contains a reference to the template the instances were created from. Optional.",
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+						},
+					},
+					"templateGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateGeneration is the current generation of the template. Optional.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"instances": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Instances is the list of VirtualMachines created from the template. Optional.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplate"),
+										Ref: ref("kubevirt.io/virt-template-api/core/subresourcesv1beta1.TemplateInstance"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplate"},
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/subresourcesv1beta1.TemplateInstance"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineTemplatePreview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplatePreview processes an inline VirtualMachineTemplate without persisting anything. It can only be created and reports the result of processing in its status.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the template to preview and the options used to process it. Required.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineTemplatePreviewSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the result of processing the template. It is set by the server. Optional.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineTemplatePreviewStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineTemplatePreviewSpec", "kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineTemplatePreviewStatus"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineTemplatePreviewSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplatePreviewSpec contains the template to preview and the options used to process it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the VirtualMachineTemplate to process. It does not have to exist in the cluster. Required.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplate"),
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Options are the options used when processing the template. Revisions cannot be selected and the template is always processed into the namespace of the request. Optional.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessOptions"),
+						},
+					},
+				},
+				Required: []string{"template"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessOptions", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplate"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineTemplatePreviewStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplatePreviewStatus contains the result of processing a previewed template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"processed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Processed is the result of processing the template. It is unset if the template could not be processed. Optional.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessedVirtualMachineTemplate"),
+						},
+					},
+					"warnings": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Warnings lists the warnings found while validating the template. Optional.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"errors": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Errors lists all errors found while validating and processing the template. Optional.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause", "kubevirt.io/virt-template-api/core/subresourcesv1beta1.ProcessedVirtualMachineTemplate"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_subresourcesv1beta1_VirtualMachineUpgrade(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineUpgrade is the object served by the /diff and /upgrade subresources. It represents the changes between a VirtualMachine and the parent VirtualMachineTemplate rendered with the parameter values recorded on the VirtualMachine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"templateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateRef contains a reference to the template that was rendered. Optional.",
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision of the template that was rendered. Optional.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"upToDate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpToDate indicates that the spec of the VirtualMachine matches the rendered template. Optional.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"changes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Changes lists the differences between the spec of the VirtualMachine and the spec rendered from the template as JSON patch operations, sorted by path. Optional.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineSpecChange"),
+									},
+								},
+							},
+						},
+					},
+					"virtualMachine": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachine is the VirtualMachine rendered from the template when diffing, or the updated VirtualMachine when upgrading. Required.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachine"),
+						},
+					},
+				},
+				Required: []string{"virtualMachine"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachine", "kubevirt.io/virt-template-api/core/subresourcesv1beta1.VirtualMachineSpecChange"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_Generalization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Generalization configures the Job generalizing the cloned disks of a VirtualMachine. The Job runs in the namespace of the request with the default service account of the namespace. Disks on filesystem volumes are mounted at /disks/<volume name>, with the disk image at /disks/<volume name>/disk.img. Disks on block volumes are attached at /dev/disks/<volume name>. The environment variable DISKS holds the space separated paths of the disk images and devices.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the container image generalizing the disks, e.g. an image providing virt-sysprep.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Command overrides the entrypoint of the image.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are the arguments passed to the entrypoint of the image.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveDeadlineSeconds limits the duration of the Job. The request fails if the Job does not complete in time.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"image"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_InstancetypeCapture(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstancetypeCapture controls how the instancetype and preference of a VirtualMachine are captured.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy is Expand to expand the instancetype and preference into the template, or Keep to keep the references to them. The revisions of the source VirtualMachine are never kept.\n\nPossible enum values:\n - `\"Expand\"` expands the instancetype and preference into the template.\n - `\"Keep\"` keeps the references to the instancetype and preference in the template.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Expand", "Keep"},
+						},
+					},
+					"copy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Copy copies a referenced namespaced VirtualMachineInstancetype or VirtualMachinePreference into the namespace of the template if it is not in the same namespace as the VirtualMachine. Existing objects with the same name are not overwritten.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"parameterize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameterize replaces the names of the referenced instancetype and preference with the parameters INSTANCETYPE and PREFERENCE, whose values default to the captured names.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"policy"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_Parameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Parameter defines a name/value combination that is to be substituted during processing of the template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the parameter. It can be referenced in the template VirtualMachine using ${PARAMETER_NAME}. Required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisplayName is an alternative name that can be shown in a UI instead of the parameter's name. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is the description of the parameter. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value holds the value of the Parameter. If specified, a generator will be ignored. The value replaces all occurrences of the ${PARAMETER_NAME} expression during processing of the template. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"generate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generate specifies the generator to be used to generate a Value for this parameter. The From field can be used to provide input to this generator If empty, no generator is being used, leaving the result Value untouched. Optional.\n\nThe only supported generator is \"expression\", which accepts a From value with a regex-like syntax, which should follow the form of \"[a-zA-Z0-9]{length}\". The expression defines the range and length of the resulting random characters.\n\nThe following character classes are supported in the range:\n\nrange | characters ------------------------------------------------------------- \"\\w\"  | abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ_ \"\\d\"  | 0123456789 \"\\a\"  | abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ \"\\A\"  | !\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~\n\nGenerated examples:\n\nexpression       | generated value ---------------------------------- \"test[0-9]{1}x\"  | \"test7x\" \"[0-1]{8}\"       | \"01001100\" \"0x[A-F0-9]{4}\"  | \"0xB3AF\" \"[a-zA-Z0-9]{8}\" | \"hW4yQU5i\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From is used as input for the generator specified in Generate. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"required": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that the parameter must have a Value or valid Generate and From values. Defaults to false. Optional.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_Sanitization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Sanitization controls which settings specific to the source VirtualMachine are removed from the template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile is the built-in set of rules to apply. If unset, the default profile of the cluster is used.\n\nPossible enum values:\n - `\"Aggressive\"` additionally removes the access credentials and the cloud-init volumes.\n - `\"Default\"` additionally removes the hostname, the labels and annotations of the VirtualMachineInstance template and the node affinity.\n - `\"Minimal\"` removes the MAC addresses and the firmware identifiers.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Aggressive", "Default", "Minimal"},
+						},
+					},
+					"strip": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Strip lists rules to apply in addition to the rules of the profile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+										Enum:   []interface{}{"AccessCredentials", "Annotations", "CloudInit", "FirmwareIdentifiers", "Hostname", "Labels", "MACAddresses", "NodeAffinity"},
+									},
+								},
+							},
+						},
+					},
+					"keep": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Keep lists rules of the profile not to apply. Keep takes precedence over Strip.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+										Enum:   []interface{}{"AccessCredentials", "Annotations", "CloudInit", "FirmwareIdentifiers", "Hostname", "Labels", "MACAddresses", "NodeAffinity"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_StorageOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageOverride overrides the storage of a cloned volume. Unset fields are determined by CDI.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClassName is the name of the StorageClass of the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessModes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessModes are the access modes of the volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+										Enum:   []interface{}{"ReadOnlyMany", "ReadWriteMany", "ReadWriteOnce", "ReadWriteOncePod"},
+									},
+								},
+							},
+						},
+					},
+					"volumeMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMode is the volume mode of the volume.\n\nPossible enum values:\n - `\"Block\"` means the volume will not be formatted with a filesystem and will remain a raw block device.\n - `\"Filesystem\"` means the volume will be or is formatted with a filesystem.\n - `\"FromStorageProfile\"` means the volume mode will be auto selected by CDI according to a matching StorageProfile",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Block", "Filesystem", "FromStorageProfile"},
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the requested size of the volume. It must not be smaller than the size of the source volume.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_StorageOverrides(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageOverrides overrides the storage of the cloned volumes of a VirtualMachine. The overrides apply to all volumes, the overrides of a single volume take precedence over them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClassName is the name of the StorageClass of the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessModes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessModes are the access modes of the volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+										Enum:   []interface{}{"ReadOnlyMany", "ReadWriteMany", "ReadWriteOnce", "ReadWriteOncePod"},
+									},
+								},
+							},
+						},
+					},
+					"volumeMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMode is the volume mode of the volume.\n\nPossible enum values:\n - `\"Block\"` means the volume will not be formatted with a filesystem and will remain a raw block device.\n - `\"Filesystem\"` means the volume will be or is formatted with a filesystem.\n - `\"FromStorageProfile\"` means the volume mode will be auto selected by CDI according to a matching StorageProfile",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Block", "Filesystem", "FromStorageProfile"},
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the requested size of the volume. It must not be smaller than the size of the source volume.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes overrides the storage of single volumes of the VirtualMachine.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/virt-template-api/core/v1alpha1.VolumeStorageOverride"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/virt-template-api/core/v1alpha1.VolumeStorageOverride"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_TemplateParameterization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateParameterization selects settings of a VirtualMachine that are replaced with parameters. Settings that are not set on the VirtualMachine are not parameterized.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU replaces the number of CPU sockets, cores and threads with the parameters CPU_SOCKETS, CPU_CORES and CPU_THREADS.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory replaces the guest memory, or the requested memory if the guest memory is not set, with the parameter MEMORY.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunStrategy replaces the run strategy with the parameter RUN_STRATEGY.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"cloudInitUserData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudInitUserData replaces the inline user data of cloud-init volumes with the parameter CLOUD_INIT_USER_DATA. Further volumes use the suffixes _2, _3 and so on.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"sshKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHKeys replaces the names of the secrets holding the SSH public keys of the access credentials with the parameter SSH_KEYS_SECRET. Further secrets use the suffixes _2, _3 and so on.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"networkNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkNames replaces the names of the Multus networks with the parameters NETWORK_<NAME>, where <NAME> is the name of the network in the VirtualMachine in upper case.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineReference holds a reference to a VirtualMachine.kubevirt.io",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the VirtualMachine.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the VirtualMachine.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplate is the Schema for the virtualmachinetemplates API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired state of the template",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status defines the observed state of the template",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateSpec", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateStatus"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplateList contains a list of VirtualMachineTemplate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplate"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplate"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplateReference holds a reference to a VirtualMachineTemplate.template.kubevirt.io",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the VirtualMachineTemplate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the VirtualMachineTemplate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplateRequest is the Schema for the virtualmachinetemplaterequests API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired state of the template requests",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status defines the observed state of the template request",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestSpec", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestStatus"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplateRequestList contains a list of VirtualMachineTemplateRequest",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequest"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequest"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplateRequestSpec defines the desired state of VirtualMachineTemplateRequest",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualMachineRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineReference holds a reference to a VirtualMachine.kubevirt.io. Exactly one of virtualMachineRef and virtualMachineTemplateRef must be specified.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineReference"),
+						},
+					},
+					"virtualMachineTemplateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineTemplateRef holds a reference to a VirtualMachineTemplate to copy into the namespace of the request, including its disks. The PVCs and VolumeSnapshots referenced by the DataVolumeTemplates of the template are cloned and the references are rewritten to the clones. Exactly one of virtualMachineRef and virtualMachineTemplateRef must be specified.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateReference"),
+						},
+					},
+					"virtualMachineSnapshotName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineSnapshotName holds the optional name of an existing VirtualMachineSnapshot of the VirtualMachine in its namespace. If specified, the template is created from this snapshot instead of a new snapshot of the VirtualMachine, and the snapshot is kept after the template was created.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"templateName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateName holds the optional name for the new VirtualMachineTemplate. If not specified the template will have the same name as the VirtualMachineTemplateRequest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"templateLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateLabels holds optional labels to apply to the created VirtualMachineTemplate. Labels with the \"template.kubevirt.io/\" prefix are reserved for system use and are not allowed.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"ttlSecondsAfterFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLSecondsAfterFinished limits the lifetime of a VirtualMachineTemplateRequest that has finished execution. Failed VirtualMachineTemplateRequests are never cleaned up by the TTL controller, so they remain available for debugging. If this field is unset, the VirtualMachineTemplateRequest is not automatically deleted and must be removed manually or through the owner reference cleanup described below. If this field is set to zero, the VirtualMachineTemplateRequest becomes eligible to be deleted immediately after it finishes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"instancetypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Instancetypes controls how the instancetype and preference referenced by the VirtualMachine are captured in the template. If unset, they are expanded into the template and the references are removed.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.InstancetypeCapture"),
+						},
+					},
+					"captureBackendStorage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CaptureBackendStorage clones the persistent backend storage of the VirtualMachine, which holds its persistent EFI variables and vTPM state, into the template. VirtualMachines created from the template adopt a clone of it instead of starting with empty state. This shares the vTPM state of the source VirtualMachine with all of them: secrets sealed by its vTPM, like BitLocker keys or credentials, can be unsealed by every VirtualMachine created from the template. Only capture the backend storage of generalized VirtualMachines whose vTPM holds no secrets. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"parameterize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameterize selects settings of the VirtualMachine whose captured values are replaced with parameters in the template. The parameters default to the captured values. The names of the instancetype and preference are parameterized with instancetypes.parameterize.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.TemplateParameterization"),
+						},
+					},
+					"sanitization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sanitization controls which settings specific to the source VirtualMachine are removed from the template. If unset, the rules of the default profile of the cluster are applied.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.Sanitization"),
+						},
+					},
+					"generalization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generalization runs a Job against the cloned disks before the template is created, e.g. to remove SSH host keys, the machine ID, cloud-init state or Windows SIDs with virt-sysprep.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.Generalization"),
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage overrides the storage of the DataVolumes cloned from the volumes of the VirtualMachine and of the DataVolumeTemplates in the template. If unset, the storage is determined by CDI.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.StorageOverrides"),
+						},
+					},
+					"dataVolumeTemplateSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumeTemplateSource selects how the DataVolumeTemplates of the template refer to the cloned volumes. PVC refers to the cloned PVCs directly. DataSource creates a CDI DataSource per cloned volume and refers to it, so that the volumes can be replaced without changing the template. Defaults to PVC.\n\nPossible enum values:\n - `\"DataSource\"` refers to CDI DataSources pointing at the cloned PVCs.\n - `\"PVC\"` refers to the cloned PVCs.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"DataSource", "PVC"},
+						},
+					},
+					"diskStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiskStrategy selects how the volumes of the VirtualMachine are captured. Clone clones the VolumeSnapshots of the volumes into DataVolumes owned by the template. Snapshot keeps the VolumeSnapshots instead and lets the DataVolumeTemplates of the template clone them, which is cheaper and faster with CSI drivers that clone snapshots efficiently. Snapshot requires the VirtualMachine to be in the namespace of the request. Defaults to Clone.\n\nPossible enum values:\n - `\"Clone\"` clones the VolumeSnapshots of the volumes into DataVolumes.\n - `\"Snapshot\"` keeps the VolumeSnapshots of the volumes.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Clone", "Snapshot"},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/virt-template-api/core/v1alpha1.Generalization", "kubevirt.io/virt-template-api/core/v1alpha1.InstancetypeCapture", "kubevirt.io/virt-template-api/core/v1alpha1.Sanitization", "kubevirt.io/virt-template-api/core/v1alpha1.StorageOverrides", "kubevirt.io/virt-template-api/core/v1alpha1.TemplateParameterization", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineReference", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateReference"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplateRequestStatus defines the observed state of VirtualMachineTemplateRequest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represent the current state of the template request. Each condition has a unique type and reflects the status of a specific aspect of the resource.\n\nCondition types include: - \"Ready\": the template was created successfully\n\nThe status of each condition is one of True, False, or Unknown.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"templateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateRef is a reference to the created VirtualMachineTemplate.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"sanitizedFields": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SanitizedFields lists the fields of the source VirtualMachine that were removed from the template.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplateSpec defines the desired state of VirtualMachineTemplate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualMachine": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachine is the template VirtualMachine to include in this template. If a namespace value is hardcoded, it will be removed during processing of the template. If the namespace value however contains a ${PARAMETER_REFERENCE}, the resolved value after parameter substitution will be respected and the VirtualMachine will be created in that namespace.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters is an optional list of Parameters used during processing of the template.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/virt-template-api/core/v1alpha1.Parameter"),
+									},
+								},
+							},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is an optional instructional message for this template. This field should inform the user how to utilize the newly created VirtualMachine.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revisionHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevisionHistoryLimit is the number of ControllerRevisions to retain for this template. Each change to the template is recorded in a new revision, which allows to process a previous revision or to roll back to it. The current revision is always retained. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"patchablePaths": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PatchablePaths restricts the overrides a request processing the template can apply to the processed VirtualMachine. Each path is a JSON pointer like /spec/runStrategy that allows changes at or below it. If unset, all overrides are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"virtualMachine"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension", "kubevirt.io/virt-template-api/core/v1alpha1.Parameter"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplateStatus defines the observed state of VirtualMachineTemplate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represent the current state of the template. Each condition has a unique type and reflects the status of a specific aspect of the resource.\n\nCondition types include: - \"Ready\": the template is ready to be processed\n\nThe status of each condition is one of True, False, or Unknown.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"instances": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Instances is the number of VirtualMachines that were created from this template.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"latestInstance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LatestInstance is a reference to the most recently created VirtualMachine that was created from this template.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineReference"),
+						},
+					},
+					"outdatedInstances": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "OutdatedInstances holds references to VirtualMachines that were created from an older generation of this template. At most 100 references are listed, the instances subresource can be used to retrieve all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineReference"),
+									},
+								},
+							},
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the number of the ControllerRevision that holds the current state of the template.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineReference"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1alpha1_VolumeStorageOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeStorageOverride overrides the storage of a single volume of a VirtualMachine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the volume in the VirtualMachine.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClassName is the name of the StorageClass of the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessModes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessModes are the access modes of the volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+										Enum:   []interface{}{"ReadOnlyMany", "ReadWriteMany", "ReadWriteOnce", "ReadWriteOncePod"},
+									},
+								},
+							},
+						},
+					},
+					"volumeMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMode is the volume mode of the volume.\n\nPossible enum values:\n - `\"Block\"` means the volume will not be formatted with a filesystem and will remain a raw block device.\n - `\"Filesystem\"` means the volume will be or is formatted with a filesystem.\n - `\"FromStorageProfile\"` means the volume mode will be auto selected by CDI according to a matching StorageProfile",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Block", "Filesystem", "FromStorageProfile"},
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the requested size of the volume. It must not be smaller than the size of the source volume.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_ClusterVirtualMachineTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterVirtualMachineTemplate is the Schema for the clustervirtualmachinetemplates API. It has the same spec as a VirtualMachineTemplate, but is cluster-scoped and can be processed into any namespace. Revisions are not recorded for cluster-scoped templates.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired state of the template",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status defines the observed state of the template",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateSpec", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateStatus"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_ClusterVirtualMachineTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterVirtualMachineTemplateList contains a list of ClusterVirtualMachineTemplate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/virt-template-api/core/v1beta1.ClusterVirtualMachineTemplate"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/virt-template-api/core/v1beta1.ClusterVirtualMachineTemplate"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_Generalization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Generalization configures the Job generalizing the cloned disks of a VirtualMachine. The Job runs in the namespace of the request with the default service account of the namespace. Disks on filesystem volumes are mounted at /disks/<volume name>, with the disk image at /disks/<volume name>/disk.img. Disks on block volumes are attached at /dev/disks/<volume name>. The environment variable DISKS holds the space separated paths of the disk images and devices.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the container image generalizing the disks, e.g. an image providing virt-sysprep.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Command overrides the entrypoint of the image.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are the arguments passed to the entrypoint of the image.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActiveDeadlineSeconds limits the duration of the Job. The request fails if the Job does not complete in time.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"image"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_InstancetypeCapture(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstancetypeCapture controls how the instancetype and preference of a VirtualMachine are captured.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy is Expand to expand the instancetype and preference into the template, or Keep to keep the references to them. The revisions of the source VirtualMachine are never kept.\n\nPossible enum values:\n - `\"Expand\"` expands the instancetype and preference into the template.\n - `\"Keep\"` keeps the references to the instancetype and preference in the template.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Expand", "Keep"},
+						},
+					},
+					"copy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Copy copies a referenced namespaced VirtualMachineInstancetype or VirtualMachinePreference into the namespace of the template if it is not in the same namespace as the VirtualMachine. Existing objects with the same name are not overwritten.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"parameterize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameterize replaces the names of the referenced instancetype and preference with the parameters INSTANCETYPE and PREFERENCE, whose values default to the captured names.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"policy"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_Parameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Parameter defines a name/value combination that is to be substituted during processing of the template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the parameter. It can be referenced in the template VirtualMachine using ${PARAMETER_NAME}. Required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisplayName is an alternative name that can be shown in a UI instead of the parameter's name. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is the description of the parameter. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value holds the value of the Parameter. If specified, a generator will be ignored. The value replaces all occurrences of the ${PARAMETER_NAME} expression during processing of the template. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"generate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generate specifies the generator to be used to generate a Value for this parameter. The From field can be used to provide input to this generator If empty, no generator is being used, leaving the result Value untouched. Optional.\n\nThe only supported generator is \"expression\", which accepts a From value with a regex-like syntax, which should follow the form of \"[a-zA-Z0-9]{length}\". The expression defines the range and length of the resulting random characters.\n\nThe following character classes are supported in the range:\n\nrange | characters ------------------------------------------------------------- \"\\w\"  | abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ_ \"\\d\"  | 0123456789 \"\\a\"  | abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ \"\\A\"  | !\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~\n\nGenerated examples:\n\nexpression       | generated value ---------------------------------- \"test[0-9]{1}x\"  | \"test7x\" \"[0-1]{8}\"       | \"01001100\" \"0x[A-F0-9]{4}\"  | \"0xB3AF\" \"[a-zA-Z0-9]{8}\" | \"hW4yQU5i\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From is used as input for the generator specified in Generate. Optional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"required": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that the parameter must have a Value or valid Generate and From values. Defaults to false. Optional.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_Sanitization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Sanitization controls which settings specific to the source VirtualMachine are removed from the template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile is the built-in set of rules to apply. If unset, the default profile of the cluster is used.\n\nPossible enum values:\n - `\"Aggressive\"` additionally removes the access credentials and the cloud-init volumes.\n - `\"Default\"` additionally removes the hostname, the labels and annotations of the VirtualMachineInstance template and the node affinity.\n - `\"Minimal\"` removes the MAC addresses and the firmware identifiers.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Aggressive", "Default", "Minimal"},
+						},
+					},
+					"strip": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Strip lists rules to apply in addition to the rules of the profile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+										Enum:   []interface{}{"AccessCredentials", "Annotations", "CloudInit", "FirmwareIdentifiers", "Hostname", "Labels", "MACAddresses", "NodeAffinity"},
+									},
+								},
+							},
+						},
+					},
+					"keep": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Keep lists rules of the profile not to apply. Keep takes precedence over Strip.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+										Enum:   []interface{}{"AccessCredentials", "Annotations", "CloudInit", "FirmwareIdentifiers", "Hostname", "Labels", "MACAddresses", "NodeAffinity"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_StorageOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageOverride overrides the storage of a cloned volume. Unset fields are determined by CDI.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClassName is the name of the StorageClass of the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessModes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessModes are the access modes of the volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+										Enum:   []interface{}{"ReadOnlyMany", "ReadWriteMany", "ReadWriteOnce", "ReadWriteOncePod"},
+									},
+								},
+							},
+						},
+					},
+					"volumeMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMode is the volume mode of the volume.\n\nPossible enum values:\n - `\"Block\"` means the volume will not be formatted with a filesystem and will remain a raw block device.\n - `\"Filesystem\"` means the volume will be or is formatted with a filesystem.\n - `\"FromStorageProfile\"` means the volume mode will be auto selected by CDI according to a matching StorageProfile",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Block", "Filesystem", "FromStorageProfile"},
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the requested size of the volume. It must not be smaller than the size of the source volume.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_StorageOverrides(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageOverrides overrides the storage of the cloned volumes of a VirtualMachine. The overrides apply to all volumes, the overrides of a single volume take precedence over them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClassName is the name of the StorageClass of the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessModes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessModes are the access modes of the volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+										Enum:   []interface{}{"ReadOnlyMany", "ReadWriteMany", "ReadWriteOnce", "ReadWriteOncePod"},
+									},
+								},
+							},
+						},
+					},
+					"volumeMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMode is the volume mode of the volume.\n\nPossible enum values:\n - `\"Block\"` means the volume will not be formatted with a filesystem and will remain a raw block device.\n - `\"Filesystem\"` means the volume will be or is formatted with a filesystem.\n - `\"FromStorageProfile\"` means the volume mode will be auto selected by CDI according to a matching StorageProfile",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Block", "Filesystem", "FromStorageProfile"},
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the requested size of the volume. It must not be smaller than the size of the source volume.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes overrides the storage of single volumes of the VirtualMachine.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/virt-template-api/core/v1beta1.VolumeStorageOverride"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/virt-template-api/core/v1beta1.VolumeStorageOverride"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_TemplateParameterization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateParameterization selects settings of a VirtualMachine that are replaced with parameters. Settings that are not set on the VirtualMachine are not parameterized.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU replaces the number of CPU sockets, cores and threads with the parameters CPU_SOCKETS, CPU_CORES and CPU_THREADS.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory replaces the guest memory, or the requested memory if the guest memory is not set, with the parameter MEMORY.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunStrategy replaces the run strategy with the parameter RUN_STRATEGY.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"cloudInitUserData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudInitUserData replaces the inline user data of cloud-init volumes with the parameter CLOUD_INIT_USER_DATA. Further volumes use the suffixes _2, _3 and so on.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"sshKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHKeys replaces the names of the secrets holding the SSH public keys of the access credentials with the parameter SSH_KEYS_SECRET. Further secrets use the suffixes _2, _3 and so on.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"networkNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkNames replaces the names of the Multus networks with the parameters NETWORK_<NAME>, where <NAME> is the name of the network in the VirtualMachine in upper case.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineReference holds a reference to a VirtualMachine.kubevirt.io",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the VirtualMachine.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the VirtualMachine.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplate is the Schema for the virtualmachinetemplates API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired state of the template",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status defines the observed state of the template",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateSpec", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateStatus"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateGrant(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineTemplateRequest is the Schema for the virtualmachinetemplaterequests API",
+				Description: "VirtualMachineTemplateGrant is the Schema for the virtualmachinetemplategrants API. Like a Gateway API ReferenceGrant it lives in the namespace of the templates and allows processing them into other namespaces.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -33289,16 +36241,9 @@ func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateReq
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired state of the template requests",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status defines the observed state of the template request",
+							Description: "Spec defines the granted namespaces, subjects and templates",
 							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestStatus"),
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantSpec"),
 						},
 					},
 				},
@@ -33306,369 +36251,428 @@ func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateReq
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestSpec", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequestStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantSpec"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateGrantFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineTemplateRequestList contains a list of VirtualMachineTemplateRequest",
+				Description: "VirtualMachineTemplateGrantFrom describes a target namespace of a VirtualMachineTemplateGrant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Namespace is the namespace templates may be processed into.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+					"subjects": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
-					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Subjects lists the subjects that may process templates into the namespace.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequest"),
+										Ref: ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantSubject"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"namespace", "subjects"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineTemplateRequest"},
+			"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantSubject"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateGrantList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineTemplateRequestSpec defines the desired state of VirtualMachineTemplateRequest",
+				Description: "VirtualMachineTemplateGrantList contains a list of VirtualMachineTemplateGrant",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"virtualMachineRef": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VirtualMachineReference holds a reference to a VirtualMachine.kubevirt.io",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineReference"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"templateName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TemplateName holds the optional name for the new VirtualMachineTemplate. If not specified the template will have the same name as the VirtualMachineTemplateRequest.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"templateLabels": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TemplateLabels holds optional labels to apply to the created VirtualMachineTemplate. Labels with the \"template.kubevirt.io/\" prefix are reserved for system use and are not allowed.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrant"),
 									},
 								},
 							},
 						},
 					},
-					"ttlSecondsAfterFinished": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TTLSecondsAfterFinished limits the lifetime of a VirtualMachineTemplateRequest that has finished execution. Failed VirtualMachineTemplateRequests are never cleaned up by the TTL controller, so they remain available for debugging. If this field is unset, the VirtualMachineTemplateRequest is not automatically deleted and must be removed manually or through the owner reference cleanup described below. If this field is set to zero, the VirtualMachineTemplateRequest becomes eligible to be deleted immediately after it finishes.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
 				},
-				Required: []string{"virtualMachineRef"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/virt-template-api/core/v1alpha1.VirtualMachineReference"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrant"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateGrantSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineTemplateRequestStatus defines the observed state of VirtualMachineTemplateRequest.",
+				Description: "VirtualMachineTemplateGrantSpec defines which namespaces and subjects may process the VirtualMachineTemplates in the namespace of the grant into their namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
+					"from": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"type",
-								},
-								"x-kubernetes-list-type": "map",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represent the current state of the template request. Each condition has a unique type and reflects the status of a specific aspect of the resource.\n\nCondition types include: - \"Ready\": the template was created successfully\n\nThe status of each condition is one of True, False, or Unknown.",
+							Description: "From lists the namespaces and subjects that are granted to process templates into the listed namespaces.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+										Ref: ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantFrom"),
 									},
 								},
 							},
 						},
 					},
-					"templateRef": {
+					"to": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "TemplateRef is a reference to the created VirtualMachineTemplate.",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "To lists the templates in the namespace of the grant that may be processed. If empty, all templates in the namespace of the grant may be processed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantTo"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"from"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+			"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantFrom", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateGrantTo"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateGrantSubject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineTemplateSpec defines the desired state of VirtualMachineTemplate",
+				Description: "VirtualMachineTemplateGrantSubject references a user, group or service account.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"virtualMachine": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VirtualMachine is the template VirtualMachine to include in this template. If a namespace value is hardcoded, it will be removed during processing of the template. If the namespace value however contains a ${PARAMETER_REFERENCE}, the resolved value after parameter substitution will be respected and the VirtualMachine will be created in that namespace.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "Kind is the kind of the subject.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"parameters": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parameters is an optional list of Parameters used during processing of the template.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevirt.io/virt-template-api/core/v1alpha1.Parameter"),
-									},
-								},
-							},
+							Description: "Name is the name of the subject.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"message": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Message is an optional instructional message for this template. This field should inform the user how to utilize the newly created VirtualMachine.",
+							Description: "Namespace is the namespace of a ServiceAccount subject.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"virtualMachine"},
+				Required: []string{"kind", "name"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/runtime.RawExtension", "kubevirt.io/virt-template-api/core/v1alpha1.Parameter"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1alpha1_VirtualMachineTemplateStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateGrantTo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineTemplateStatus defines the observed state of VirtualMachineTemplate.",
+				Description: "VirtualMachineTemplateGrantTo references a template in the namespace of a VirtualMachineTemplateGrant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"type",
-								},
-								"x-kubernetes-list-type": "map",
-							},
-						},
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represent the current state of the template. Each condition has a unique type and reflects the status of a specific aspect of the resource.\n\nCondition types include: - \"Ready\": the template is ready to be processed\n\nThe status of each condition is one of True, False, or Unknown.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
-									},
-								},
-							},
+							Description: "Name is the name of the template.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1beta1_Parameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Parameter defines a name/value combination that is to be substituted during processing of the template.",
+				Description: "VirtualMachineTemplateInstance is the Schema for the virtualmachinetemplateinstances API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the parameter. It can be referenced in the template VirtualMachine using ${PARAMETER_NAME}. Required.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"displayName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DisplayName is an alternative name that can be shown in a UI instead of the parameter's name. Optional.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"description": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is the description of the parameter. Optional.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"value": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value holds the value of the Parameter. If specified, a generator will be ignored. The value replaces all occurrences of the ${PARAMETER_NAME} expression during processing of the template. Optional.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Spec defines the desired state of the template instance",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateInstanceSpec"),
 						},
 					},
-					"generate": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Generate specifies the generator to be used to generate a Value for this parameter. The From field can be used to provide input to this generator If empty, no generator is being used, leaving the result Value untouched. Optional.\n\nThe only supported generator is \"expression\", which accepts a From value with a regex-like syntax, which should follow the form of \"[a-zA-Z0-9]{length}\". The expression defines the range and length of the resulting random characters.\n\nThe following character classes are supported in the range:\n\nrange | characters ------------------------------------------------------------- \"\\w\"  | abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ_ \"\\d\"  | 0123456789 \"\\a\"  | abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ \"\\A\"  | !\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~\n\nGenerated examples:\n\nexpression       | generated value ---------------------------------- \"test[0-9]{1}x\"  | \"test7x\" \"[0-1]{8}\"       | \"01001100\" \"0x[A-F0-9]{4}\"  | \"0xB3AF\" \"[a-zA-Z0-9]{8}\" | \"hW4yQU5i\"",
+							Description: "Status defines the observed state of the template instance",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateInstanceStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateInstanceSpec", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateInstanceStatus"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateInstanceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplateInstanceList contains a list of VirtualMachineTemplateInstance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"from": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "From is used as input for the generator specified in Generate. Optional.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"required": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Indicates that the parameter must have a Value or valid Generate and From values. Defaults to false. Optional.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateInstance"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateInstance"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateInstanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineReference holds a reference to a VirtualMachine.kubevirt.io",
+				Description: "VirtualMachineTemplateInstanceSpec defines the desired state of VirtualMachineTemplateInstance",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"namespace": {
+					"templateRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of the VirtualMachine.",
+							Description: "TemplateRef references the VirtualMachineTemplate the VirtualMachine is rendered from. The template must exist in the namespace of the instance.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"parameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameters holds the values of the template parameters the VirtualMachine is rendered with. Values of parameters not listed here are taken from the template. Generated values are generated once and kept for the lifetime of the VirtualMachine.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision pins the instance to a revision of the template. If not specified, the instance follows the current revision of the template.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"updatePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatePolicy defines whether changes to the template or parameters are applied to an existing VirtualMachine. The run strategy of the VirtualMachine is never updated. Defaults to Automatic.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"deletionPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the VirtualMachine.",
+							Description: "DeletionPolicy defines whether the VirtualMachine is deleted together with the instance. Defaults to Delete.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"namespace", "name"},
+				Required: []string{"templateRef"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateInstanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineTemplate is the Schema for the virtualmachinetemplates API",
+				Description: "VirtualMachineTemplateInstanceStatus defines the observed state of VirtualMachineTemplateInstance.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Conditions represent the current state of the template instance. Each condition has a unique type and reflects the status of a specific aspect of the resource.\n\nCondition types include: - \"Ready\": the VirtualMachine was rendered and exists - \"UpToDate\": the VirtualMachine matches the rendered template\n\nThe status of each condition is one of True, False, or Unknown.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"virtualMachineRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "VirtualMachineRef is a reference to the VirtualMachine managed by the instance.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineReference"),
 						},
 					},
-					"metadata": {
+					"templateGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "TemplateGeneration is the generation of the template the VirtualMachine was last rendered from.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"spec": {
+					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired state of the template",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateSpec"),
+							Description: "Revision is the revision of the template the VirtualMachine was last rendered from.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"status": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status defines the observed state of the template",
-							Default:     map[string]interface{}{},
-							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateStatus"),
+							Description: "Message is the processed message of the template.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateSpec", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineReference"},
 	}
 }
 
@@ -33720,6 +36724,34 @@ func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateList
 	}
 }
 
+func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineTemplateReference holds a reference to a VirtualMachineTemplate.template.kubevirt.io",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the VirtualMachineTemplate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the VirtualMachineTemplate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -33827,11 +36859,24 @@ func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateRequ
 				Properties: map[string]spec.Schema{
 					"virtualMachineRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VirtualMachineReference holds a reference to a VirtualMachine.kubevirt.io",
+							Description: "VirtualMachineReference holds a reference to a VirtualMachine.kubevirt.io. Exactly one of virtualMachineRef and virtualMachineTemplateRef must be specified.",
 							Default:     map[string]interface{}{},
 							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineReference"),
 						},
 					},
+					"virtualMachineTemplateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineTemplateRef holds a reference to a VirtualMachineTemplate to copy into the namespace of the request, including its disks. The PVCs and VolumeSnapshots referenced by the DataVolumeTemplates of the template are cloned and the references are rewritten to the clones. Exactly one of virtualMachineRef and virtualMachineTemplateRef must be specified.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateReference"),
+						},
+					},
+					"virtualMachineSnapshotName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineSnapshotName holds the optional name of an existing VirtualMachineSnapshot of the VirtualMachine in its namespace. If specified, the template is created from this snapshot instead of a new snapshot of the VirtualMachine, and the snapshot is kept after the template was created.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"templateName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TemplateName holds the optional name for the new VirtualMachineTemplate. If not specified the template will have the same name as the VirtualMachineTemplateRequest.",
@@ -33861,12 +36906,64 @@ func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateRequ
 							Format:      "int32",
 						},
 					},
+					"instancetypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Instancetypes controls how the instancetype and preference referenced by the VirtualMachine are captured in the template. If unset, they are expanded into the template and the references are removed.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.InstancetypeCapture"),
+						},
+					},
+					"captureBackendStorage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CaptureBackendStorage clones the persistent backend storage of the VirtualMachine, which holds its persistent EFI variables and vTPM state, into the template. VirtualMachines created from the template adopt a clone of it instead of starting with empty state. This shares the vTPM state of the source VirtualMachine with all of them: secrets sealed by its vTPM, like BitLocker keys or credentials, can be unsealed by every VirtualMachine created from the template. Only capture the backend storage of generalized VirtualMachines whose vTPM holds no secrets. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"parameterize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parameterize selects settings of the VirtualMachine whose captured values are replaced with parameters in the template. The parameters default to the captured values. The names of the instancetype and preference are parameterized with instancetypes.parameterize.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.TemplateParameterization"),
+						},
+					},
+					"sanitization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sanitization controls which settings specific to the source VirtualMachine are removed from the template. If unset, the rules of the default profile of the cluster are applied.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.Sanitization"),
+						},
+					},
+					"generalization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generalization runs a Job against the cloned disks before the template is created, e.g. to remove SSH host keys, the machine ID, cloud-init state or Windows SIDs with virt-sysprep.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.Generalization"),
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage overrides the storage of the DataVolumes cloned from the volumes of the VirtualMachine and of the DataVolumeTemplates in the template. If unset, the storage is determined by CDI.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.StorageOverrides"),
+						},
+					},
+					"dataVolumeTemplateSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumeTemplateSource selects how the DataVolumeTemplates of the template refer to the cloned volumes. PVC refers to the cloned PVCs directly. DataSource creates a CDI DataSource per cloned volume and refers to it, so that the volumes can be replaced without changing the template. Defaults to PVC.\n\nPossible enum values:\n - `\"DataSource\"` refers to CDI DataSources pointing at the cloned PVCs.\n - `\"PVC\"` refers to the cloned PVCs.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"DataSource", "PVC"},
+						},
+					},
+					"diskStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiskStrategy selects how the volumes of the VirtualMachine are captured. Clone clones the VolumeSnapshots of the volumes into DataVolumes owned by the template. Snapshot keeps the VolumeSnapshots instead and lets the DataVolumeTemplates of the template clone them, which is cheaper and faster with CSI drivers that clone snapshots efficiently. Snapshot requires the VirtualMachine to be in the namespace of the request. Defaults to Clone.\n\nPossible enum values:\n - `\"Clone\"` clones the VolumeSnapshots of the volumes into DataVolumes.\n - `\"Snapshot\"` keeps the VolumeSnapshots of the volumes.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Clone", "Snapshot"},
+						},
+					},
 				},
-				Required: []string{"virtualMachineRef"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineReference"},
+			"kubevirt.io/virt-template-api/core/v1beta1.Generalization", "kubevirt.io/virt-template-api/core/v1beta1.InstancetypeCapture", "kubevirt.io/virt-template-api/core/v1beta1.Sanitization", "kubevirt.io/virt-template-api/core/v1beta1.StorageOverrides", "kubevirt.io/virt-template-api/core/v1beta1.TemplateParameterization", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineReference", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineTemplateReference"},
 	}
 }
 
@@ -33904,6 +37001,25 @@ func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateRequ
 							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
+					"sanitizedFields": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SanitizedFields lists the fields of the source VirtualMachine that were removed from the template.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -33945,6 +37061,32 @@ func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateSpec
 							Format:      "",
 						},
 					},
+					"revisionHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevisionHistoryLimit is the number of ControllerRevisions to retain for this template. Each change to the template is recorded in a new revision, which allows to process a previous revision or to roll back to it. The current revision is always retained. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"patchablePaths": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PatchablePaths restricts the overrides a request processing the template can apply to the processed VirtualMachine. Each path is a JSON pointer like /spec/runStrategy that allows changes at or below it. If unset, all overrides are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"virtualMachine"},
 			},
@@ -33982,10 +37124,113 @@ func schema_kubevirtio_virt_template_api_core_v1beta1_VirtualMachineTemplateStat
 							},
 						},
 					},
+					"instances": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Instances is the number of VirtualMachines that were created from this template.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"latestInstance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LatestInstance is a reference to the most recently created VirtualMachine in the namespace of this template that was created from this template.",
+							Ref:         ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineReference"),
+						},
+					},
+					"outdatedInstances": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "OutdatedInstances holds references to VirtualMachines in the namespace of this template that were created from an older generation of this template. At most 100 references are listed, the instances subresource can be used to retrieve all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineReference"),
+									},
+								},
+							},
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the number of the ControllerRevision that holds the current state of the template.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "kubevirt.io/virt-template-api/core/v1beta1.VirtualMachineReference"},
+	}
+}
+
+func schema_kubevirtio_virt_template_api_core_v1beta1_VolumeStorageOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeStorageOverride overrides the storage of a single volume of a VirtualMachine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the volume in the VirtualMachine.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"storageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageClassName is the name of the StorageClass of the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessModes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessModes are the access modes of the volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+										Enum:   []interface{}{"ReadOnlyMany", "ReadWriteMany", "ReadWriteOnce", "ReadWriteOncePod"},
+									},
+								},
+							},
+						},
+					},
+					"volumeMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMode is the volume mode of the volume.\n\nPossible enum values:\n - `\"Block\"` means the volume will not be formatted with a filesystem and will remain a raw block device.\n - `\"Filesystem\"` means the volume will be or is formatted with a filesystem.\n - `\"FromStorageProfile\"` means the volume mode will be auto selected by CDI according to a matching StorageProfile",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Block", "Filesystem", "FromStorageProfile"},
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the requested size of the volume. It must not be smaller than the size of the source volume.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
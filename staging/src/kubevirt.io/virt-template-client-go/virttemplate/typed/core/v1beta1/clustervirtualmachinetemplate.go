@@ -0,0 +1,75 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	context "context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	corev1beta1 "kubevirt.io/virt-template-api/core/v1beta1"
+	scheme "kubevirt.io/virt-template-client-go/virttemplate/scheme"
+)
+
+// ClusterVirtualMachineTemplatesGetter has a method to return a ClusterVirtualMachineTemplateInterface.
+// A group's client should implement this interface.
+type ClusterVirtualMachineTemplatesGetter interface {
+	ClusterVirtualMachineTemplates() ClusterVirtualMachineTemplateInterface
+}
+
+// ClusterVirtualMachineTemplateInterface has methods to work with ClusterVirtualMachineTemplate resources.
+type ClusterVirtualMachineTemplateInterface interface {
+	Create(ctx context.Context, clusterVirtualMachineTemplate *corev1beta1.ClusterVirtualMachineTemplate, opts v1.CreateOptions) (*corev1beta1.ClusterVirtualMachineTemplate, error)
+	Update(ctx context.Context, clusterVirtualMachineTemplate *corev1beta1.ClusterVirtualMachineTemplate, opts v1.UpdateOptions) (*corev1beta1.ClusterVirtualMachineTemplate, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, clusterVirtualMachineTemplate *corev1beta1.ClusterVirtualMachineTemplate, opts v1.UpdateOptions) (*corev1beta1.ClusterVirtualMachineTemplate, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*corev1beta1.ClusterVirtualMachineTemplate, error)
+	List(ctx context.Context, opts v1.ListOptions) (*corev1beta1.ClusterVirtualMachineTemplateList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *corev1beta1.ClusterVirtualMachineTemplate, err error)
+	ClusterVirtualMachineTemplateExpansion
+}
+
+// clusterVirtualMachineTemplates implements ClusterVirtualMachineTemplateInterface
+type clusterVirtualMachineTemplates struct {
+	*gentype.ClientWithList[*corev1beta1.ClusterVirtualMachineTemplate, *corev1beta1.ClusterVirtualMachineTemplateList]
+}
+
+// newClusterVirtualMachineTemplates returns a ClusterVirtualMachineTemplates
+func newClusterVirtualMachineTemplates(c *TemplateV1beta1Client) *clusterVirtualMachineTemplates {
+	return &clusterVirtualMachineTemplates{
+		gentype.NewClientWithList[*corev1beta1.ClusterVirtualMachineTemplate, *corev1beta1.ClusterVirtualMachineTemplateList](
+			"clustervirtualmachinetemplates",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *corev1beta1.ClusterVirtualMachineTemplate { return &corev1beta1.ClusterVirtualMachineTemplate{} },
+			func() *corev1beta1.ClusterVirtualMachineTemplateList {
+				return &corev1beta1.ClusterVirtualMachineTemplateList{}
+			},
+		),
+	}
+}
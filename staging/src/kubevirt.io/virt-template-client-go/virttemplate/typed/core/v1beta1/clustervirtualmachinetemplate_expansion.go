@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	templateapi "kubevirt.io/virt-template-api/core"
+	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
+)
+
+type ClusterVirtualMachineTemplateExpansion interface {
+	Process(
+		ctx context.Context, name, namespace string, options subresourcesv1beta1.ProcessOptions,
+	) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error)
+	CreateVirtualMachine(
+		ctx context.Context, name, namespace string, options subresourcesv1beta1.ProcessOptions,
+	) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error)
+	CreateVirtualMachines(
+		ctx context.Context, name, namespace string, options subresourcesv1beta1.ProcessOptions,
+	) (*subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch, error)
+}
+
+// Process processes the named ClusterVirtualMachineTemplate into the given namespace.
+func (c *clusterVirtualMachineTemplates) Process(
+	ctx context.Context, name, namespace string, options subresourcesv1beta1.ProcessOptions,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
+	result := &subresourcesv1beta1.ProcessedVirtualMachineTemplate{}
+	err := c.GetClient().
+		Post().
+		AbsPath(fmt.Sprintf(subresourceURLFmt, subresourcesv1beta1.GroupVersion.Group, subresourcesv1beta1.GroupVersion.Version)).
+		Namespace(namespace).
+		Resource(templateapi.PluralClusterResourceName).
+		Name(name).
+		SubResource("process").
+		Body(&options).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// CreateVirtualMachine processes the named ClusterVirtualMachineTemplate and creates the
+// resulting VirtualMachine in the given namespace. With options.DryRun set to All the
+// VirtualMachine is validated and returned, but not persisted.
+func (c *clusterVirtualMachineTemplates) CreateVirtualMachine(
+	ctx context.Context, name, namespace string, options subresourcesv1beta1.ProcessOptions,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
+	result := &subresourcesv1beta1.ProcessedVirtualMachineTemplate{}
+	err := c.GetClient().
+		Post().
+		AbsPath(fmt.Sprintf(subresourceURLFmt, subresourcesv1beta1.GroupVersion.Group, subresourcesv1beta1.GroupVersion.Version)).
+		Namespace(namespace).
+		Resource(templateapi.PluralClusterResourceName).
+		Name(name).
+		SubResource("create").
+		Body(&options).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// CreateVirtualMachines creates the number of VirtualMachines requested by options.Count from the
+// named ClusterVirtualMachineTemplate in the given namespace. Each VirtualMachine succeeds or
+// fails individually, errors are reported in the items of the returned batch.
+func (c *clusterVirtualMachineTemplates) CreateVirtualMachines(
+	ctx context.Context, name, namespace string, options subresourcesv1beta1.ProcessOptions,
+) (*subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch, error) {
+	result := &subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch{}
+	err := c.GetClient().
+		Post().
+		AbsPath(fmt.Sprintf(subresourceURLFmt, subresourcesv1beta1.GroupVersion.Group, subresourcesv1beta1.GroupVersion.Version)).
+		Namespace(namespace).
+		Resource(templateapi.PluralClusterResourceName).
+		Name(name).
+		SubResource("create").
+		Body(&options).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
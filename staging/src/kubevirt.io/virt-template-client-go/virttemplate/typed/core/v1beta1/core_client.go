@@ -31,7 +31,10 @@ import (
 
 type TemplateV1beta1Interface interface {
 	RESTClient() rest.Interface
+	ClusterVirtualMachineTemplatesGetter
 	VirtualMachineTemplatesGetter
+	VirtualMachineTemplateGrantsGetter
+	VirtualMachineTemplateInstancesGetter
 	VirtualMachineTemplateRequestsGetter
 }
 
@@ -40,10 +43,22 @@ type TemplateV1beta1Client struct {
 	restClient rest.Interface
 }
 
+func (c *TemplateV1beta1Client) ClusterVirtualMachineTemplates() ClusterVirtualMachineTemplateInterface {
+	return newClusterVirtualMachineTemplates(c)
+}
+
 func (c *TemplateV1beta1Client) VirtualMachineTemplates(namespace string) VirtualMachineTemplateInterface {
 	return newVirtualMachineTemplates(c, namespace)
 }
 
+func (c *TemplateV1beta1Client) VirtualMachineTemplateGrants(namespace string) VirtualMachineTemplateGrantInterface {
+	return newVirtualMachineTemplateGrants(c, namespace)
+}
+
+func (c *TemplateV1beta1Client) VirtualMachineTemplateInstances(namespace string) VirtualMachineTemplateInstanceInterface {
+	return newVirtualMachineTemplateInstances(c, namespace)
+}
+
 func (c *TemplateV1beta1Client) VirtualMachineTemplateRequests(namespace string) VirtualMachineTemplateRequestInterface {
 	return newVirtualMachineTemplateRequests(c, namespace)
 }
@@ -0,0 +1,55 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1beta1 "kubevirt.io/virt-template-api/core/v1beta1"
+	corev1beta1 "kubevirt.io/virt-template-client-go/virttemplate/typed/core/v1beta1"
+)
+
+// fakeClusterVirtualMachineTemplates implements ClusterVirtualMachineTemplateInterface
+type fakeClusterVirtualMachineTemplates struct {
+	*gentype.FakeClientWithList[*v1beta1.ClusterVirtualMachineTemplate, *v1beta1.ClusterVirtualMachineTemplateList]
+	Fake *FakeTemplateV1beta1
+}
+
+func newFakeClusterVirtualMachineTemplates(fake *FakeTemplateV1beta1) corev1beta1.ClusterVirtualMachineTemplateInterface {
+	return &fakeClusterVirtualMachineTemplates{
+		gentype.NewFakeClientWithList[*v1beta1.ClusterVirtualMachineTemplate, *v1beta1.ClusterVirtualMachineTemplateList](
+			fake.Fake,
+			"",
+			v1beta1.SchemeGroupVersion.WithResource("clustervirtualmachinetemplates"),
+			v1beta1.SchemeGroupVersion.WithKind("ClusterVirtualMachineTemplate"),
+			func() *v1beta1.ClusterVirtualMachineTemplate { return &v1beta1.ClusterVirtualMachineTemplate{} },
+			func() *v1beta1.ClusterVirtualMachineTemplateList { return &v1beta1.ClusterVirtualMachineTemplateList{} },
+			func(dst, src *v1beta1.ClusterVirtualMachineTemplateList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.ClusterVirtualMachineTemplateList) []*v1beta1.ClusterVirtualMachineTemplate {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.ClusterVirtualMachineTemplateList, items []*v1beta1.ClusterVirtualMachineTemplate) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -31,10 +31,22 @@ type FakeTemplateV1beta1 struct {
 	*testing.Fake
 }
 
+func (c *FakeTemplateV1beta1) ClusterVirtualMachineTemplates() v1beta1.ClusterVirtualMachineTemplateInterface {
+	return newFakeClusterVirtualMachineTemplates(c)
+}
+
 func (c *FakeTemplateV1beta1) VirtualMachineTemplates(namespace string) v1beta1.VirtualMachineTemplateInterface {
 	return newFakeVirtualMachineTemplates(c, namespace)
 }
 
+func (c *FakeTemplateV1beta1) VirtualMachineTemplateGrants(namespace string) v1beta1.VirtualMachineTemplateGrantInterface {
+	return newFakeVirtualMachineTemplateGrants(c, namespace)
+}
+
+func (c *FakeTemplateV1beta1) VirtualMachineTemplateInstances(namespace string) v1beta1.VirtualMachineTemplateInstanceInterface {
+	return newFakeVirtualMachineTemplateInstances(c, namespace)
+}
+
 func (c *FakeTemplateV1beta1) VirtualMachineTemplateRequests(namespace string) v1beta1.VirtualMachineTemplateRequestInterface {
 	return newFakeVirtualMachineTemplateRequests(c, namespace)
 }
@@ -28,7 +28,10 @@ import (
 	"kubevirt.io/virt-template-api/core/subresourcesv1beta1"
 )
 
-var virtualmachinetemplatesResource = subresourcesv1beta1.GroupVersion.WithResource(templateapi.PluralResourceName)
+var (
+	virtualmachinetemplatesResource        = subresourcesv1beta1.GroupVersion.WithResource(templateapi.PluralResourceName)
+	virtualmachinetemplatepreviewsResource = subresourcesv1beta1.GroupVersion.WithResource(templateapi.PluralPreviewResourceName)
+)
 
 func (f *fakeVirtualMachineTemplates) Process(_ context.Context, name string, options subresourcesv1beta1.ProcessOptions) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
 	obj, err := f.Fake.Invokes(
@@ -51,3 +54,69 @@ func (f *fakeVirtualMachineTemplates) CreateVirtualMachine(_ context.Context, na
 	}
 	return obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplate), err
 }
+
+func (f *fakeVirtualMachineTemplates) CreateVirtualMachines(_ context.Context, name string, options subresourcesv1beta1.ProcessOptions) (*subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch, error) {
+	obj, err := f.Fake.Invokes(
+		testing.NewCreateSubresourceAction(virtualmachinetemplatesResource, name, "create", f.Namespace(), &options),
+		&subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch{},
+	)
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch), err
+}
+
+func (f *fakeVirtualMachineTemplates) Instances(_ context.Context, name string) (*subresourcesv1beta1.VirtualMachineTemplateInstances, error) {
+	obj, err := f.Fake.Invokes(
+		testing.NewGetSubresourceAction(virtualmachinetemplatesResource, f.Namespace(), "instances", name),
+		&subresourcesv1beta1.VirtualMachineTemplateInstances{},
+	)
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*subresourcesv1beta1.VirtualMachineTemplateInstances), err
+}
+
+func (f *fakeVirtualMachineTemplates) Rollback(_ context.Context, name string, options subresourcesv1beta1.RollbackOptions) (*subresourcesv1beta1.RolledBackVirtualMachineTemplate, error) {
+	obj, err := f.Fake.Invokes(
+		testing.NewCreateSubresourceAction(virtualmachinetemplatesResource, name, "rollback", f.Namespace(), &options),
+		&subresourcesv1beta1.RolledBackVirtualMachineTemplate{},
+	)
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*subresourcesv1beta1.RolledBackVirtualMachineTemplate), err
+}
+
+func (f *fakeVirtualMachineTemplates) DiffVirtualMachine(_ context.Context, name string, options subresourcesv1beta1.UpgradeOptions) (*subresourcesv1beta1.VirtualMachineUpgrade, error) {
+	obj, err := f.Fake.Invokes(
+		testing.NewCreateSubresourceAction(virtualmachinetemplatesResource, name, "diff", f.Namespace(), &options),
+		&subresourcesv1beta1.VirtualMachineUpgrade{},
+	)
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*subresourcesv1beta1.VirtualMachineUpgrade), err
+}
+
+func (f *fakeVirtualMachineTemplates) UpgradeVirtualMachine(_ context.Context, name string, options subresourcesv1beta1.UpgradeOptions) (*subresourcesv1beta1.VirtualMachineUpgrade, error) {
+	obj, err := f.Fake.Invokes(
+		testing.NewCreateSubresourceAction(virtualmachinetemplatesResource, name, "upgrade", f.Namespace(), &options),
+		&subresourcesv1beta1.VirtualMachineUpgrade{},
+	)
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*subresourcesv1beta1.VirtualMachineUpgrade), err
+}
+
+func (f *fakeVirtualMachineTemplates) Preview(_ context.Context, preview *subresourcesv1beta1.VirtualMachineTemplatePreview) (*subresourcesv1beta1.VirtualMachineTemplatePreview, error) {
+	obj, err := f.Fake.Invokes(
+		testing.NewCreateAction(virtualmachinetemplatepreviewsResource, f.Namespace(), preview),
+		&subresourcesv1beta1.VirtualMachineTemplatePreview{},
+	)
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*subresourcesv1beta1.VirtualMachineTemplatePreview), err
+}
@@ -0,0 +1,55 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1beta1 "kubevirt.io/virt-template-api/core/v1beta1"
+	corev1beta1 "kubevirt.io/virt-template-client-go/virttemplate/typed/core/v1beta1"
+)
+
+// fakeVirtualMachineTemplateGrants implements VirtualMachineTemplateGrantInterface
+type fakeVirtualMachineTemplateGrants struct {
+	*gentype.FakeClientWithList[*v1beta1.VirtualMachineTemplateGrant, *v1beta1.VirtualMachineTemplateGrantList]
+	Fake *FakeTemplateV1beta1
+}
+
+func newFakeVirtualMachineTemplateGrants(fake *FakeTemplateV1beta1, namespace string) corev1beta1.VirtualMachineTemplateGrantInterface {
+	return &fakeVirtualMachineTemplateGrants{
+		gentype.NewFakeClientWithList[*v1beta1.VirtualMachineTemplateGrant, *v1beta1.VirtualMachineTemplateGrantList](
+			fake.Fake,
+			namespace,
+			v1beta1.SchemeGroupVersion.WithResource("virtualmachinetemplategrants"),
+			v1beta1.SchemeGroupVersion.WithKind("VirtualMachineTemplateGrant"),
+			func() *v1beta1.VirtualMachineTemplateGrant { return &v1beta1.VirtualMachineTemplateGrant{} },
+			func() *v1beta1.VirtualMachineTemplateGrantList { return &v1beta1.VirtualMachineTemplateGrantList{} },
+			func(dst, src *v1beta1.VirtualMachineTemplateGrantList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.VirtualMachineTemplateGrantList) []*v1beta1.VirtualMachineTemplateGrant {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.VirtualMachineTemplateGrantList, items []*v1beta1.VirtualMachineTemplateGrant) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
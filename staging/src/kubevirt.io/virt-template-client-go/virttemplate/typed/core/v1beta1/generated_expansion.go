@@ -20,3 +20,7 @@
 // Code generated by client-gen. DO NOT EDIT.
 
 package v1beta1
+
+type VirtualMachineTemplateGrantExpansion interface{}
+
+type VirtualMachineTemplateInstanceExpansion interface{}
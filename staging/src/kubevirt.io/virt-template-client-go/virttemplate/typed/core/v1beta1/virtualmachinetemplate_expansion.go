@@ -32,6 +32,12 @@ const subresourceURLFmt = "/apis/%s/%s"
 type VirtualMachineTemplateExpansion interface {
 	Process(ctx context.Context, name string, options subresourcesv1beta1.ProcessOptions) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error)
 	CreateVirtualMachine(ctx context.Context, name string, options subresourcesv1beta1.ProcessOptions) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error)
+	CreateVirtualMachines(ctx context.Context, name string, options subresourcesv1beta1.ProcessOptions) (*subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch, error)
+	Instances(ctx context.Context, name string) (*subresourcesv1beta1.VirtualMachineTemplateInstances, error)
+	Rollback(ctx context.Context, name string, options subresourcesv1beta1.RollbackOptions) (*subresourcesv1beta1.RolledBackVirtualMachineTemplate, error)
+	DiffVirtualMachine(ctx context.Context, name string, options subresourcesv1beta1.UpgradeOptions) (*subresourcesv1beta1.VirtualMachineUpgrade, error)
+	UpgradeVirtualMachine(ctx context.Context, name string, options subresourcesv1beta1.UpgradeOptions) (*subresourcesv1beta1.VirtualMachineUpgrade, error)
+	Preview(ctx context.Context, preview *subresourcesv1beta1.VirtualMachineTemplatePreview) (*subresourcesv1beta1.VirtualMachineTemplatePreview, error)
 }
 
 func (c *virtualMachineTemplates) Process(ctx context.Context, name string, options subresourcesv1beta1.ProcessOptions) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
@@ -49,6 +55,8 @@ func (c *virtualMachineTemplates) Process(ctx context.Context, name string, opti
 	return result, err
 }
 
+// CreateVirtualMachine processes the named template and creates the resulting VirtualMachine.
+// With options.DryRun set to All the VirtualMachine is validated and returned, but not persisted.
 func (c *virtualMachineTemplates) CreateVirtualMachine(ctx context.Context, name string, options subresourcesv1beta1.ProcessOptions) (*subresourcesv1beta1.ProcessedVirtualMachineTemplate, error) {
 	result := &subresourcesv1beta1.ProcessedVirtualMachineTemplate{}
 	err := c.GetClient().
@@ -63,3 +71,95 @@ func (c *virtualMachineTemplates) CreateVirtualMachine(ctx context.Context, name
 		Into(result)
 	return result, err
 }
+
+// CreateVirtualMachines creates the number of VirtualMachines requested by options.Count from the
+// named template. Each VirtualMachine succeeds or fails individually, errors are reported in the
+// items of the returned batch.
+func (c *virtualMachineTemplates) CreateVirtualMachines(ctx context.Context, name string, options subresourcesv1beta1.ProcessOptions) (*subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch, error) {
+	result := &subresourcesv1beta1.ProcessedVirtualMachineTemplateBatch{}
+	err := c.GetClient().
+		Post().
+		AbsPath(fmt.Sprintf(subresourceURLFmt, subresourcesv1beta1.GroupVersion.Group, subresourcesv1beta1.GroupVersion.Version)).
+		Namespace(c.GetNamespace()).
+		Resource(templateapi.PluralResourceName).
+		Name(name).
+		SubResource("create").
+		Body(&options).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (c *virtualMachineTemplates) Instances(ctx context.Context, name string) (*subresourcesv1beta1.VirtualMachineTemplateInstances, error) {
+	result := &subresourcesv1beta1.VirtualMachineTemplateInstances{}
+	err := c.GetClient().
+		Get().
+		AbsPath(fmt.Sprintf(subresourceURLFmt, subresourcesv1beta1.GroupVersion.Group, subresourcesv1beta1.GroupVersion.Version)).
+		Namespace(c.GetNamespace()).
+		Resource(templateapi.PluralResourceName).
+		Name(name).
+		SubResource("instances").
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (c *virtualMachineTemplates) Rollback(ctx context.Context, name string, options subresourcesv1beta1.RollbackOptions) (*subresourcesv1beta1.RolledBackVirtualMachineTemplate, error) {
+	result := &subresourcesv1beta1.RolledBackVirtualMachineTemplate{}
+	err := c.GetClient().
+		Post().
+		AbsPath(fmt.Sprintf(subresourceURLFmt, subresourcesv1beta1.GroupVersion.Group, subresourcesv1beta1.GroupVersion.Version)).
+		Namespace(c.GetNamespace()).
+		Resource(templateapi.PluralResourceName).
+		Name(name).
+		SubResource("rollback").
+		Body(&options).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (c *virtualMachineTemplates) DiffVirtualMachine(ctx context.Context, name string, options subresourcesv1beta1.UpgradeOptions) (*subresourcesv1beta1.VirtualMachineUpgrade, error) {
+	result := &subresourcesv1beta1.VirtualMachineUpgrade{}
+	err := c.GetClient().
+		Post().
+		AbsPath(fmt.Sprintf(subresourceURLFmt, subresourcesv1beta1.GroupVersion.Group, subresourcesv1beta1.GroupVersion.Version)).
+		Namespace(c.GetNamespace()).
+		Resource(templateapi.PluralResourceName).
+		Name(name).
+		SubResource("diff").
+		Body(&options).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+func (c *virtualMachineTemplates) UpgradeVirtualMachine(ctx context.Context, name string, options subresourcesv1beta1.UpgradeOptions) (*subresourcesv1beta1.VirtualMachineUpgrade, error) {
+	result := &subresourcesv1beta1.VirtualMachineUpgrade{}
+	err := c.GetClient().
+		Post().
+		AbsPath(fmt.Sprintf(subresourceURLFmt, subresourcesv1beta1.GroupVersion.Group, subresourcesv1beta1.GroupVersion.Version)).
+		Namespace(c.GetNamespace()).
+		Resource(templateapi.PluralResourceName).
+		Name(name).
+		SubResource("upgrade").
+		Body(&options).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// Preview processes the inline template of the preview in the namespace of the client
+// without persisting anything. The result is reported in the status of the returned preview.
+func (c *virtualMachineTemplates) Preview(ctx context.Context, preview *subresourcesv1beta1.VirtualMachineTemplatePreview) (*subresourcesv1beta1.VirtualMachineTemplatePreview, error) {
+	result := &subresourcesv1beta1.VirtualMachineTemplatePreview{}
+	err := c.GetClient().
+		Post().
+		AbsPath(fmt.Sprintf(subresourceURLFmt, subresourcesv1beta1.GroupVersion.Group, subresourcesv1beta1.GroupVersion.Version)).
+		Namespace(c.GetNamespace()).
+		Resource(templateapi.PluralPreviewResourceName).
+		Body(preview).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
@@ -0,0 +1,73 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	context "context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	corev1beta1 "kubevirt.io/virt-template-api/core/v1beta1"
+	scheme "kubevirt.io/virt-template-client-go/virttemplate/scheme"
+)
+
+// VirtualMachineTemplateGrantsGetter has a method to return a VirtualMachineTemplateGrantInterface.
+// A group's client should implement this interface.
+type VirtualMachineTemplateGrantsGetter interface {
+	VirtualMachineTemplateGrants(namespace string) VirtualMachineTemplateGrantInterface
+}
+
+// VirtualMachineTemplateGrantInterface has methods to work with VirtualMachineTemplateGrant resources.
+type VirtualMachineTemplateGrantInterface interface {
+	Create(ctx context.Context, virtualMachineTemplateGrant *corev1beta1.VirtualMachineTemplateGrant, opts v1.CreateOptions) (*corev1beta1.VirtualMachineTemplateGrant, error)
+	Update(ctx context.Context, virtualMachineTemplateGrant *corev1beta1.VirtualMachineTemplateGrant, opts v1.UpdateOptions) (*corev1beta1.VirtualMachineTemplateGrant, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*corev1beta1.VirtualMachineTemplateGrant, error)
+	List(ctx context.Context, opts v1.ListOptions) (*corev1beta1.VirtualMachineTemplateGrantList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *corev1beta1.VirtualMachineTemplateGrant, err error)
+	VirtualMachineTemplateGrantExpansion
+}
+
+// virtualMachineTemplateGrants implements VirtualMachineTemplateGrantInterface
+type virtualMachineTemplateGrants struct {
+	*gentype.ClientWithList[*corev1beta1.VirtualMachineTemplateGrant, *corev1beta1.VirtualMachineTemplateGrantList]
+}
+
+// newVirtualMachineTemplateGrants returns a VirtualMachineTemplateGrants
+func newVirtualMachineTemplateGrants(c *TemplateV1beta1Client, namespace string) *virtualMachineTemplateGrants {
+	return &virtualMachineTemplateGrants{
+		gentype.NewClientWithList[*corev1beta1.VirtualMachineTemplateGrant, *corev1beta1.VirtualMachineTemplateGrantList](
+			"virtualmachinetemplategrants",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *corev1beta1.VirtualMachineTemplateGrant { return &corev1beta1.VirtualMachineTemplateGrant{} },
+			func() *corev1beta1.VirtualMachineTemplateGrantList {
+				return &corev1beta1.VirtualMachineTemplateGrantList{}
+			},
+		),
+	}
+}
@@ -116,3 +116,19 @@ func (p *processor) Process(tpl *v1beta1.VirtualMachineTemplate) (*virtv1.Virtua
 
 	return vm, msg, nil
 }
+
+// GenerateParameterValues generates values for all parameters of a VirtualMachineTemplate
+// that have a generator specified and no value set yet. The generated values are stored in
+// the parameters of the template, so that processing the template afterward is deterministic.
+func (p *processor) GenerateParameterValues(tpl *v1beta1.VirtualMachineTemplate) *field.Error {
+	params, gErr := generateParameterValues(tpl.Spec.Parameters, p.generators)
+	if gErr != nil {
+		return gErr
+	}
+
+	for i := range tpl.Spec.Parameters {
+		tpl.Spec.Parameters[i] = params[tpl.Spec.Parameters[i].Name]
+	}
+
+	return nil
+}
@@ -74,6 +74,57 @@ var _ = Describe("Processor", func() {
 		Expect(msg).To(BeEmpty())
 	})
 
+	It("should generate parameter values deterministically", func() {
+		tmpl := &v1beta1.VirtualMachineTemplate{
+			Spec: v1beta1.VirtualMachineTemplateSpec{
+				Parameters: []v1beta1.Parameter{
+					{
+						Name:     param1Name,
+						Generate: "expression",
+						From:     "test-[a-z]{8}",
+					},
+					{
+						Name:  param2Name,
+						Value: param2Val,
+					},
+				},
+				VirtualMachine: &runtime.RawExtension{
+					Object: &virtv1.VirtualMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: param1Placeholder,
+						},
+					},
+				},
+			},
+		}
+
+		Expect(p.GenerateParameterValues(tmpl)).To(BeNil())
+		Expect(tmpl.Spec.Parameters[0].Value).To(MatchRegexp("^test-[a-z]{8}$"))
+		Expect(tmpl.Spec.Parameters[1].Value).To(Equal(param2Val))
+
+		vm1, _, err := p.Process(tmpl)
+		Expect(err).ToNot(HaveOccurred())
+		vm2, _, err := p.Process(tmpl)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm1.Name).To(Equal(tmpl.Spec.Parameters[0].Value))
+		Expect(vm2.Name).To(Equal(vm1.Name))
+	})
+
+	It("should return error for parameter generation failure when generating values", func() {
+		tmpl := &v1beta1.VirtualMachineTemplate{
+			Spec: v1beta1.VirtualMachineTemplateSpec{
+				Parameters: []v1beta1.Parameter{
+					{
+						Name:     param1Name,
+						Required: true,
+					},
+				},
+			},
+		}
+
+		Expect(p.GenerateParameterValues(tmpl)).To(MatchError(ContainSubstring("parameter 'NAME' is required")))
+	})
+
 	DescribeTable(
 		"should return error when trying to process empty virtualMachine", func(templateVM *runtime.RawExtension) {
 			t := &v1beta1.VirtualMachineTemplate{
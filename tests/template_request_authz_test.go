@@ -47,6 +47,8 @@ var _ = Describe("VirtualMachineTemplateRequest Authorization", func() {
 		verbCreate          = "create"
 		cdiAPIGroup         = "cdi.kubevirt.io"
 		dataVolumesResource = "datavolumes"
+		testSnapshotName    = "test-snapshot"
+		testTemplateName    = "test-template"
 	)
 
 	var (
@@ -127,7 +129,7 @@ var _ = Describe("VirtualMachineTemplateRequest Authorization", func() {
 		roleBindings = append(roleBindings, rb)
 	}
 
-	createTemplateRequest := func(sourceNamespace string) error {
+	createTemplateRequestWithSpec := func(spec v1beta1.VirtualMachineTemplateRequestSpec) error {
 		cfg := rest.CopyConfig(virtClient.Config())
 		cfg.Impersonate = rest.ImpersonationConfig{
 			UserName: "system:serviceaccount:" + serviceAccount.Namespace + ":" + serviceAccount.Name,
@@ -140,18 +142,36 @@ var _ = Describe("VirtualMachineTemplateRequest Authorization", func() {
 				GenerateName: vmtrAuthzTest,
 				Namespace:    NamespaceTest,
 			},
-			Spec: v1beta1.VirtualMachineTemplateRequestSpec{
-				VirtualMachineRef: v1beta1.VirtualMachineReference{
-					Namespace: sourceNamespace,
-					Name:      testVMName,
-				},
-			},
+			Spec: spec,
 		}
 		tplReq, err = saClient.TemplateV1beta1().VirtualMachineTemplateRequests(NamespaceTest).
 			Create(context.Background(), tplReq, metav1.CreateOptions{})
 		return err
 	}
 
+	createTemplateRequestFromSnapshot := func(sourceNamespace, snapshotName string) error {
+		return createTemplateRequestWithSpec(v1beta1.VirtualMachineTemplateRequestSpec{
+			VirtualMachineRef: v1beta1.VirtualMachineReference{
+				Namespace: sourceNamespace,
+				Name:      testVMName,
+			},
+			VirtualMachineSnapshotName: snapshotName,
+		})
+	}
+
+	createTemplateRequestFromTemplate := func(sourceNamespace string) error {
+		return createTemplateRequestWithSpec(v1beta1.VirtualMachineTemplateRequestSpec{
+			VirtualMachineTemplateRef: &v1beta1.VirtualMachineTemplateReference{
+				Namespace: sourceNamespace,
+				Name:      testTemplateName,
+			},
+		})
+	}
+
+	createTemplateRequest := func(sourceNamespace string) error {
+		return createTemplateRequestFromSnapshot(sourceNamespace, "")
+	}
+
 	Context("when user lacks source permissions for cross namespace clone", func() {
 		BeforeEach(func() {
 			requestRole := createRole(NamespaceTest, []rbacv1.PolicyRule{
@@ -274,5 +294,49 @@ var _ = Describe("VirtualMachineTemplateRequest Authorization", func() {
 			Expect(createTemplateRequest(NamespaceTest)).To(Succeed())
 			Expect(tplReq.Name).ToNot(BeEmpty())
 		})
+
+		It("should deny when user cannot get the referenced VirtualMachineSnapshot", func() {
+			Expect(createTemplateRequestFromSnapshot(NamespaceTest, testSnapshotName)).
+				To(MatchError(ContainSubstring("User is not allowed to get VirtualMachineSnapshot")))
+		})
+
+		It("should allow when user can get the referenced VirtualMachineSnapshot", func() {
+			snapshotRole := createRole(NamespaceTest, []rbacv1.PolicyRule{
+				{
+					APIGroups:     []string{"snapshot.kubevirt.io"},
+					Resources:     []string{"virtualmachinesnapshots"},
+					ResourceNames: []string{testSnapshotName},
+					Verbs:         []string{"get"},
+				},
+			})
+			createRoleBinding(NamespaceTest, roleKind, snapshotRole.Name)
+
+			Expect(createTemplateRequestFromSnapshot(NamespaceTest, testSnapshotName)).To(Succeed())
+			Expect(tplReq.Name).ToNot(BeEmpty())
+		})
+
+		It("should deny generalization when user cannot create Jobs", func() {
+			Expect(createTemplateRequestWithSpec(v1beta1.VirtualMachineTemplateRequestSpec{
+				VirtualMachineRef: v1beta1.VirtualMachineReference{
+					Namespace: NamespaceTest,
+					Name:      testVMName,
+				},
+				Generalization: &v1beta1.Generalization{
+					Image: "quay.io/example/virt-sysprep:latest",
+				},
+			})).To(MatchError(ContainSubstring("User is not allowed to create Jobs")))
+		})
+
+		It("should deny cross namespace VirtualMachineTemplate source without source role", func() {
+			Expect(createTemplateRequestFromTemplate(NamespaceSecondaryTest)).
+				To(MatchError(ContainSubstring("User is not allowed to use VirtualMachineTemplate")))
+		})
+
+		It("should allow cross namespace VirtualMachineTemplate source with source role", func() {
+			createRoleBinding(NamespaceSecondaryTest, clusterRoleKind, sourceRoleName)
+
+			Expect(createTemplateRequestFromTemplate(NamespaceSecondaryTest)).To(Succeed())
+			Expect(tplReq.Name).ToNot(BeEmpty())
+		})
 	})
 })